@@ -0,0 +1,332 @@
+package locale
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+// Amounts is a collection of amounts in different currencies (a money bag), keeping one total per currency. It is immutable like Amount, and the zero value is an empty collection.
+type Amounts struct {
+	totals map[currency.Unit]Amount
+}
+
+func MustNewAmounts(amounts ...Amount) Amounts {
+	a, err := NewAmounts(amounts...)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// NewAmounts returns the totals per currency of the given amounts.
+func NewAmounts(amounts ...Amount) (Amounts, error) {
+	a := Amounts{}
+	for _, amount := range amounts {
+		var err error
+		if a, err = a.Add(amount); err != nil {
+			return Amounts{}, err
+		}
+	}
+	return a, nil
+}
+
+func (a Amounts) clone() Amounts {
+	totals := make(map[currency.Unit]Amount, len(a.totals)+1)
+	for unit, total := range a.totals {
+		totals[unit] = total
+	}
+	return Amounts{totals}
+}
+
+// Len returns the number of currencies.
+func (a Amounts) Len() int {
+	return len(a.totals)
+}
+
+// IsZero returns true if all totals are zero.
+func (a Amounts) IsZero() bool {
+	for _, total := range a.totals {
+		if !total.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns the total for the given currency, which is zero if the currency is not present.
+func (a Amounts) Get(unit currency.Unit) Amount {
+	if total, ok := a.totals[unit]; ok {
+		return total
+	}
+	total, _ := NewZeroAmount(unit)
+	return total
+}
+
+// Units returns the currencies sorted by their ISO 4217 code.
+func (a Amounts) Units() []currency.Unit {
+	units := make([]currency.Unit, 0, len(a.totals))
+	for unit := range a.totals {
+		units = append(units, unit)
+	}
+	sort.Slice(units, func(i, j int) bool {
+		return units[i].String() < units[j].String()
+	})
+	return units
+}
+
+// All iterates over the totals sorted by their ISO 4217 code.
+func (a Amounts) All() iter.Seq2[currency.Unit, Amount] {
+	return func(yield func(currency.Unit, Amount) bool) {
+		for _, unit := range a.Units() {
+			if !yield(unit, a.totals[unit]) {
+				return
+			}
+		}
+	}
+}
+
+// List returns the totals sorted by their ISO 4217 code.
+func (a Amounts) List() []Amount {
+	amounts := make([]Amount, 0, len(a.totals))
+	for _, total := range a.All() {
+		amounts = append(amounts, total)
+	}
+	return amounts
+}
+
+func (a Amounts) Equals(b Amounts) bool {
+	for _, unit := range a.Units() {
+		if !a.totals[unit].Equals(b.Get(unit)) {
+			return false
+		}
+	}
+	for _, unit := range b.Units() {
+		if !b.totals[unit].Equals(a.Get(unit)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a Amounts) MustAdd(b Amount) Amounts {
+	c, err := a.Add(b)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Add adds the amount to the total of its currency. Adding ZeroAmount is a no-op.
+func (a Amounts) Add(b Amount) (Amounts, error) {
+	if b == ZeroAmount {
+		return a, nil
+	}
+	total, err := a.Get(b.Unit).Add(b)
+	if err != nil {
+		return Amounts{}, err
+	}
+	a = a.clone()
+	a.totals[b.Unit] = total
+	return a, nil
+}
+
+func (a Amounts) MustSub(b Amount) Amounts {
+	c, err := a.Sub(b)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Sub subtracts the amount from the total of its currency. Subtracting ZeroAmount is a no-op.
+func (a Amounts) Sub(b Amount) (Amounts, error) {
+	return a.Add(b.Neg())
+}
+
+func (a Amounts) MustAddAmounts(b Amounts) Amounts {
+	c, err := a.AddAmounts(b)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// AddAmounts adds all totals of b.
+func (a Amounts) AddAmounts(b Amounts) (Amounts, error) {
+	for _, total := range b.All() {
+		var err error
+		if a, err = a.Add(total); err != nil {
+			return Amounts{}, err
+		}
+	}
+	return a, nil
+}
+
+func (a Amounts) MustSubAmounts(b Amounts) Amounts {
+	c, err := a.SubAmounts(b)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// SubAmounts subtracts all totals of b.
+func (a Amounts) SubAmounts(b Amounts) (Amounts, error) {
+	return a.AddAmounts(b.Neg())
+}
+
+// Neg negates all totals.
+func (a Amounts) Neg() Amounts {
+	a = a.clone()
+	for unit, total := range a.totals {
+		a.totals[unit] = total.Neg()
+	}
+	return a
+}
+
+// Round performs banker's rounding of all totals to their currency's increments.
+func (a Amounts) Round() Amounts {
+	a = a.clone()
+	for unit, total := range a.totals {
+		a.totals[unit] = total.Round()
+	}
+	return a
+}
+
+// String returns the totals sorted by currency and separated by commas, such as "EUR 1.00, USD 2.00".
+func (a Amounts) String() string {
+	sb := strings.Builder{}
+	for i, total := range a.List() {
+		if i != 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(total.String())
+	}
+	return sb.String()
+}
+
+// Scan implements the Scanner interface, it accepts the totals as given by Value separated by spaces, such as "EUR12.34 USD5".
+func (a *Amounts) Scan(isrc interface{}) error {
+	var s string
+	switch src := isrc.(type) {
+	case Amounts:
+		*a = src
+		return nil
+	case nil:
+		*a = Amounts{}
+		return nil
+	case []byte:
+		s = string(src)
+	case string:
+		s = src
+	default:
+		return fmt.Errorf("unexpected type for amounts: %T", isrc)
+	}
+
+	v := Amounts{}
+	for _, field := range strings.Fields(s) {
+		var amount Amount
+		if err := amount.Scan(field); err != nil {
+			return err
+		} else if _, ok := v.totals[amount.Unit]; ok {
+			return fmt.Errorf("invalid amounts: duplicate currency: %v", amount.Unit)
+		} else if v, err = v.Add(amount); err != nil {
+			return err
+		}
+	}
+	*a = v
+	return nil
+}
+
+// Value implements the driver Valuer interface, it returns the totals sorted by currency and separated by spaces, such as "EUR12.34 USD5".
+func (a Amounts) Value() (driver.Value, error) {
+	sb := strings.Builder{}
+	for i, total := range a.List() {
+		if i != 0 {
+			sb.WriteByte(' ')
+		}
+		v, err := total.Value()
+		if err != nil {
+			return nil, err
+		}
+		sb.WriteString(v.(string))
+	}
+	return sb.String(), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The canonical form is an array of amounts sorted by currency, such as [{"currency":"EUR","amount":"12.34"},{"currency":"USD","amount":"5"}].
+func (a Amounts) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.List())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface and accepts only the canonical form of MarshalJSON, each currency may appear only once.
+func (a *Amounts) UnmarshalJSON(b []byte) error {
+	var amounts []Amount
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if err := dec.Decode(&amounts); err != nil {
+		return fmt.Errorf("invalid amounts: %v", err)
+	} else if dec.More() || amounts == nil {
+		return fmt.Errorf("invalid amounts: %v", string(b))
+	}
+
+	v := Amounts{}
+	for _, amount := range amounts {
+		if amount == ZeroAmount {
+			return fmt.Errorf("invalid amounts: currency is required")
+		} else if _, ok := v.totals[amount.Unit]; ok {
+			return fmt.Errorf("invalid amounts: duplicate currency: %v", amount.Unit)
+		}
+		var err error
+		if v, err = v.Add(amount); err != nil {
+			return err
+		}
+	}
+	*a = v
+	return nil
+}
+
+// AmountsFormatter formats the totals sorted by currency using AmountFormatter and joins them using the locale's list pattern.
+type AmountsFormatter struct {
+	Amounts
+	Layout string
+}
+
+func (f AmountsFormatter) Format(state fmt.State, verb rune) {
+	locale := locales["root"]
+	if languager, ok := state.(Languager); ok {
+		locale = GetLocale(languager.Language())
+	}
+
+	items := []string{}
+	for _, total := range f.Amounts.All() {
+		sb := strings.Builder{}
+		AmountFormatter{total, f.Layout}.Format(&amountsState{state, &sb}, verb)
+		items = append(items, sb.String())
+	}
+	state.Write([]byte(locale.ListPattern.Join(items)))
+}
+
+// amountsState writes to a buffer but otherwise passes through the original state, including the language.
+type amountsState struct {
+	fmt.State
+	w *strings.Builder
+}
+
+func (s *amountsState) Write(b []byte) (int, error) {
+	return s.w.Write(b)
+}
+
+func (s *amountsState) Language() language.Tag {
+	if languager, ok := s.State.(Languager); ok {
+		return languager.Language()
+	}
+	return language.Und
+}
@@ -0,0 +1,87 @@
+package locale
+
+import (
+	"encoding/json"
+	"testing"
+
+	"golang.org/x/text/currency"
+
+	"github.com/tdewolff/test"
+)
+
+func TestAmounts(t *testing.T) {
+	a := MustNewAmounts(MustNewAmount(currency.USD, 5, 0), MustNewAmount(EUR, 1234, 2), ZeroAmount)
+	a = a.MustAdd(MustNewAmount(currency.USD, 250, 2))
+	a = a.MustSub(MustNewAmount(currency.JPY, 100, 0))
+	test.T(t, a.Len(), 3)
+	test.T(t, a.String(), "EUR 12.34, JPY -100, USD 7.50")
+	test.T(t, a.Neg().String(), "EUR -12.34, JPY 100, USD -7.50")
+	test.T(t, a.Get(currency.GBP).String(), "GBP 0.00")
+	test.T(t, a.IsZero(), false)
+	test.T(t, a.MustSubAmounts(a).IsZero(), true)
+	test.T(t, a.MustAddAmounts(a).Equals(a), false)
+	test.T(t, a.MustAddAmounts(a).Equals(a.MustAddAmounts(a)), true)
+	test.T(t, Amounts{}.IsZero(), true)
+
+	units := []currency.Unit{}
+	for unit, total := range a.All() {
+		test.T(t, total.Unit, unit)
+		units = append(units, unit)
+	}
+	test.T(t, units, []currency.Unit{EUR, currency.JPY, currency.USD})
+
+	_, err := a.Add(MustNewAmount(currency.USD, MaxAmount/100000, 0))
+	test.T(t, err, ErrOverflow)
+}
+
+func TestAmountsEncoding(t *testing.T) {
+	a := MustNewAmounts(MustNewAmount(currency.USD, 5, 0), MustNewAmount(EUR, -1234, 2))
+
+	v, err := a.Value()
+	test.Error(t, err)
+	test.T(t, v, "EUR-12.34 USD5")
+
+	var b Amounts
+	test.Error(t, b.Scan(v))
+	test.T(t, b.Equals(a), true)
+	test.Error(t, b.Scan(nil))
+	test.T(t, b.Len(), 0)
+	test.That(t, b.Scan("EUR5 EUR6") != nil, "must return error for duplicate currency")
+
+	j, err := json.Marshal(a)
+	test.Error(t, err)
+	test.T(t, string(j), `[{"currency":"EUR","amount":"-12.34"},{"currency":"USD","amount":"5"}]`)
+	test.Error(t, json.Unmarshal(j, &b))
+	test.T(t, b.Equals(a), true)
+
+	j, err = json.Marshal(Amounts{})
+	test.Error(t, err)
+	test.T(t, string(j), `[]`)
+
+	for _, s := range []string{`null`, `{}`, `[{"currency":"EUR","amount":"5"},{"currency":"EUR","amount":"6"}]`, `[{"currency":"","amount":"0"}]`} {
+		test.That(t, json.Unmarshal([]byte(s), &b) != nil, "must return error for "+s)
+	}
+}
+
+func TestAmountsFormatter(t *testing.T) {
+	one := MustNewAmounts(MustNewAmount(EUR, 5, 0))
+	two := one.MustAdd(MustNewAmount(currency.USD, 3, 0))
+	three := two.MustAdd(MustNewAmount(currency.GBP, 1, 0))
+	tests := []struct {
+		p   *Printer
+		a   Amounts
+		str string
+	}{
+		{en, Amounts{}, ""},
+		{en, one, "€5.00"},
+		{en, two, "€5.00 and $3.00"},
+		{en, three, "€5.00, £1.00, and $3.00"},
+		{es, three, "5,00\u00A0€, 1,00\u00A0£ y 3,00\u00A0$"},
+		{nl, two, "€\u00A05,00 en $\u00A03,00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			test.T(t, tt.p.T(tt.a, "$100.00"), tt.str)
+		})
+	}
+}
@@ -0,0 +1,503 @@
+package locale
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+)
+
+var bigScales = func() [len(int64Scales)]*big.Int {
+	scales := [len(int64Scales)]*big.Int{}
+	for i, scale := range int64Scales {
+		scales[i] = big.NewInt(scale)
+	}
+	return scales
+}()
+
+// bigScale returns 10^n, do not modify the result.
+func bigScale(n int) *big.Int {
+	if n < len(bigScales) {
+		return bigScales[n]
+	}
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// BigAmount is an arbitrary-precision amount that can never overflow. It has the same API as Amount and should be used for currencies where amounts may exceed the range of Amount, which is about 9.2e12 for currencies with three digits, such as crypto and high-inflation currencies.
+type BigAmount struct {
+	currency.Unit
+	amount   *big.Int // amount multiplied by 10^(digits + AmountPrecision), nil is zero
+	digits   int      // decimal digits for display
+	rounding int      // rounding increment
+}
+
+func ParseBigAmount(unit currency.Unit, s string) (BigAmount, error) {
+	return ParseBigAmountBytes(unit, []byte(s))
+}
+
+func ParseBigAmountBytes(unit currency.Unit, b []byte) (BigAmount, error) {
+	amount, dec, n := parseBigNumber(b, ',', '.')
+	if n == 0 || n != len(b) {
+		return BigAmount{}, fmt.Errorf("invalid amount: %v", string(b))
+	}
+	return NewBigAmount(unit, amount, dec)
+}
+
+func ParseBigAmountLocale(tag language.Tag, unit currency.Unit, s string) (BigAmount, error) {
+	locale := GetLocale(tag)
+	amount, dec, n := parseBigNumber([]byte(s), locale.GroupSymbol, locale.DecimalSymbol)
+	if n == 0 || n != len(s) {
+		return BigAmount{}, fmt.Errorf("invalid amount: %v", s)
+	}
+	return NewBigAmount(unit, amount, dec)
+}
+
+func NewZeroBigAmount(unit currency.Unit) (BigAmount, error) {
+	return NewBigAmount(unit, new(big.Int), 0)
+}
+
+func MustNewBigAmount(unit currency.Unit, amount *big.Int, dec int) BigAmount {
+	a, err := NewBigAmount(unit, amount, dec)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// NewBigAmount returns a new amount of amount*10^-dec.
+func NewBigAmount(unit currency.Unit, amount *big.Int, dec int) (BigAmount, error) {
+	cur := GetCurrency(unit)
+	if cur.Rounding != 0 && cur.Rounding != 1 && cur.Rounding != 10 && cur.Rounding != 100 {
+		return BigAmount{}, fmt.Errorf("unsupported currency rounding: %v", cur.Rounding)
+	}
+	prec := cur.Digits + AmountPrecision
+	if dec < prec {
+		amount = new(big.Int).Mul(amount, bigScale(prec-dec))
+	} else if prec < dec {
+		amount = bankersRoundingRat(new(big.Rat).SetFrac(amount, bigScale(dec-prec)))
+	} else {
+		amount = new(big.Int).Set(amount)
+	}
+	return BigAmount{unit, amount, cur.Digits, cur.Rounding}, nil
+}
+
+// ToBigAmount converts the amount to an arbitrary-precision amount.
+func (a Amount) ToBigAmount() BigAmount {
+	return BigAmount{a.Unit, big.NewInt(a.amount), a.digits, a.rounding}
+}
+
+// ToAmount converts the amount to an Amount, it returns an error if it doesn't fit.
+func (a BigAmount) ToAmount() (Amount, error) {
+	if len(int64Scales) <= a.digits+AmountPrecision {
+		return Amount{}, fmt.Errorf("unsupported currency digits for Amount: %v", a.digits)
+	}
+	amount := a.int()
+	if !amount.IsInt64() || amount.Int64() == -MaxAmount-1 {
+		if amount.Sign() < 0 {
+			return Amount{}, ErrUnderflow
+		}
+		return Amount{}, ErrOverflow
+	}
+	return Amount{a.Unit, amount.Int64(), a.digits, a.rounding}, nil
+}
+
+func (a BigAmount) int() *big.Int {
+	if a.amount == nil {
+		return new(big.Int)
+	}
+	return a.amount
+}
+
+// Zero returns the zero value for the amount (keep the currency).
+func (a BigAmount) Zero() BigAmount {
+	a.amount = new(big.Int)
+	return a
+}
+
+func (a BigAmount) IsZero() bool {
+	return a.int().Sign() == 0
+}
+
+func (a BigAmount) IsNegative() bool {
+	return a.int().Sign() < 0
+}
+
+func (a BigAmount) IsPositive() bool {
+	return 0 < a.int().Sign()
+}
+
+func (a BigAmount) isZeroAmount() bool {
+	return a.Unit == currency.Unit{} && a.IsZero()
+}
+
+// normaliseBigAmounts returns the amounts of both number that are comparable, ie. they have the same unit are are in the same magnitude.
+func normaliseBigAmounts(a, b BigAmount) (*big.Int, *big.Int, bool) {
+	if a.Unit != b.Unit {
+		return nil, nil, false
+	}
+	A, B := a.int(), b.int()
+	if a.digits < b.digits {
+		A = new(big.Int).Mul(A, bigScale(b.digits-a.digits))
+	} else if b.digits < a.digits {
+		B = new(big.Int).Mul(B, bigScale(a.digits-b.digits))
+	}
+	return A, B, true
+}
+
+func (a BigAmount) Equals(b BigAmount) bool {
+	A, B, ok := normaliseBigAmounts(a, b)
+	if !ok {
+		return false
+	}
+	return A.Cmp(B) == 0
+}
+
+func (a BigAmount) Compare(b BigAmount) int {
+	A, B, ok := normaliseBigAmounts(a, b)
+	if !ok {
+		return 0
+	}
+	return A.Cmp(B)
+}
+
+// round performs banker's rounding to the given increments
+func (a BigAmount) round(incr int) (BigAmount, error) {
+	prec := AmountPrecision
+	switch incr {
+	case 0, 1:
+		// no-op
+	case 10:
+		prec++
+	case 100:
+		prec += 2
+	default:
+		return BigAmount{}, fmt.Errorf("unexpected increment: %v", incr)
+	}
+	amount := bankersRoundingRat(new(big.Rat).SetFrac(a.int(), bigScale(prec)))
+	a.amount = amount.Mul(amount, bigScale(prec))
+	return a, nil
+}
+
+// Round performs banker's rounding to the currency's increments
+func (a BigAmount) Round() BigAmount {
+	a, _ = a.round(a.rounding)
+	return a
+}
+
+func (a BigAmount) Neg() BigAmount {
+	a.amount = new(big.Int).Neg(a.int())
+	return a
+}
+
+func (a BigAmount) Abs() BigAmount {
+	a.amount = new(big.Int).Abs(a.int())
+	return a
+}
+
+func (a BigAmount) MustAdd(b BigAmount) BigAmount {
+	c, err := a.Add(b)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func (a BigAmount) Add(b BigAmount) (BigAmount, error) {
+	if a.Unit != b.Unit {
+		if a.isZeroAmount() {
+			return b, nil
+		}
+		return BigAmount{}, fmt.Errorf("currencies don't match: %v != %v", a.Unit, b.Unit)
+	}
+	a.amount = new(big.Int).Add(a.int(), b.int())
+	return a, nil
+}
+
+func (a BigAmount) MustSub(b BigAmount) BigAmount {
+	c, err := a.Sub(b)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func (a BigAmount) Sub(b BigAmount) (BigAmount, error) {
+	if a.Unit != b.Unit {
+		if a.isZeroAmount() {
+			return b.Neg(), nil
+		}
+		return BigAmount{}, fmt.Errorf("currencies don't match: %v != %v", a.Unit, b.Unit)
+	}
+	a.amount = new(big.Int).Sub(a.int(), b.int())
+	return a, nil
+}
+
+func (a BigAmount) MustMul(f int) BigAmount {
+	c, err := a.Mul(f)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Mul multiplies the amount by f, it never returns an error but keeps the same API as Amount.
+func (a BigAmount) Mul(f int) (BigAmount, error) {
+	a.amount = new(big.Int).Mul(a.int(), big.NewInt(int64(f)))
+	return a, nil
+}
+
+func (a BigAmount) Div(f int) BigAmount {
+	a.amount = bankersRoundingRat(new(big.Rat).SetFrac(a.int(), big.NewInt(int64(f))))
+	return a
+}
+
+func (a BigAmount) DivAmount(b BigAmount) float64 {
+	A, B, ok := normaliseBigAmounts(a, b)
+	if !ok || B.Sign() == 0 {
+		return math.NaN()
+	}
+	f, _ := new(big.Rat).SetFrac(A, B).Float64()
+	return f
+}
+
+func (a BigAmount) MustMulf(f float64) BigAmount {
+	c, err := a.Mulf(f)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func (a BigAmount) Mulf(f float64) (BigAmount, error) {
+	r := new(big.Rat)
+	if r.SetFloat64(f) == nil {
+		return BigAmount{}, fmt.Errorf("invalid factor: %v", f)
+	}
+	return a.MulRat(r), nil
+}
+
+// MulRat multiplies the amount by r exactly, and uses banker's rounding only for the extra precision of the amount (see AmountPrecision).
+func (a BigAmount) MulRat(r *big.Rat) BigAmount {
+	v := new(big.Rat).SetInt(a.int())
+	a.amount = bankersRoundingRat(v.Mul(v, r))
+	return a
+}
+
+// increment returns the currency's rounding increment in units of the amount.
+func (a BigAmount) increment() *big.Int {
+	incr := int64(a.rounding)
+	if incr == 0 {
+		incr = 1
+	}
+	return big.NewInt(incr * int64Scales[AmountPrecision])
+}
+
+// RoundMode rounds the amount to the currency's increments using the given rounding mode.
+func (a BigAmount) RoundMode(mode RoundingMode) BigAmount {
+	incr := a.increment()
+	amount := roundRat(new(big.Rat).SetFrac(a.int(), incr), mode)
+	a.amount = amount.Mul(amount, incr)
+	return a
+}
+
+// Quo divides the amount by d and rounds the result to the currency's increments using the given rounding mode.
+func (a BigAmount) Quo(d int, mode RoundingMode) (BigAmount, error) {
+	if d == 0 {
+		return BigAmount{}, fmt.Errorf("division by zero")
+	}
+	incr := a.increment()
+	amount := roundRat(new(big.Rat).SetFrac(a.int(), new(big.Int).Mul(big.NewInt(int64(d)), incr)), mode)
+	a.amount = amount.Mul(amount, incr)
+	return a, nil
+}
+
+// DivMod divides the amount by d and returns the quotient truncated to the currency's increments and the remainder, such that q*d + r equals the amount exactly. The remainder has the same sign as the amount.
+func (a BigAmount) DivMod(d int) (BigAmount, BigAmount, error) {
+	if d == 0 {
+		return BigAmount{}, BigAmount{}, fmt.Errorf("division by zero")
+	}
+	incr := a.increment()
+	q, r := a, a
+	q.amount = new(big.Int).Quo(a.int(), new(big.Int).Mul(big.NewInt(int64(d)), incr))
+	q.amount.Mul(q.amount, incr)
+	r.amount = new(big.Int).Sub(a.int(), new(big.Int).Mul(q.amount, big.NewInt(int64(d))))
+	return q, r, nil
+}
+
+// MulBasisPoints returns the percentage of the amount exactly, such as the tax amount. Round the result to obtain a monetary amount.
+func (a BigAmount) MulBasisPoints(bp BasisPoints) BigAmount {
+	return a.MulRat(bp.Rat())
+}
+
+// AddBasisPoints increases the amount by the percentage exactly, such as adding tax or interest.
+func (a BigAmount) AddBasisPoints(bp BasisPoints) BigAmount {
+	return a.MulRat(big.NewRat(10000+int64(bp), 10000))
+}
+
+// SubBasisPoints decreases the amount by the percentage exactly, such as subtracting a discount.
+func (a BigAmount) SubBasisPoints(bp BasisPoints) BigAmount {
+	return a.MulRat(big.NewRat(10000-int64(bp), 10000))
+}
+
+func (a BigAmount) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(a.int(), bigScale(a.digits+AmountPrecision)).Float64()
+	return f
+}
+
+func (a BigAmount) Amount() (*big.Int, int) {
+	return new(big.Int).Set(a.int()), a.digits + AmountPrecision
+}
+
+func (a BigAmount) AmountRounded() (*big.Int, int, error) {
+	var err error
+	a, err = a.round(1)
+	if err != nil {
+		return nil, 0, err
+	}
+	return new(big.Int).Quo(a.int(), bigScale(AmountPrecision)), a.digits, nil
+}
+
+func (a BigAmount) StringAmount() string {
+	amount, dec := a.Amount()
+	b := appendBigNumber(nil, amount, dec, 0, 0, '.')
+
+	// remove superfluous trailing zeros
+	if 0 < dec {
+		for b[len(b)-1] == '0' {
+			b = b[:len(b)-1]
+		}
+		if b[len(b)-1] == '.' {
+			b = b[:len(b)-1]
+		}
+	}
+	return string(b)
+}
+
+func (a BigAmount) String() string {
+	amount, dec, err := a.AmountRounded()
+	if err != nil {
+		return ""
+	}
+	b := appendBigNumber(nil, amount, dec, 3, ',', '.')
+	return a.Unit.String() + " " + string(b)
+}
+
+func (a *BigAmount) Scan(isrc interface{}) error {
+	var b []byte
+	switch src := isrc.(type) {
+	case BigAmount:
+		*a = src
+		return nil
+	case Amount:
+		*a = src.ToBigAmount()
+		return nil
+	case []byte:
+		b = src
+	case string:
+		b = []byte(src)
+	default:
+		return fmt.Errorf("unexpected type for amount: %T", isrc)
+	}
+
+	if len(b) < 4 {
+		return fmt.Errorf("invalid amount: %v", isrc)
+	}
+	unit, err := currency.ParseISO(string(b[:3]))
+	if err != nil {
+		return fmt.Errorf("%v: %v", err, string(b))
+	}
+	i := 3
+	if b[i] == ' ' {
+		i++
+	}
+	amount, err := ParseBigAmountBytes(unit, b[i:])
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+func (a BigAmount) Value() (driver.Value, error) {
+	return a.Unit.String() + a.StringAmount(), nil
+}
+
+type BigAmountFormatter struct {
+	BigAmount
+	Layout string
+}
+
+func (f BigAmountFormatter) Format(state fmt.State, verb rune) {
+	tag := language.Und
+	locale := locales["root"]
+	if languager, ok := state.(Languager); ok {
+		tag = languager.Language()
+		locale = GetLocale(tag)
+	}
+	b, spans := appendAmount(nil, nil, tag, locale, f.Unit, f.BigAmount.int(), f.BigAmount.digits, f.Layout)
+	writeParts(state, locale, b, spans)
+}
+
+// parseBigNumber is like strconv.ParseNumber but without a limit on the number of digits.
+func parseBigNumber(b []byte, groupSym rune, decSym rune) (*big.Int, int, int) {
+	n, dec := 0, 0
+	hasDecimals := false
+	digits := strings.Builder{}
+	if 0 < len(b) && b[0] == '-' {
+		digits.WriteByte('-')
+		n++
+	}
+	for n < len(b) {
+		if '0' <= b[n] && b[n] <= '9' {
+			digits.WriteByte(b[n])
+			if hasDecimals {
+				dec++
+			}
+			n++
+		} else if r, size := utf8.DecodeRune(b[n:]); !hasDecimals && (r == groupSym || r == decSym) {
+			if r == decSym {
+				hasDecimals = true
+			}
+			n += size
+		} else {
+			break
+		}
+	}
+	num, ok := new(big.Int).SetString(digits.String(), 10)
+	if !ok {
+		return new(big.Int), 0, 0
+	}
+	return num, dec, n
+}
+
+// appendBigNumber is like strconv.AppendNumber but without a limit on the number of digits.
+func appendBigNumber(b []byte, num *big.Int, dec int, groupSize int, groupSym rune, decSym rune) []byte {
+	digits := num.Text(10)
+	if digits[0] == '-' {
+		b = append(b, '-')
+		digits = digits[1:]
+	}
+	if dec < 0 {
+		dec = 0
+	} else if len(digits) <= dec {
+		digits = strings.Repeat("0", dec-len(digits)+1) + digits
+	}
+
+	integer, fraction := digits[:len(digits)-dec], digits[len(digits)-dec:]
+	for i := 0; i < len(integer); i++ {
+		if 0 < i && 0 < groupSize && groupSym != 0 && (len(integer)-i)%groupSize == 0 {
+			b = utf8.AppendRune(b, groupSym)
+		}
+		b = append(b, integer[i])
+	}
+	if 0 < dec {
+		b = utf8.AppendRune(b, decSym)
+		b = append(b, fraction...)
+	}
+	return b
+}
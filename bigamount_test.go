@@ -0,0 +1,95 @@
+package locale
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"golang.org/x/text/currency"
+
+	"github.com/tdewolff/test"
+)
+
+func TestBigAmount(t *testing.T) {
+	var tests = []struct {
+		s string
+		r string
+	}{
+		{"EUR5", "EUR 5.00"},
+		{"EUR-5.125", "EUR -5.12"},
+		{"EUR12345678901234567890.12", "EUR 12,345,678,901,234,567,890.12"},
+		{"KWD99999999999999.999", "KWD 99,999,999,999,999.999"},
+		{"JPY1234.5", "JPY 1,234"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			var a BigAmount
+			test.Error(t, a.Scan(tt.s))
+			test.T(t, a.String(), tt.r)
+
+			v, err := a.Value()
+			test.Error(t, err)
+
+			var b BigAmount
+			test.Error(t, b.Scan(v))
+			test.That(t, a.Equals(b), "must round trip")
+		})
+	}
+}
+
+func TestBigAmountArithmetic(t *testing.T) {
+	max := MustNewAmount(currency.MustParseISO("KWD"), MaxAmount/1000, 3).ToBigAmount()
+	_, err := max.ToAmount()
+	test.Error(t, err)
+
+	a := max.MustAdd(max)
+	test.T(t, a.String(), "KWD 18,446,744,073,709.550")
+	_, err = a.ToAmount()
+	test.T(t, err, ErrOverflow)
+	_, err = a.Neg().ToAmount()
+	test.T(t, err, ErrUnderflow)
+
+	test.T(t, a.MustSub(max).Equals(max), true)
+	test.T(t, a.Div(2).Equals(max), true)
+	test.T(t, max.MustMul(1000000).String(), "KWD 9,223,372,036,854,775,000.000")
+	test.T(t, a.Compare(max), 1)
+	test.T(t, max.Compare(a), -1)
+
+	eur := MustNewBigAmount(EUR, big.NewInt(1005), 3) // 1.005
+	test.T(t, eur.Round().String(), "EUR 1.00")
+	test.T(t, eur.MustMulf(2.0).String(), "EUR 2.01")
+	test.T(t, eur.DivAmount(eur), 1.0)
+	test.T(t, eur.RoundMode(RoundHalfUp).String(), "EUR 1.01")
+	test.T(t, eur.MulBasisPoints(21*Percent).StringAmount(), "0.21105")
+	q, _ := eur.Quo(3, RoundUp)
+	test.T(t, q.String(), "EUR 0.34")
+	q, r, _ := eur.DivMod(3)
+	test.T(t, q.String()+" "+r.StringAmount(), "EUR 0.33 0.015")
+
+	_, err = eur.Add(max)
+	test.That(t, err != nil, "must return error for different currencies")
+}
+
+func TestBigAmountFormat(t *testing.T) {
+	var tests = []struct {
+		f string
+		s string
+		r string
+	}{
+		{"100", "USD16.00", "16"},
+		{"$100", "EUR16.00", "€ 16"},
+		{"$100.00", "EUR16.006", "€ 16.01"},
+		{"USD 100", "EUR12345678901234567890.12", "EUR 12,345,678,901,234,567,890.12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			var amount BigAmount
+			err := amount.Scan(tt.s)
+			test.Error(t, err)
+
+			v := fmt.Sprintf("%v", BigAmountFormatter{amount, tt.f})
+			test.T(t, v, tt.r)
+		})
+	}
+}
@@ -0,0 +1,428 @@
+package locale
+
+import (
+	"log"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// calendarDate is a date in a (non-)Gregorian calendar.
+type calendarDate struct {
+	Era   int // index into the calendar's eras
+	Year  int // year within the era
+	Month int // month number starting at 1, such as 7 for both Adar and Adar II in the Hebrew calendar
+	Day   int
+	Leap  bool // leap month, such as Adar II in the Hebrew calendar
+}
+
+// withCalendar returns the locale set to use the given calendar for dates, such as "islamic-umalqura" or "japanese". Both CLDR and BCP 47 calendar types are accepted, unsupported calendars fall back to the Gregorian calendar, such as the observational "islamic" calendar that can't be computed.
+func withCalendar(locale Locale, calendar string) Locale {
+	if calendar == "gregory" {
+		calendar = "gregorian"
+	}
+	if _, ok := locale.Calendars[calendarDataType(calendar)]; !ok || !isSupportedCalendar(calendar) {
+		if calendar != "gregorian" {
+			log.Printf("INFO: locale: unsupported calendar: %v\n", calendar)
+		}
+		calendar = "gregorian"
+	}
+	locale.Calendar = calendar
+	return locale
+}
+
+// getDateFormat returns the date formats of the locale's calendar.
+func getDateFormat(locale Locale) CalendarFormat {
+	if cal, ok := locale.Calendars[calendarDataType(locale.Calendar)]; ok && locale.Calendar != "gregorian" {
+		return cal.DateFormat
+	}
+	return locale.DateFormat
+}
+
+// calendarDataType returns the calendar type under which the names and patterns are stored, which is "islamic" for all variants of the Islamic calendar.
+func calendarDataType(calendar string) string {
+	if strings.HasPrefix(calendar, "islamic") {
+		return "islamic"
+	}
+	return calendar
+}
+
+func isSupportedCalendar(calendar string) bool {
+	switch calendar {
+	case "gregorian", "buddhist", "hebrew", "islamic-civil", "islamic-tbla", "islamic-umalqura", "japanese", "persian":
+		return true
+	}
+	return false
+}
+
+var weekdayTypes = map[string]int{
+	"sun": 0,
+	"mon": 1,
+	"tue": 2,
+	"wed": 3,
+	"thu": 4,
+	"fri": 5,
+	"sat": 6,
+}
+
+// getWeekData returns the week data of the tag's region, where the first day of the week is overridden by the -u-fw- extension.
+func getWeekData(tag language.Tag) WeekData {
+	week := weekData["001"]
+	if region, confidence := tag.Region(); confidence != language.No {
+		if regionWeek, ok := weekData[region.String()]; ok {
+			week = regionWeek
+		}
+	}
+	if day, ok := weekdayTypes[tag.TypeForKey("fw")]; ok {
+		week.FirstDay = day
+	}
+	return week
+}
+
+// getTimeData returns the hour cycles of the tag's language and region, such as for en_001, or of its region.
+func getTimeData(tag language.Tag) TimeData {
+	if region, confidence := tag.Region(); confidence != language.No {
+		base, _ := tag.Base()
+		if hours, ok := timeData[base.String()+"_"+region.String()]; ok {
+			return hours
+		} else if hours, ok := timeData[region.String()]; ok {
+			return hours
+		}
+	}
+	return timeData["001"]
+}
+
+// FirstDayOfWeek returns the first day of the week for the region of the tag, such as Sunday for the US and Monday for the Netherlands, or the day set by the -u-fw- extension.
+func FirstDayOfWeek(tag language.Tag) time.Weekday {
+	return time.Weekday(getWeekData(tag).FirstDay)
+}
+
+// Weekend returns the first and last day of the weekend for the region of the tag, such as Saturday and Sunday for most regions or Friday and Saturday for Saudi Arabia.
+func Weekend(tag language.Tag) (time.Weekday, time.Weekday) {
+	week := getWeekData(tag)
+	return time.Weekday(week.WeekendStart), time.Weekday(week.WeekendEnd)
+}
+
+// WeekOfYear returns the week-based year and the week of the year for the region of the tag. Weeks start on the first day of the week and the first week of the year is the first with a minimal number of days in the new year, such that most of Europe uses ISO 8601 weeks (see time.Time.ISOWeek) and the US uses weeks containing January 1.
+func WeekOfYear(tag language.Tag, t time.Time) (int, int) {
+	return getWeekOfYear(GetLocale(tag), t)
+}
+
+// getCalendarDate returns the date of t in the locale's calendar.
+func getCalendarDate(locale Locale, t time.Time) calendarDate {
+	year, month, day := t.Date()
+	switch locale.Calendar {
+	case "buddhist":
+		return calendarDate{0, year + 543, int(month), day, false}
+	case "japanese":
+		era := 0
+		date := t.Format("2006-01-02")
+		for i := len(japaneseEras) - 1; 0 < i; i-- {
+			if japaneseEras[i] <= date {
+				era = i
+				break
+			}
+		}
+		eraYear, _ := strconv.Atoi(japaneseEras[era][:4])
+		return calendarDate{era, year - eraYear + 1, int(month), day, false}
+	case "islamic-civil":
+		return julianDayToIslamic(getJulianDay(t), islamicCivilEpoch)
+	case "islamic-umalqura":
+		return julianDayToUmalqura(getJulianDay(t))
+	case "islamic-tbla":
+		return julianDayToIslamic(getJulianDay(t), islamicAstronomicalEpoch)
+	case "hebrew":
+		return julianDayToHebrew(getJulianDay(t))
+	case "persian":
+		return julianDayToPersian(getJulianDay(t))
+	}
+	return calendarDate{getEra(t), getEraYear(t), int(month), day, false}
+}
+
+// eraSymbol returns the name of the date's era.
+func (d calendarDate) eraSymbol(locale Locale) CalendarSymbol {
+	if locale.Calendar == "gregorian" {
+		return locale.EraSymbol[d.Era]
+	} else if eras := locale.Calendars[calendarDataType(locale.Calendar)].EraSymbol; d.Era < len(eras) {
+		return eras[d.Era]
+	}
+	return CalendarSymbol{}
+}
+
+// monthSymbol returns the name of the date's month, where non-Gregorian calendars only have names for the format context.
+func (d calendarDate) monthSymbol(locale Locale, standalone bool) CalendarSymbol {
+	if months := locale.Calendars[calendarDataType(locale.Calendar)].MonthSymbol; locale.Calendar != "gregorian" && len(months) != 0 {
+		if d.Leap && 13 < len(months) {
+			return months[13]
+		} else if d.Month <= len(months) {
+			return months[d.Month-1]
+		}
+		return CalendarSymbol{}
+	} else if standalone {
+		return locale.MonthStandaloneSymbol[d.Month-1]
+	}
+	return locale.MonthSymbol[d.Month-1]
+}
+
+// Julian day numbers of 1 Muharram 1 AH for the civil (Friday) and astronomical (Thursday) epochs of the tabular Islamic calendar
+const (
+	islamicCivilEpoch        = 1948440
+	islamicAstronomicalEpoch = 1948439
+)
+
+func islamicToJulianDay(year, month, day int, epoch int64) int64 {
+	return int64(day+(59*(month-1)+1)/2+(year-1)*354+floorDiv(3+11*year, 30)) + epoch - 1
+}
+
+// julianDayToIslamic returns the date in the tabular Islamic calendar, which has 11 leap years in a 30-year cycle.
+func julianDayToIslamic(jd, epoch int64) calendarDate {
+	year := int(floorDiv64(30*(jd-epoch)+10646, 10631))
+	month := int(math.Ceil(float64(jd-29-islamicToJulianDay(year, 1, 1, epoch))/29.5)) + 1
+	month = min(max(month, 1), 12)
+	day := int(jd-islamicToJulianDay(year, month, 1, epoch)) + 1
+	return calendarDate{0, year, month, day, false}
+}
+
+// Julian day number of 1 Muharram 1300 AH, the first day of umalquraMonths
+const (
+	umalquraEpoch = 2408762
+	umalquraYear  = 1300
+)
+
+// umalquraMonths are the month lengths of the Umm al-Qura calendar of Saudi Arabia from 1300 through 1600 AH as computed by ICU, where bit i is set if month i+1 has 30 instead of 29 days.
+var umalquraMonths = [...]uint16{
+	0x555, 0x2ab, 0x937, 0x2b6, 0x576, 0x36c, 0xb55, 0xaaa, 0x956, 0x49e,
+	0x95d, 0x2ba, 0x5b5, 0x3aa, 0xb4b, 0xa96, 0x52e, 0x2ad, 0x56d, 0xb5a,
+	0x752, 0xf25, 0xe8a, 0xd16, 0xa56, 0xab5, 0x6b4, 0xda9, 0xb92, 0xb25,
+	0x64b, 0xa9b, 0x35a, 0x6d9, 0x5d4, 0xda5, 0xd4a, 0xa95, 0x536, 0x975,
+	0x2f4, 0x6e9, 0x6d4, 0x6a9, 0x535, 0x25d, 0x4bd, 0x9ba, 0x3b4, 0xb69,
+	0xb2a, 0xa55, 0x4ad, 0xa5d, 0x2da, 0x6d9, 0xeaa, 0xe94, 0xd2a, 0xc56,
+	0x4ae, 0xa6d, 0x56a, 0xd55, 0xd4a, 0xa93, 0x52b, 0xa5b, 0x53a, 0x6b5,
+	0xea9, 0xd52, 0xd29, 0xa55, 0x4ad, 0x56d, 0xaea, 0x6e4, 0xed1, 0xda2,
+	0xaaa, 0x95a, 0x2da, 0x5b9, 0xbb2, 0x764, 0x6c9, 0x555, 0x2ab, 0x4db,
+	0xaba, 0x5b4, 0xda9, 0xd52, 0xaa5, 0x92d, 0x26d, 0x8ed, 0x2da, 0xad5,
+	0xaa5, 0xa4b, 0x497, 0x937, 0x2b6, 0x975, 0xd69, 0xd52, 0xc95, 0x92b,
+	0x25b, 0x4db, 0x9d5, 0x5d2, 0xda5, 0xd4a, 0xa95, 0x54d, 0xaad, 0x3aa,
+	0xbd2, 0xbc4, 0xb89, 0xa95, 0x52d, 0x5ad, 0xb6a, 0x6d4, 0xdc9, 0xd92,
+	0xaa6, 0x956, 0x2ae, 0x56d, 0x36a, 0xb55, 0xaaa, 0x94d, 0x49d, 0x95d,
+	0x2ba, 0x5b5, 0x5aa, 0xd55, 0xa9a, 0x92e, 0x26e, 0x55d, 0xada, 0x6d4,
+	0x6a5, 0xb27, 0xa4d, 0x4ad, 0x56d, 0xb5a, 0x754, 0xf49, 0xe92, 0xd26,
+	0xa56, 0x356, 0x6b5, 0xbaa, 0xb92, 0xb25, 0x68b, 0xa9b, 0x55a, 0xada,
+	0x5b4, 0xda9, 0xb52, 0xa9a, 0x536, 0x276, 0x575, 0xaf2, 0x6d4, 0x6a9,
+	0x555, 0x2ad, 0x4bd, 0x9ba, 0x574, 0xb69, 0xb52, 0xa95, 0x52d, 0xa5d,
+	0x4da, 0xad9, 0x6b2, 0xe95, 0xe2a, 0xc96, 0x92e, 0xaad, 0x56a, 0xd65,
+	0xd4a, 0xd15, 0x62b, 0xc5b, 0x53a, 0x6b5, 0xdb2, 0xd64, 0xd29, 0xa55,
+	0x4ad, 0x96d, 0xaea, 0x6e8, 0xed1, 0xda4, 0xd4a, 0xa6a, 0x2da, 0x5b9,
+	0xb72, 0xb68, 0x6d1, 0x655, 0x4ab, 0x95b, 0x2ba, 0x5b5, 0xda9, 0xd52,
+	0xca6, 0x94e, 0x46e, 0x95d, 0x4da, 0xad5, 0xaaa, 0xa4d, 0x49b, 0x937,
+	0x4b6, 0x975, 0xd6a, 0xd52, 0xaa5, 0x94b, 0x2ab, 0x55b, 0xad9, 0x5d2,
+	0xdc5, 0xd92, 0xb25, 0x555, 0xab5, 0x5b4, 0xba9, 0x7a2, 0x745, 0x593,
+	0xaab, 0x4d6, 0x9d6, 0x5d2, 0xba5, 0xb4a, 0xa95, 0x4ad, 0x15d, 0x2dd,
+	0x9da, 0x5b4, 0x5a9, 0x52d, 0x25b, 0x8b7, 0x176, 0x56d, 0xb6a, 0xaca,
+	0xa96, 0x52b, 0x15b, 0x2bb, 0x5b6, 0xdaa, 0xb94, 0xd46, 0xa8d, 0x52d,
+	0xa9d, 0x55a, 0x755, 0x749, 0xf13, 0xe4a, 0xa96, 0x556, 0x6b5, 0xbaa,
+	0xb94,
+}
+
+// julianDayToUmalqura returns the date in the Umm al-Qura calendar, which uses the tabular Islamic calendar outside of the years 1300 through 1600 AH.
+func julianDayToUmalqura(jd int64) calendarDate {
+	if jd < umalquraEpoch {
+		return julianDayToIslamic(jd, islamicCivilEpoch)
+	}
+	start := int64(umalquraEpoch)
+	for i, months := range umalquraMonths {
+		days := int64(12*29 + bits.OnesCount16(months))
+		if jd < start+days {
+			month := 0
+			for ; month < 11 && start+int64(29+months>>month&1) <= jd; month++ {
+				start += int64(29 + months>>month&1)
+			}
+			return calendarDate{0, umalquraYear + i, month + 1, int(jd-start) + 1, false}
+		}
+		start += days
+	}
+	return julianDayToIslamic(jd, islamicCivilEpoch)
+}
+
+// Julian day number of the day before the Hebrew epoch, such that 1 Tishri 1 AM is day one
+const hebrewEpoch = 347997
+
+func isHebrewLeapYear(year int) bool {
+	return floorMod(7*year+1, 19) < 7
+}
+
+// hebrewElapsedDays returns the number of days from the epoch to the new year, taking into account the postponement rules of the molad.
+func hebrewElapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	day := months*29 + floorDiv(parts, 25920)
+	if floorMod(3*(day+1), 7) < 3 {
+		day++
+	}
+	return day
+}
+
+func hebrewNewYearDelay(year int) int {
+	last, present, next := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	if next-present == 356 {
+		return 2
+	} else if present-last == 382 {
+		return 1
+	}
+	return 0
+}
+
+func hebrewYearDays(year int) int {
+	return int(hebrewToJulianDay(year+1, 7, 1) - hebrewToJulianDay(year, 7, 1))
+}
+
+// hebrewMonthDays returns the number of days in the month, where months are counted from Nisan (1) to Adar (12) and Adar II (13).
+func hebrewMonthDays(year, month int) int {
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == 13:
+		return 29
+	case month == 12 && !isHebrewLeapYear(year):
+		return 29
+	case month == 8 && hebrewYearDays(year)%10 != 5:
+		return 29
+	case month == 9 && hebrewYearDays(year)%10 == 3:
+		return 29
+	}
+	return 30
+}
+
+func hebrewMonths(year int) int {
+	if isHebrewLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// hebrewToJulianDay returns the Julian day number, where months are counted from Nisan as for hebrewMonthDays.
+func hebrewToJulianDay(year, month, day int) int64 {
+	jd := int64(hebrewEpoch + hebrewElapsedDays(year) + hebrewNewYearDelay(year) + day)
+	if month < 7 {
+		for m := 7; m <= hebrewMonths(year); m++ {
+			jd += int64(hebrewMonthDays(year, m))
+		}
+		for m := 1; m < month; m++ {
+			jd += int64(hebrewMonthDays(year, m))
+		}
+	} else {
+		for m := 7; m < month; m++ {
+			jd += int64(hebrewMonthDays(year, m))
+		}
+	}
+	return jd
+}
+
+// julianDayToHebrew returns the date in the Hebrew calendar, where months are numbered from Tishri (1) to Elul (13) as in CLDR and Adar I (6) only occurs in leap years.
+func julianDayToHebrew(jd int64) calendarDate {
+	year := int((jd-hebrewEpoch)*98496/35975351) - 1
+	for hebrewToJulianDay(year+1, 7, 1) <= jd {
+		year++
+	}
+	month := 1
+	if jd < hebrewToJulianDay(year, 1, 1) {
+		month = 7
+	}
+	for hebrewToJulianDay(year, month, hebrewMonthDays(year, month)) < jd {
+		month++
+	}
+	day := int(jd-hebrewToJulianDay(year, month, 1)) + 1
+
+	// convert month numbering from Nisan to Tishri
+	leap := false
+	if 7 <= month && month <= 11 {
+		month -= 6
+	} else if month == 12 {
+		month = 7
+		if isHebrewLeapYear(year) {
+			month = 6
+		}
+	} else if month == 13 {
+		month, leap = 7, true
+	} else {
+		month += 7
+	}
+	return calendarDate{0, year, month, day, leap}
+}
+
+// persianBreaks are the years in which the 33-year leap cycle of the Persian calendar is interrupted.
+var persianBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// persianYear returns the number of years since the last leap year, which is zero for leap years, and the day in March of the Gregorian year on which the year starts.
+func persianYear(year int) (int, int) {
+	gyear := year + 621
+	leapJ := -14
+	jp := persianBreaks[0]
+	jump := 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gyear/4 - (gyear/100+1)*3/4 - 150
+	march := 20 + leapJ - leapG
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap := ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, march
+}
+
+// julianDayToPersian returns the date in the arithmetic Persian (Solar Hijri) calendar, which is valid for the years 1 to 3177 AP.
+func julianDayToPersian(jd int64) calendarDate {
+	gyear, _, _ := time.Unix((jd-2440588)*86400, 0).UTC().Date()
+	year := gyear - 621
+	leap, march := persianYear(year)
+	k := int(jd - getJulianDay(time.Date(gyear, 3, march, 0, 0, 0, 0, time.UTC)))
+	if 0 <= k {
+		if k <= 185 {
+			return calendarDate{0, year, 1 + k/31, k%31 + 1, false}
+		}
+		k -= 186
+	} else {
+		year--
+		k += 179
+		if leap == 1 {
+			k++ // previous year is a leap year
+		}
+	}
+	return calendarDate{0, year, 7 + k/30, k%30 + 1, false}
+}
+
+func floorDiv(a, b int) int {
+	if q := a / b; (a%b != 0) && ((a < 0) != (b < 0)) {
+		return q - 1
+	} else {
+		return q
+	}
+}
+
+func floorDiv64(a, b int64) int64 {
+	if q := a / b; (a%b != 0) && ((a < 0) != (b < 0)) {
+		return q - 1
+	} else {
+		return q
+	}
+}
+
+func floorMod(a, b int) int {
+	return a - floorDiv(a, b)*b
+}
@@ -0,0 +1,123 @@
+package locale
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/tdewolff/test"
+)
+
+func TestCalendarDate(t *testing.T) {
+	tests := []struct {
+		calendar string
+		t        time.Time
+		date     calendarDate
+	}{
+		{"gregorian", time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), calendarDate{0, 44, 3, 15, false}},
+		{"buddhist", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), calendarDate{0, 2568, 1, 2, false}},
+		{"japanese", time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC), calendarDate{2, 64, 1, 7, false}},
+		{"japanese", time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC), calendarDate{3, 1, 1, 8, false}},
+		{"japanese", time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC), calendarDate{3, 31, 4, 30, false}},
+		{"japanese", time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), calendarDate{4, 1, 5, 1, false}},
+		{"islamic-civil", time.Date(622, 7, 19, 0, 0, 0, 0, time.UTC), calendarDate{0, 1, 1, 1, false}},
+		{"islamic-civil", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), calendarDate{0, 1420, 9, 24, false}},
+		{"islamic-civil", time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), calendarDate{0, 1445, 9, 1, false}},
+		{"islamic-tbla", time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), calendarDate{0, 1445, 9, 2, false}},
+		{"islamic-umalqura", time.Date(1882, 11, 11, 0, 0, 0, 0, time.UTC), calendarDate{0, 1299, 12, 29, false}},
+		{"islamic-umalqura", time.Date(1882, 11, 12, 0, 0, 0, 0, time.UTC), calendarDate{0, 1300, 1, 1, false}},
+		{"islamic-umalqura", time.Date(2024, 7, 7, 0, 0, 0, 0, time.UTC), calendarDate{0, 1446, 1, 1, false}},
+		{"islamic-umalqura", time.Date(2025, 3, 29, 0, 0, 0, 0, time.UTC), calendarDate{0, 1446, 9, 29, false}},
+		{"islamic-umalqura", time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), calendarDate{0, 1446, 10, 1, false}},
+		{"islamic", time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC), calendarDate{1, 2025, 3, 30, false}},
+		{"hebrew", time.Date(2023, 9, 16, 0, 0, 0, 0, time.UTC), calendarDate{0, 5784, 1, 1, false}},
+		{"hebrew", time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), calendarDate{0, 5784, 6, 6, false}},
+		{"hebrew", time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC), calendarDate{0, 5784, 7, 14, true}},
+		{"hebrew", time.Date(2024, 4, 23, 0, 0, 0, 0, time.UTC), calendarDate{0, 5784, 8, 15, false}},
+		{"hebrew", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), calendarDate{0, 5785, 4, 2, false}},
+		{"hebrew", time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), calendarDate{0, 5785, 7, 14, false}},
+		{"persian", time.Date(2024, 3, 19, 0, 0, 0, 0, time.UTC), calendarDate{0, 1402, 12, 29, false}},
+		{"persian", time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), calendarDate{0, 1403, 1, 1, false}},
+		{"persian", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), calendarDate{0, 1403, 10, 13, false}},
+		{"persian", time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC), calendarDate{0, 1403, 12, 30, false}},
+		{"persian", time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC), calendarDate{0, 1404, 1, 1, false}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.calendar, "_", tt.t.Format("2006-01-02")), func(t *testing.T) {
+			locale := withCalendar(locales["en"], tt.calendar)
+			test.T(t, getCalendarDate(locale, tt.t), tt.date)
+		})
+	}
+}
+
+func TestTimeFormatterCalendar(t *testing.T) {
+	tm := time.Date(2025, 1, 2, 15, 4, 0, 0, time.UTC)
+	tests := []struct {
+		tag    string
+		layout string
+		s      string
+	}{
+		{"en-u-ca-buddhist", DateFull, "Thursday, January 2, 2568 BE"},
+		{"en-u-ca-japanese", DateLong, "January 2, 7 Reiwa"},
+		{"en-u-ca-japanese", DateShort, "1/2/7 R"},
+		{"en-u-ca-islamic-civil", DateFull, "Thursday, Rajab 2, 1446 AH"},
+		{"en-u-ca-hebrew", DateMedium, "Tevet 2, 5785 AM"},
+		{"en-u-ca-persian", DateShort + " " + TimeShort, "10/13/1403 AP, 3:04 PM"},
+		{"en-u-ca-gregory", DateLong, "January 2, 2025"},
+		{"es-u-ca-islamic-umalqura", DateLong, "2 de rayab de 1446 a. H."},
+		{"es-u-ca-islamic", DateLong, "2 de enero de 2025"},
+		{"nl-u-ca-hebrew", DateShort, "02-04-5785 A.M."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			p := NewPrinter(language.MustParse(tt.tag), time.UTC)
+			test.T(t, p.T(tm, tt.layout), tt.s)
+		})
+	}
+
+	p := NewPrinter(language.MustParse("nl-NL-u-nu-latn"), time.UTC)
+	test.Error(t, p.SetCalendar("islamic-umalqura"))
+	test.T(t, p.LanguageTag.String(), "nl-NL-u-ca-islamic-umalqura-nu-latn")
+	test.T(t, p.T(tm, DateLong), "2 Rajab 1446 AH")
+	test.T(t, p.T(tm, tm.Add(time.Hour), DateMedium+" "+TimeShort), "2 Raj. 1446 AH, 15:04–16:04")
+}
+
+func TestWeekData(t *testing.T) {
+	tests := []struct {
+		tag          string
+		firstDay     time.Weekday
+		weekendStart time.Weekday
+		weekendEnd   time.Weekday
+		week         string
+	}{
+		{"en", time.Sunday, time.Saturday, time.Sunday, "2010-W02"},
+		{"en-GB", time.Monday, time.Saturday, time.Sunday, "2009-W53"},
+		{"en-US-u-fw-mon", time.Monday, time.Saturday, time.Sunday, "2010-W01"},
+		{"nl", time.Monday, time.Saturday, time.Sunday, "2009-W53"},
+		{"nl-u-fw-sun", time.Sunday, time.Saturday, time.Sunday, "2010-W01"},
+		{"es-MX", time.Sunday, time.Saturday, time.Sunday, "2010-W02"},
+		{"ar-SA", time.Sunday, time.Friday, time.Saturday, "2010-W02"},
+		{"ar-EG", time.Saturday, time.Friday, time.Saturday, "2010-W02"},
+		{"fa-IR", time.Saturday, time.Friday, time.Friday, "2010-W02"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			tag := language.MustParse(tt.tag)
+			test.T(t, FirstDayOfWeek(tag), tt.firstDay)
+			weekendStart, weekendEnd := Weekend(tag)
+			test.T(t, weekendStart, tt.weekendStart)
+			test.T(t, weekendEnd, tt.weekendEnd)
+			year, week := WeekOfYear(tag, time.Date(2010, 1, 3, 0, 0, 0, 0, time.UTC))
+			test.T(t, fmt.Sprintf("%d-W%02d", year, week), tt.week)
+		})
+	}
+
+	// week symbols use the week data of the tag's region
+	tm := time.Date(2010, 1, 3, 0, 0, 0, 0, time.UTC)
+	b, _ := formatTime(nil, nil, "Y-'W'ww-e", GetLocale(language.MustParse("en-GB")), tm)
+	test.String(t, string(b), "2009-W53-7")
+	b, _ = formatTime(nil, nil, "Y-'W'ww-e", GetLocale(language.MustParse("nl-u-fw-sun")), tm)
+	test.String(t, string(b), "2010-W01-1")
+}
@@ -5,6 +5,7 @@ type CurrencyFormat struct {
     Standard string
     Amount   string
     ISO      string
+    Long     Count
 }
 
 type CalendarFormat struct {
@@ -18,6 +19,7 @@ type CalendarSymbol struct {
     Wide        string
     Abbreviated string
     Narrow      string
+    Short       string
 }
 
 type DayPeriodRule struct {
@@ -31,9 +33,23 @@ type Count struct {
 }
 
 type Currency struct {
-    Name     string
-    Standard string
-    Narrow   string
+    Name       string
+    Standard   string
+    Narrow     string
+    PluralName Count
+    Decimal    int32
+    Group      int32
+}
+
+type CurrencySpacingRule struct {
+    CurrencyMatch    string
+    SurroundingMatch string
+    InsertBetween    string
+}
+
+type CurrencySpacing struct {
+    BeforeCurrency CurrencySpacingRule
+    AfterCurrency  CurrencySpacingRule
 }
 
 type Unit struct {
@@ -42,30 +58,65 @@ type Unit struct {
     Narrow Count
 }
 
+type ListPattern struct {
+    Start  string
+    Middle string
+    End    string
+    Two    string
+}
+
 type Locale struct {
-    DecimalFormat          string
-    CurrencyFormat         CurrencyFormat
-    DateFormat             CalendarFormat
-    TimeFormat             CalendarFormat
-    DatetimeFormat         CalendarFormat
-    DatetimeIntervalFormat map[string]map[string]string
-    TimezoneFormat         string
-    DecimalSymbol          int32
-    GroupSymbol            int32
-    CurrencyDecimalSymbol  int32
-    CurrencyGroupSymbol    int32
-    PlusSymbol             int32
-    MinusSymbol            int32
-    TimeSeparatorSymbol    int32
-    MonthSymbol            [12]CalendarSymbol
-    DaySymbol              [7]CalendarSymbol
-    DayPeriodRules         map[string]DayPeriodRule
-    DayPeriodSymbol        map[string]CalendarSymbol
-    TimezoneCity           map[string]string
-    Metazones              map[string]Metazone
-    Currency               map[string]Currency
-    Unit                   map[string]Unit
-    Territory              map[string]string
+    DecimalFormat           string
+    CurrencyFormat          CurrencyFormat
+    DateFormat              CalendarFormat
+    TimeFormat              CalendarFormat
+    DatetimeFormat          CalendarFormat
+    DatetimeIntervalFormat  map[string]map[string]string
+    TimezoneFormat          string
+    DecimalSymbol           int32
+    GroupSymbol             int32
+    CurrencyDecimalSymbol   int32
+    CurrencyGroupSymbol     int32
+    PlusSymbol              int32
+    MinusSymbol             int32
+    TimeSeparatorSymbol     int32
+    MonthSymbol             [12]CalendarSymbol
+    DaySymbol               [7]CalendarSymbol
+    DayPeriodRules          map[string]DayPeriodRule
+    DayPeriodSymbol         map[string]CalendarSymbol
+    TimezoneCity            map[string]string
+    Metazones               map[string]Metazone
+    Currency                map[string]Currency
+    Unit                    map[string]Unit
+    Territory               map[string]string
+    ListPattern             ListPattern
+    CurrencySpacing         CurrencySpacing
+    PercentFormat           string
+    PercentSymbol           int32
+    RelativeTime            map[string]RelativeTime
+    DatetimeAvailableFormat map[string]string
+    EraSymbol               [2]CalendarSymbol
+    QuarterSymbol           [4]CalendarSymbol
+    MonthStandaloneSymbol   [12]CalendarSymbol
+    DayStandaloneSymbol     [7]CalendarSymbol
+    QuarterStandaloneSymbol [4]CalendarSymbol
+    Calendar                string
+    Calendars               map[string]Calendar
+    FirstDay                int
+    MinDays                 int
+    HourCycle               string
+    AllowedHours            []string
+    NumberingSystem         string
+    PreferredCurrency       string
+    MeasurementSystem       string
+    Timezone                string
+    GMTFormat               string
+    GMTZeroFormat           string
+    HourFormat              string
+    RegionFormat            string
+    RegionDaylightFormat    string
+    RegionStandardFormat    string
+    FallbackFormat          string
 }
 
 type CurrencyInfo struct {
@@ -75,6 +126,13 @@ type CurrencyInfo struct {
     CashRounding int
 }
 
+type RegionCurrency struct {
+    Currency string
+    From     string
+    To       string
+    Tender   bool
+}
+
 type MetazoneSymbol struct {
     Long  string
     Short string
@@ -86,8 +144,32 @@ type Metazone struct {
     Daylight MetazoneSymbol
 }
 
+type RelativeTime struct {
+    Relative map[string]string
+    Future   Count
+    Past     Count
+}
+
+type Calendar struct {
+    DateFormat  CalendarFormat
+    MonthSymbol []CalendarSymbol
+    EraSymbol   []CalendarSymbol
+}
+
+type WeekData struct {
+    FirstDay     int
+    MinDays      int
+    WeekendStart int
+    WeekendEnd   int
+}
+
+type TimeData struct {
+    Preferred string
+    Allowed   []string
+}
+
 var locales = map[string]Locale{
-    "en": {"#,##0.###", CurrencyFormat{"¤#,##0.00", "#,##0.00", "¤ #,##0.00"}, CalendarFormat{"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy"}, CalendarFormat{"h:mm:ss a zzzz", "h:mm:ss a z", "h:mm:ss a", "h:mm a"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
+    "en": {"#,##0.###", CurrencyFormat{"¤#,##0.00", "#,##0.00", "¤ #,##0.00", Count{"{0} {1}", "{0} {1}"}}, CalendarFormat{"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy"}, CalendarFormat{"h:mm:ss a zzzz", "h:mm:ss a z", "h:mm:ss a", "h:mm a"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
         "": {
             "": "{0} – {1}",
         },
@@ -231,40 +313,40 @@ var locales = map[string]Locale{
             "y": "M/d/y – M/d/y",
         },
     }, "{0} {1}", 46, 44, 46, 44, 43, 45, 58, [12]CalendarSymbol{
-        {"January", "Jan", "J"},
-        {"February", "Feb", "F"},
-        {"March", "Mar", "M"},
-        {"April", "Apr", "A"},
-        {"May", "May", "M"},
-        {"June", "Jun", "J"},
-        {"July", "Jul", "J"},
-        {"August", "Aug", "A"},
-        {"September", "Sep", "S"},
-        {"October", "Oct", "O"},
-        {"November", "Nov", "N"},
-        {"December", "Dec", "D"},
+        {"January", "Jan", "J", ""},
+        {"February", "Feb", "F", ""},
+        {"March", "Mar", "M", ""},
+        {"April", "Apr", "A", ""},
+        {"May", "May", "M", ""},
+        {"June", "Jun", "J", ""},
+        {"July", "Jul", "J", ""},
+        {"August", "Aug", "A", ""},
+        {"September", "Sep", "S", ""},
+        {"October", "Oct", "O", ""},
+        {"November", "Nov", "N", ""},
+        {"December", "Dec", "D", ""},
     }, [7]CalendarSymbol{
-        {"Sunday", "Sun", "S"},
-        {"Monday", "Mon", "M"},
-        {"Tuesday", "Tue", "T"},
-        {"Wednesday", "Wed", "W"},
-        {"Thursday", "Thu", "T"},
-        {"Friday", "Fri", "F"},
-        {"Saturday", "Sat", "S"},
+        {"Sunday", "Sun", "S", "Su"},
+        {"Monday", "Mon", "M", "Mo"},
+        {"Tuesday", "Tue", "T", "Tu"},
+        {"Wednesday", "Wed", "W", "We"},
+        {"Thursday", "Thu", "T", "Th"},
+        {"Friday", "Fri", "F", "Fr"},
+        {"Saturday", "Sat", "S", "Sa"},
     }, map[string]DayPeriodRule{
         "afternoon1": {720, 1080},
         "evening1": {1080, 1260},
         "morning1": {0, 720},
         "night1": {1260, 1440},
     }, map[string]CalendarSymbol{
-        "afternoon1": {"in the afternoon", "in the afternoon", "afternoon"},
-        "am": {"AM", "AM", "AM"},
-        "evening1": {"in the evening", "in the evening", "evening"},
-        "midnight": {"midnight", "midnight", "midnight"},
-        "morning1": {"in the morning", "in the morning", "morning"},
-        "night1": {"at night", "at night", "night"},
-        "noon": {"noon", "noon", "noon"},
-        "pm": {"PM", "PM", "PM"},
+        "afternoon1": {"in the afternoon", "in the afternoon", "afternoon", ""},
+        "am": {"AM", "AM", "AM", ""},
+        "evening1": {"in the evening", "in the evening", "evening", ""},
+        "midnight": {"midnight", "midnight", "midnight", ""},
+        "morning1": {"in the morning", "in the morning", "morning", ""},
+        "night1": {"at night", "at night", "night", ""},
+        "noon": {"noon", "noon", "noon", ""},
+        "pm": {"PM", "PM", "PM", ""},
     }, map[string]string{
         "Africa/Asmera": "Asmara",
         "Africa/El_Aaiun": "El Aaiún",
@@ -525,313 +607,313 @@ var locales = map[string]Locale{
         "Yekaterinburg": {MetazoneSymbol{"Yekaterinburg Time", ""}, MetazoneSymbol{"Yekaterinburg Standard Time", ""}, MetazoneSymbol{"Yekaterinburg Summer Time", ""}},
         "Yukon": {MetazoneSymbol{"", ""}, MetazoneSymbol{"Yukon Time", ""}, MetazoneSymbol{"", ""}},
     }, map[string]Currency{
-        "ADP": {"Andorran Peseta", "", ""},
-        "AED": {"United Arab Emirates Dirham", "AED", ""},
-        "AFA": {"Afghan Afghani (1927–2002)", "", ""},
-        "AFN": {"Afghan Afghani", "AFN", "؋"},
-        "ALK": {"Albanian Lek (1946–1965)", "", ""},
-        "ALL": {"Albanian Lek", "ALL", ""},
-        "AMD": {"Armenian Dram", "AMD", "֏"},
-        "ANG": {"Netherlands Antillean Guilder", "ANG", ""},
-        "AOA": {"Angolan Kwanza", "AOA", "Kz"},
-        "AOK": {"Angolan Kwanza (1977–1991)", "", ""},
-        "AON": {"Angolan New Kwanza (1990–2000)", "", ""},
-        "AOR": {"Angolan Readjusted Kwanza (1995–1999)", "", ""},
-        "ARA": {"Argentine Austral", "", ""},
-        "ARL": {"Argentine Peso Ley (1970–1983)", "", ""},
-        "ARM": {"Argentine Peso (1881–1970)", "", ""},
-        "ARP": {"Argentine Peso (1983–1985)", "", ""},
-        "ARS": {"Argentine Peso", "ARS", "$"},
-        "ATS": {"Austrian Schilling", "", ""},
-        "AUD": {"Australian Dollar", "A$", "$"},
-        "AWG": {"Aruban Florin", "AWG", ""},
-        "AZM": {"Azerbaijani Manat (1993–2006)", "", ""},
-        "AZN": {"Azerbaijani Manat", "AZN", "₼"},
-        "BAD": {"Bosnia-Herzegovina Dinar (1992–1994)", "", ""},
-        "BAM": {"Bosnia-Herzegovina Convertible Mark", "BAM", "KM"},
-        "BAN": {"Bosnia-Herzegovina New Dinar (1994–1997)", "", ""},
-        "BBD": {"Barbadian Dollar", "BBD", "$"},
-        "BDT": {"Bangladeshi Taka", "BDT", "৳"},
-        "BEC": {"Belgian Franc (convertible)", "", ""},
-        "BEF": {"Belgian Franc", "", ""},
-        "BEL": {"Belgian Franc (financial)", "", ""},
-        "BGL": {"Bulgarian Hard Lev", "", ""},
-        "BGM": {"Bulgarian Socialist Lev", "", ""},
-        "BGN": {"Bulgarian Lev", "BGN", ""},
-        "BGO": {"Bulgarian Lev (1879–1952)", "", ""},
-        "BHD": {"Bahraini Dinar", "BHD", ""},
-        "BIF": {"Burundian Franc", "BIF", ""},
-        "BMD": {"Bermudan Dollar", "BMD", "$"},
-        "BND": {"Brunei Dollar", "BND", "$"},
-        "BOB": {"Bolivian Boliviano", "BOB", "Bs"},
-        "BOL": {"Bolivian Boliviano (1863–1963)", "", ""},
-        "BOP": {"Bolivian Peso", "", ""},
-        "BOV": {"Bolivian Mvdol", "", ""},
-        "BRB": {"Brazilian New Cruzeiro (1967–1986)", "", ""},
-        "BRC": {"Brazilian Cruzado (1986–1989)", "", ""},
-        "BRE": {"Brazilian Cruzeiro (1990–1993)", "", ""},
-        "BRL": {"Brazilian Real", "R$", "R$"},
-        "BRN": {"Brazilian New Cruzado (1989–1990)", "", ""},
-        "BRR": {"Brazilian Cruzeiro (1993–1994)", "", ""},
-        "BRZ": {"Brazilian Cruzeiro (1942–1967)", "", ""},
-        "BSD": {"Bahamian Dollar", "BSD", "$"},
-        "BTN": {"Bhutanese Ngultrum", "BTN", ""},
-        "BUK": {"Burmese Kyat", "", ""},
-        "BWP": {"Botswanan Pula", "BWP", "P"},
-        "BYB": {"Belarusian Ruble (1994–1999)", "", ""},
-        "BYN": {"Belarusian Ruble", "BYN", ""},
-        "BYR": {"Belarusian Ruble (2000–2016)", "", ""},
-        "BZD": {"Belize Dollar", "BZD", "$"},
-        "CAD": {"Canadian Dollar", "CA$", "$"},
-        "CDF": {"Congolese Franc", "CDF", ""},
-        "CHE": {"WIR Euro", "", ""},
-        "CHF": {"Swiss Franc", "CHF", ""},
-        "CHW": {"WIR Franc", "", ""},
-        "CLE": {"Chilean Escudo", "", ""},
-        "CLF": {"Chilean Unit of Account (UF)", "", ""},
-        "CLP": {"Chilean Peso", "CLP", "$"},
-        "CNH": {"Chinese Yuan (offshore)", "CNH", ""},
-        "CNX": {"Chinese People’s Bank Dollar", "", ""},
-        "CNY": {"Chinese Yuan", "CN¥", "¥"},
-        "COP": {"Colombian Peso", "COP", "$"},
-        "COU": {"Colombian Real Value Unit", "", ""},
-        "CRC": {"Costa Rican Colón", "CRC", "₡"},
-        "CSD": {"Serbian Dinar (2002–2006)", "", ""},
-        "CSK": {"Czechoslovak Hard Koruna", "", ""},
-        "CUC": {"Cuban Convertible Peso", "CUC", "$"},
-        "CUP": {"Cuban Peso", "CUP", "$"},
-        "CVE": {"Cape Verdean Escudo", "CVE", ""},
-        "CYP": {"Cypriot Pound", "", ""},
-        "CZK": {"Czech Koruna", "CZK", "Kč"},
-        "DDM": {"East German Mark", "", ""},
-        "DEM": {"German Mark", "", ""},
-        "DJF": {"Djiboutian Franc", "DJF", ""},
-        "DKK": {"Danish Krone", "DKK", "kr"},
-        "DOP": {"Dominican Peso", "DOP", "$"},
-        "DZD": {"Algerian Dinar", "DZD", ""},
-        "ECS": {"Ecuadorian Sucre", "", ""},
-        "ECV": {"Ecuadorian Unit of Constant Value", "", ""},
-        "EEK": {"Estonian Kroon", "", ""},
-        "EGP": {"Egyptian Pound", "EGP", "E£"},
-        "ERN": {"Eritrean Nakfa", "ERN", ""},
-        "ESA": {"Spanish Peseta (A account)", "", ""},
-        "ESB": {"Spanish Peseta (convertible account)", "", ""},
-        "ESP": {"Spanish Peseta", "", "₧"},
-        "ETB": {"Ethiopian Birr", "ETB", ""},
-        "EUR": {"Euro", "€", "€"},
-        "FIM": {"Finnish Markka", "", ""},
-        "FJD": {"Fijian Dollar", "FJD", "$"},
-        "FKP": {"Falkland Islands Pound", "FKP", "£"},
-        "FRF": {"French Franc", "", ""},
-        "GBP": {"British Pound", "£", "£"},
-        "GEK": {"Georgian Kupon Larit", "", ""},
-        "GEL": {"Georgian Lari", "GEL", "₾"},
-        "GHC": {"Ghanaian Cedi (1979–2007)", "", ""},
-        "GHS": {"Ghanaian Cedi", "GHS", "GH₵"},
-        "GIP": {"Gibraltar Pound", "GIP", "£"},
-        "GMD": {"Gambian Dalasi", "GMD", ""},
-        "GNF": {"Guinean Franc", "GNF", "FG"},
-        "GNS": {"Guinean Syli", "", ""},
-        "GQE": {"Equatorial Guinean Ekwele", "", ""},
-        "GRD": {"Greek Drachma", "", ""},
-        "GTQ": {"Guatemalan Quetzal", "GTQ", "Q"},
-        "GWE": {"Portuguese Guinea Escudo", "", ""},
-        "GWP": {"Guinea-Bissau Peso", "", ""},
-        "GYD": {"Guyanaese Dollar", "GYD", "$"},
-        "HKD": {"Hong Kong Dollar", "HK$", "$"},
-        "HNL": {"Honduran Lempira", "HNL", "L"},
-        "HRD": {"Croatian Dinar", "", ""},
-        "HRK": {"Croatian Kuna", "HRK", "kn"},
-        "HTG": {"Haitian Gourde", "HTG", ""},
-        "HUF": {"Hungarian Forint", "HUF", "Ft"},
-        "IDR": {"Indonesian Rupiah", "IDR", "Rp"},
-        "IEP": {"Irish Pound", "", ""},
-        "ILP": {"Israeli Pound", "", ""},
-        "ILR": {"Israeli Shekel (1980–1985)", "", ""},
-        "ILS": {"Israeli New Shekel", "₪", "₪"},
-        "INR": {"Indian Rupee", "₹", "₹"},
-        "IQD": {"Iraqi Dinar", "IQD", ""},
-        "IRR": {"Iranian Rial", "IRR", ""},
-        "ISJ": {"Icelandic Króna (1918–1981)", "", ""},
-        "ISK": {"Icelandic Króna", "ISK", "kr"},
-        "ITL": {"Italian Lira", "", ""},
-        "JMD": {"Jamaican Dollar", "JMD", "$"},
-        "JOD": {"Jordanian Dinar", "JOD", ""},
-        "JPY": {"Japanese Yen", "¥", "¥"},
-        "KES": {"Kenyan Shilling", "KES", ""},
-        "KGS": {"Kyrgyz Som", "KGS", "⃀"},
-        "KHR": {"Cambodian Riel", "KHR", "៛"},
-        "KMF": {"Comorian Franc", "KMF", "CF"},
-        "KPW": {"North Korean Won", "KPW", "₩"},
-        "KRH": {"South Korean Hwan (1953–1962)", "", ""},
-        "KRO": {"South Korean Won (1945–1953)", "", ""},
-        "KRW": {"South Korean Won", "₩", "₩"},
-        "KWD": {"Kuwaiti Dinar", "KWD", ""},
-        "KYD": {"Cayman Islands Dollar", "KYD", "$"},
-        "KZT": {"Kazakhstani Tenge", "KZT", "₸"},
-        "LAK": {"Laotian Kip", "LAK", "₭"},
-        "LBP": {"Lebanese Pound", "LBP", "L£"},
-        "LKR": {"Sri Lankan Rupee", "LKR", "Rs"},
-        "LRD": {"Liberian Dollar", "LRD", "$"},
-        "LSL": {"Lesotho Loti", "LSL", ""},
-        "LTL": {"Lithuanian Litas", "", "Lt"},
-        "LTT": {"Lithuanian Talonas", "", ""},
-        "LUC": {"Luxembourgian Convertible Franc", "", ""},
-        "LUF": {"Luxembourgian Franc", "", ""},
-        "LUL": {"Luxembourg Financial Franc", "", ""},
-        "LVL": {"Latvian Lats", "", "Ls"},
-        "LVR": {"Latvian Ruble", "", ""},
-        "LYD": {"Libyan Dinar", "LYD", ""},
-        "MAD": {"Moroccan Dirham", "MAD", ""},
-        "MAF": {"Moroccan Franc", "", ""},
-        "MCF": {"Monegasque Franc", "", ""},
-        "MDC": {"Moldovan Cupon", "", ""},
-        "MDL": {"Moldovan Leu", "MDL", ""},
-        "MGA": {"Malagasy Ariary", "MGA", "Ar"},
-        "MGF": {"Malagasy Franc", "", ""},
-        "MKD": {"Macedonian Denar", "MKD", ""},
-        "MKN": {"Macedonian Denar (1992–1993)", "", ""},
-        "MLF": {"Malian Franc", "", ""},
-        "MMK": {"Myanmar Kyat", "MMK", "K"},
-        "MNT": {"Mongolian Tugrik", "MNT", "₮"},
-        "MOP": {"Macanese Pataca", "MOP", ""},
-        "MRO": {"Mauritanian Ouguiya (1973–2017)", "", ""},
-        "MRU": {"Mauritanian Ouguiya", "MRU", ""},
-        "MTL": {"Maltese Lira", "", ""},
-        "MTP": {"Maltese Pound", "", ""},
-        "MUR": {"Mauritian Rupee", "MUR", "Rs"},
-        "MVP": {"Maldivian Rupee (1947–1981)", "", ""},
-        "MVR": {"Maldivian Rufiyaa", "MVR", ""},
-        "MWK": {"Malawian Kwacha", "MWK", ""},
-        "MXN": {"Mexican Peso", "MX$", "$"},
-        "MXP": {"Mexican Silver Peso (1861–1992)", "", ""},
-        "MXV": {"Mexican Investment Unit", "", ""},
-        "MYR": {"Malaysian Ringgit", "MYR", "RM"},
-        "MZE": {"Mozambican Escudo", "", ""},
-        "MZM": {"Mozambican Metical (1980–2006)", "", ""},
-        "MZN": {"Mozambican Metical", "MZN", ""},
-        "NAD": {"Namibian Dollar", "NAD", "$"},
-        "NGN": {"Nigerian Naira", "NGN", "₦"},
-        "NIC": {"Nicaraguan Córdoba (1988–1991)", "", ""},
-        "NIO": {"Nicaraguan Córdoba", "NIO", "C$"},
-        "NLG": {"Dutch Guilder", "", ""},
-        "NOK": {"Norwegian Krone", "NOK", "kr"},
-        "NPR": {"Nepalese Rupee", "NPR", "Rs"},
-        "NZD": {"New Zealand Dollar", "NZ$", "$"},
-        "OMR": {"Omani Rial", "OMR", ""},
-        "PAB": {"Panamanian Balboa", "PAB", ""},
-        "PEI": {"Peruvian Inti", "", ""},
-        "PEN": {"Peruvian Sol", "PEN", ""},
-        "PES": {"Peruvian Sol (1863–1965)", "", ""},
-        "PGK": {"Papua New Guinean Kina", "PGK", ""},
-        "PHP": {"Philippine Peso", "₱", "₱"},
-        "PKR": {"Pakistani Rupee", "PKR", "Rs"},
-        "PLN": {"Polish Zloty", "PLN", "zł"},
-        "PLZ": {"Polish Zloty (1950–1995)", "", ""},
-        "PTE": {"Portuguese Escudo", "", ""},
-        "PYG": {"Paraguayan Guarani", "PYG", "₲"},
-        "QAR": {"Qatari Riyal", "QAR", ""},
-        "RHD": {"Rhodesian Dollar", "", ""},
-        "ROL": {"Romanian Leu (1952–2006)", "", ""},
-        "RON": {"Romanian Leu", "RON", "lei"},
-        "RSD": {"Serbian Dinar", "RSD", ""},
-        "RUB": {"Russian Ruble", "RUB", "₽"},
-        "RUR": {"Russian Ruble (1991–1998)", "", ""},
-        "RWF": {"Rwandan Franc", "RWF", "RF"},
-        "SAR": {"Saudi Riyal", "⃁", ""},
-        "SBD": {"Solomon Islands Dollar", "SBD", "$"},
-        "SCR": {"Seychellois Rupee", "SCR", ""},
-        "SDD": {"Sudanese Dinar (1992–2007)", "", ""},
-        "SDG": {"Sudanese Pound", "SDG", ""},
-        "SDP": {"Sudanese Pound (1957–1998)", "", ""},
-        "SEK": {"Swedish Krona", "SEK", "kr"},
-        "SGD": {"Singapore Dollar", "SGD", "$"},
-        "SHP": {"St. Helena Pound", "SHP", "£"},
-        "SIT": {"Slovenian Tolar", "", ""},
-        "SKK": {"Slovak Koruna", "", ""},
-        "SLE": {"Sierra Leonean Leone", "SLE", ""},
-        "SLL": {"Sierra Leonean Leone (1964—2022)", "SLL", ""},
-        "SOS": {"Somali Shilling", "SOS", ""},
-        "SRD": {"Surinamese Dollar", "SRD", "$"},
-        "SRG": {"Surinamese Guilder", "", ""},
-        "SSP": {"South Sudanese Pound", "SSP", "£"},
-        "STD": {"São Tomé & Príncipe Dobra (1977–2017)", "", ""},
-        "STN": {"São Tomé & Príncipe Dobra", "STN", "Db"},
-        "SUR": {"Soviet Rouble", "", ""},
-        "SVC": {"Salvadoran Colón", "", ""},
-        "SYP": {"Syrian Pound", "SYP", "£"},
-        "SZL": {"Swazi Lilangeni", "SZL", ""},
-        "THB": {"Thai Baht", "THB", "฿"},
-        "TJR": {"Tajikistani Ruble", "", ""},
-        "TJS": {"Tajikistani Somoni", "TJS", ""},
-        "TMM": {"Turkmenistani Manat (1993–2009)", "", ""},
-        "TMT": {"Turkmenistani Manat", "TMT", ""},
-        "TND": {"Tunisian Dinar", "TND", ""},
-        "TOP": {"Tongan Paʻanga", "TOP", "T$"},
-        "TPE": {"Timorese Escudo", "", ""},
-        "TRL": {"Turkish Lira (1922–2005)", "", ""},
-        "TRY": {"Turkish Lira", "TL", "₺"},
-        "TTD": {"Trinidad & Tobago Dollar", "TTD", "$"},
-        "TWD": {"New Taiwan Dollar", "NT$", "$"},
-        "TZS": {"Tanzanian Shilling", "TZS", ""},
-        "UAH": {"Ukrainian Hryvnia", "UAH", "₴"},
-        "UAK": {"Ukrainian Karbovanets", "", ""},
-        "UGS": {"Ugandan Shilling (1966–1987)", "", ""},
-        "UGX": {"Ugandan Shilling", "UGX", ""},
-        "USD": {"US Dollar", "$", "$"},
-        "USN": {"US Dollar (Next day)", "", ""},
-        "USS": {"US Dollar (Same day)", "", ""},
-        "UYI": {"Uruguayan Peso (Indexed Units)", "", ""},
-        "UYP": {"Uruguayan Peso (1975–1993)", "", ""},
-        "UYU": {"Uruguayan Peso", "UYU", "$"},
-        "UYW": {"Uruguayan Nominal Wage Index Unit", "", ""},
-        "UZS": {"Uzbekistani Som", "UZS", ""},
-        "VEB": {"Venezuelan Bolívar (1871–2008)", "", ""},
-        "VED": {"Bolívar Soberano", "", ""},
-        "VEF": {"Venezuelan Bolívar (2008–2018)", "", "Bs"},
-        "VES": {"Venezuelan Bolívar", "VES", ""},
-        "VND": {"Vietnamese Dong", "₫", "₫"},
-        "VNN": {"Vietnamese Dong (1978–1985)", "", ""},
-        "VUV": {"Vanuatu Vatu", "VUV", ""},
-        "WST": {"Samoan Tala", "WST", ""},
-        "XAF": {"Central African CFA Franc", "FCFA", ""},
-        "XAG": {"Silver", "", ""},
-        "XAU": {"Gold", "", ""},
-        "XBA": {"European Composite Unit", "", ""},
-        "XBB": {"European Monetary Unit", "", ""},
-        "XBC": {"European Unit of Account (XBC)", "", ""},
-        "XBD": {"European Unit of Account (XBD)", "", ""},
-        "XCD": {"East Caribbean Dollar", "EC$", "$"},
-        "XCG": {"Caribbean guilder", "Cg.", ""},
-        "XDR": {"Special Drawing Rights", "", ""},
-        "XEU": {"European Currency Unit", "", ""},
-        "XFO": {"French Gold Franc", "", ""},
-        "XFU": {"French UIC-Franc", "", ""},
-        "XOF": {"West African CFA Franc", "F CFA", ""},
-        "XPD": {"Palladium", "", ""},
-        "XPF": {"CFP Franc", "CFPF", ""},
-        "XPT": {"Platinum", "", ""},
-        "XRE": {"RINET Funds", "", ""},
-        "XSU": {"Sucre", "", ""},
-        "XTS": {"Testing Currency Code", "", ""},
-        "XUA": {"ADB Unit of Account", "", ""},
-        "XXX": {"Unknown Currency", "¤", ""},
-        "YDD": {"Yemeni Dinar", "", ""},
-        "YER": {"Yemeni Rial", "YER", ""},
-        "YUD": {"Yugoslavian Hard Dinar (1966–1990)", "", ""},
-        "YUM": {"Yugoslavian New Dinar (1994–2002)", "", ""},
-        "YUN": {"Yugoslavian Convertible Dinar (1990–1992)", "", ""},
-        "YUR": {"Yugoslavian Reformed Dinar (1992–1993)", "", ""},
-        "ZAL": {"South African Rand (financial)", "", ""},
-        "ZAR": {"South African Rand", "ZAR", "R"},
-        "ZMK": {"Zambian Kwacha (1968–2012)", "", ""},
-        "ZMW": {"Zambian Kwacha", "ZMW", "ZK"},
-        "ZRN": {"Zairean New Zaire (1993–1998)", "", ""},
-        "ZRZ": {"Zairean Zaire (1971–1993)", "", ""},
-        "ZWD": {"Zimbabwean Dollar (1980–2008)", "", ""},
-        "ZWG": {"Zimbabwean Gold", "ZWG", ""},
-        "ZWL": {"Zimbabwean Dollar (2009–2024)", "", ""},
-        "ZWR": {"Zimbabwean Dollar (2008)", "", ""},
+        "ADP": {"Andorran Peseta", "", "", Count{"", ""}, 0, 0},
+        "AED": {"United Arab Emirates Dirham", "AED", "", Count{"", ""}, 0, 0},
+        "AFA": {"Afghan Afghani (1927–2002)", "", "", Count{"", ""}, 0, 0},
+        "AFN": {"Afghan Afghani", "AFN", "؋", Count{"", ""}, 0, 0},
+        "ALK": {"Albanian Lek (1946–1965)", "", "", Count{"", ""}, 0, 0},
+        "ALL": {"Albanian Lek", "ALL", "", Count{"", ""}, 0, 0},
+        "AMD": {"Armenian Dram", "AMD", "֏", Count{"", ""}, 0, 0},
+        "ANG": {"Netherlands Antillean Guilder", "ANG", "", Count{"", ""}, 0, 0},
+        "AOA": {"Angolan Kwanza", "AOA", "Kz", Count{"", ""}, 0, 0},
+        "AOK": {"Angolan Kwanza (1977–1991)", "", "", Count{"", ""}, 0, 0},
+        "AON": {"Angolan New Kwanza (1990–2000)", "", "", Count{"", ""}, 0, 0},
+        "AOR": {"Angolan Readjusted Kwanza (1995–1999)", "", "", Count{"", ""}, 0, 0},
+        "ARA": {"Argentine Austral", "", "", Count{"", ""}, 0, 0},
+        "ARL": {"Argentine Peso Ley (1970–1983)", "", "", Count{"", ""}, 0, 0},
+        "ARM": {"Argentine Peso (1881–1970)", "", "", Count{"", ""}, 0, 0},
+        "ARP": {"Argentine Peso (1983–1985)", "", "", Count{"", ""}, 0, 0},
+        "ARS": {"Argentine Peso", "ARS", "$", Count{"Argentine peso", "Argentine pesos"}, 0, 0},
+        "ATS": {"Austrian Schilling", "", "", Count{"", ""}, 0, 0},
+        "AUD": {"Australian Dollar", "A$", "$", Count{"Australian dollar", "Australian dollars"}, 0, 0},
+        "AWG": {"Aruban Florin", "AWG", "", Count{"", ""}, 0, 0},
+        "AZM": {"Azerbaijani Manat (1993–2006)", "", "", Count{"", ""}, 0, 0},
+        "AZN": {"Azerbaijani Manat", "AZN", "₼", Count{"", ""}, 0, 0},
+        "BAD": {"Bosnia-Herzegovina Dinar (1992–1994)", "", "", Count{"", ""}, 0, 0},
+        "BAM": {"Bosnia-Herzegovina Convertible Mark", "BAM", "KM", Count{"", ""}, 0, 0},
+        "BAN": {"Bosnia-Herzegovina New Dinar (1994–1997)", "", "", Count{"", ""}, 0, 0},
+        "BBD": {"Barbadian Dollar", "BBD", "$", Count{"", ""}, 0, 0},
+        "BDT": {"Bangladeshi Taka", "BDT", "৳", Count{"", ""}, 0, 0},
+        "BEC": {"Belgian Franc (convertible)", "", "", Count{"", ""}, 0, 0},
+        "BEF": {"Belgian Franc", "", "", Count{"", ""}, 0, 0},
+        "BEL": {"Belgian Franc (financial)", "", "", Count{"", ""}, 0, 0},
+        "BGL": {"Bulgarian Hard Lev", "", "", Count{"", ""}, 0, 0},
+        "BGM": {"Bulgarian Socialist Lev", "", "", Count{"", ""}, 0, 0},
+        "BGN": {"Bulgarian Lev", "BGN", "", Count{"", ""}, 0, 0},
+        "BGO": {"Bulgarian Lev (1879–1952)", "", "", Count{"", ""}, 0, 0},
+        "BHD": {"Bahraini Dinar", "BHD", "", Count{"Bahraini dinar", "Bahraini dinars"}, 0, 0},
+        "BIF": {"Burundian Franc", "BIF", "", Count{"", ""}, 0, 0},
+        "BMD": {"Bermudan Dollar", "BMD", "$", Count{"", ""}, 0, 0},
+        "BND": {"Brunei Dollar", "BND", "$", Count{"", ""}, 0, 0},
+        "BOB": {"Bolivian Boliviano", "BOB", "Bs", Count{"", ""}, 0, 0},
+        "BOL": {"Bolivian Boliviano (1863–1963)", "", "", Count{"", ""}, 0, 0},
+        "BOP": {"Bolivian Peso", "", "", Count{"", ""}, 0, 0},
+        "BOV": {"Bolivian Mvdol", "", "", Count{"", ""}, 0, 0},
+        "BRB": {"Brazilian New Cruzeiro (1967–1986)", "", "", Count{"", ""}, 0, 0},
+        "BRC": {"Brazilian Cruzado (1986–1989)", "", "", Count{"", ""}, 0, 0},
+        "BRE": {"Brazilian Cruzeiro (1990–1993)", "", "", Count{"", ""}, 0, 0},
+        "BRL": {"Brazilian Real", "R$", "R$", Count{"Brazilian real", "Brazilian reals"}, 0, 0},
+        "BRN": {"Brazilian New Cruzado (1989–1990)", "", "", Count{"", ""}, 0, 0},
+        "BRR": {"Brazilian Cruzeiro (1993–1994)", "", "", Count{"", ""}, 0, 0},
+        "BRZ": {"Brazilian Cruzeiro (1942–1967)", "", "", Count{"", ""}, 0, 0},
+        "BSD": {"Bahamian Dollar", "BSD", "$", Count{"", ""}, 0, 0},
+        "BTN": {"Bhutanese Ngultrum", "BTN", "", Count{"", ""}, 0, 0},
+        "BUK": {"Burmese Kyat", "", "", Count{"", ""}, 0, 0},
+        "BWP": {"Botswanan Pula", "BWP", "P", Count{"", ""}, 0, 0},
+        "BYB": {"Belarusian Ruble (1994–1999)", "", "", Count{"", ""}, 0, 0},
+        "BYN": {"Belarusian Ruble", "BYN", "", Count{"", ""}, 0, 0},
+        "BYR": {"Belarusian Ruble (2000–2016)", "", "", Count{"", ""}, 0, 0},
+        "BZD": {"Belize Dollar", "BZD", "$", Count{"", ""}, 0, 0},
+        "CAD": {"Canadian Dollar", "CA$", "$", Count{"Canadian dollar", "Canadian dollars"}, 0, 0},
+        "CDF": {"Congolese Franc", "CDF", "", Count{"", ""}, 0, 0},
+        "CHE": {"WIR Euro", "", "", Count{"", ""}, 0, 0},
+        "CHF": {"Swiss Franc", "CHF", "", Count{"Swiss franc", "Swiss francs"}, 0, 0},
+        "CHW": {"WIR Franc", "", "", Count{"", ""}, 0, 0},
+        "CLE": {"Chilean Escudo", "", "", Count{"", ""}, 0, 0},
+        "CLF": {"Chilean Unit of Account (UF)", "", "", Count{"", ""}, 0, 0},
+        "CLP": {"Chilean Peso", "CLP", "$", Count{"Chilean peso", "Chilean pesos"}, 0, 0},
+        "CNH": {"Chinese Yuan (offshore)", "CNH", "", Count{"", ""}, 0, 0},
+        "CNX": {"Chinese People’s Bank Dollar", "", "", Count{"", ""}, 0, 0},
+        "CNY": {"Chinese Yuan", "CN¥", "¥", Count{"Chinese yuan", "Chinese yuan"}, 0, 0},
+        "COP": {"Colombian Peso", "COP", "$", Count{"Colombian peso", "Colombian pesos"}, 0, 0},
+        "COU": {"Colombian Real Value Unit", "", "", Count{"", ""}, 0, 0},
+        "CRC": {"Costa Rican Colón", "CRC", "₡", Count{"", ""}, 0, 0},
+        "CSD": {"Serbian Dinar (2002–2006)", "", "", Count{"", ""}, 0, 0},
+        "CSK": {"Czechoslovak Hard Koruna", "", "", Count{"", ""}, 0, 0},
+        "CUC": {"Cuban Convertible Peso", "CUC", "$", Count{"", ""}, 0, 0},
+        "CUP": {"Cuban Peso", "CUP", "$", Count{"", ""}, 0, 0},
+        "CVE": {"Cape Verdean Escudo", "CVE", "", Count{"Cape Verdean escudo", "Cape Verdean escudos"}, 0, 0},
+        "CYP": {"Cypriot Pound", "", "", Count{"", ""}, 0, 0},
+        "CZK": {"Czech Koruna", "CZK", "Kč", Count{"", ""}, 0, 0},
+        "DDM": {"East German Mark", "", "", Count{"", ""}, 0, 0},
+        "DEM": {"German Mark", "", "", Count{"", ""}, 0, 0},
+        "DJF": {"Djiboutian Franc", "DJF", "", Count{"", ""}, 0, 0},
+        "DKK": {"Danish Krone", "DKK", "kr", Count{"Danish krone", "Danish kroner"}, 0, 0},
+        "DOP": {"Dominican Peso", "DOP", "$", Count{"", ""}, 0, 0},
+        "DZD": {"Algerian Dinar", "DZD", "", Count{"", ""}, 0, 0},
+        "ECS": {"Ecuadorian Sucre", "", "", Count{"", ""}, 0, 0},
+        "ECV": {"Ecuadorian Unit of Constant Value", "", "", Count{"", ""}, 0, 0},
+        "EEK": {"Estonian Kroon", "", "", Count{"", ""}, 0, 0},
+        "EGP": {"Egyptian Pound", "EGP", "E£", Count{"", ""}, 0, 0},
+        "ERN": {"Eritrean Nakfa", "ERN", "", Count{"", ""}, 0, 0},
+        "ESA": {"Spanish Peseta (A account)", "", "", Count{"", ""}, 0, 0},
+        "ESB": {"Spanish Peseta (convertible account)", "", "", Count{"", ""}, 0, 0},
+        "ESP": {"Spanish Peseta", "", "₧", Count{"", ""}, 0, 0},
+        "ETB": {"Ethiopian Birr", "ETB", "", Count{"", ""}, 0, 0},
+        "EUR": {"Euro", "€", "€", Count{"euro", "euros"}, 0, 0},
+        "FIM": {"Finnish Markka", "", "", Count{"", ""}, 0, 0},
+        "FJD": {"Fijian Dollar", "FJD", "$", Count{"", ""}, 0, 0},
+        "FKP": {"Falkland Islands Pound", "FKP", "£", Count{"", ""}, 0, 0},
+        "FRF": {"French Franc", "", "", Count{"", ""}, 0, 0},
+        "GBP": {"British Pound", "£", "£", Count{"British pound", "British pounds"}, 0, 0},
+        "GEK": {"Georgian Kupon Larit", "", "", Count{"", ""}, 0, 0},
+        "GEL": {"Georgian Lari", "GEL", "₾", Count{"", ""}, 0, 0},
+        "GHC": {"Ghanaian Cedi (1979–2007)", "", "", Count{"", ""}, 0, 0},
+        "GHS": {"Ghanaian Cedi", "GHS", "GH₵", Count{"", ""}, 0, 0},
+        "GIP": {"Gibraltar Pound", "GIP", "£", Count{"", ""}, 0, 0},
+        "GMD": {"Gambian Dalasi", "GMD", "", Count{"", ""}, 0, 0},
+        "GNF": {"Guinean Franc", "GNF", "FG", Count{"", ""}, 0, 0},
+        "GNS": {"Guinean Syli", "", "", Count{"", ""}, 0, 0},
+        "GQE": {"Equatorial Guinean Ekwele", "", "", Count{"", ""}, 0, 0},
+        "GRD": {"Greek Drachma", "", "", Count{"", ""}, 0, 0},
+        "GTQ": {"Guatemalan Quetzal", "GTQ", "Q", Count{"", ""}, 0, 0},
+        "GWE": {"Portuguese Guinea Escudo", "", "", Count{"", ""}, 0, 0},
+        "GWP": {"Guinea-Bissau Peso", "", "", Count{"", ""}, 0, 0},
+        "GYD": {"Guyanaese Dollar", "GYD", "$", Count{"", ""}, 0, 0},
+        "HKD": {"Hong Kong Dollar", "HK$", "$", Count{"", ""}, 0, 0},
+        "HNL": {"Honduran Lempira", "HNL", "L", Count{"", ""}, 0, 0},
+        "HRD": {"Croatian Dinar", "", "", Count{"", ""}, 0, 0},
+        "HRK": {"Croatian Kuna", "HRK", "kn", Count{"", ""}, 0, 0},
+        "HTG": {"Haitian Gourde", "HTG", "", Count{"", ""}, 0, 0},
+        "HUF": {"Hungarian Forint", "HUF", "Ft", Count{"", ""}, 0, 0},
+        "IDR": {"Indonesian Rupiah", "IDR", "Rp", Count{"", ""}, 0, 0},
+        "IEP": {"Irish Pound", "", "", Count{"", ""}, 0, 0},
+        "ILP": {"Israeli Pound", "", "", Count{"", ""}, 0, 0},
+        "ILR": {"Israeli Shekel (1980–1985)", "", "", Count{"", ""}, 0, 0},
+        "ILS": {"Israeli New Shekel", "₪", "₪", Count{"", ""}, 0, 0},
+        "INR": {"Indian Rupee", "₹", "₹", Count{"", ""}, 0, 0},
+        "IQD": {"Iraqi Dinar", "IQD", "", Count{"", ""}, 0, 0},
+        "IRR": {"Iranian Rial", "IRR", "", Count{"", ""}, 0, 0},
+        "ISJ": {"Icelandic Króna (1918–1981)", "", "", Count{"", ""}, 0, 0},
+        "ISK": {"Icelandic Króna", "ISK", "kr", Count{"", ""}, 0, 0},
+        "ITL": {"Italian Lira", "", "", Count{"", ""}, 0, 0},
+        "JMD": {"Jamaican Dollar", "JMD", "$", Count{"", ""}, 0, 0},
+        "JOD": {"Jordanian Dinar", "JOD", "", Count{"", ""}, 0, 0},
+        "JPY": {"Japanese Yen", "¥", "¥", Count{"Japanese yen", "Japanese yen"}, 0, 0},
+        "KES": {"Kenyan Shilling", "KES", "", Count{"", ""}, 0, 0},
+        "KGS": {"Kyrgyz Som", "KGS", "⃀", Count{"", ""}, 0, 0},
+        "KHR": {"Cambodian Riel", "KHR", "៛", Count{"", ""}, 0, 0},
+        "KMF": {"Comorian Franc", "KMF", "CF", Count{"", ""}, 0, 0},
+        "KPW": {"North Korean Won", "KPW", "₩", Count{"", ""}, 0, 0},
+        "KRH": {"South Korean Hwan (1953–1962)", "", "", Count{"", ""}, 0, 0},
+        "KRO": {"South Korean Won (1945–1953)", "", "", Count{"", ""}, 0, 0},
+        "KRW": {"South Korean Won", "₩", "₩", Count{"", ""}, 0, 0},
+        "KWD": {"Kuwaiti Dinar", "KWD", "", Count{"Kuwaiti dinar", "Kuwaiti dinars"}, 0, 0},
+        "KYD": {"Cayman Islands Dollar", "KYD", "$", Count{"", ""}, 0, 0},
+        "KZT": {"Kazakhstani Tenge", "KZT", "₸", Count{"", ""}, 0, 0},
+        "LAK": {"Laotian Kip", "LAK", "₭", Count{"", ""}, 0, 0},
+        "LBP": {"Lebanese Pound", "LBP", "L£", Count{"", ""}, 0, 0},
+        "LKR": {"Sri Lankan Rupee", "LKR", "Rs", Count{"", ""}, 0, 0},
+        "LRD": {"Liberian Dollar", "LRD", "$", Count{"", ""}, 0, 0},
+        "LSL": {"Lesotho Loti", "LSL", "", Count{"", ""}, 0, 0},
+        "LTL": {"Lithuanian Litas", "", "Lt", Count{"", ""}, 0, 0},
+        "LTT": {"Lithuanian Talonas", "", "", Count{"", ""}, 0, 0},
+        "LUC": {"Luxembourgian Convertible Franc", "", "", Count{"", ""}, 0, 0},
+        "LUF": {"Luxembourgian Franc", "", "", Count{"", ""}, 0, 0},
+        "LUL": {"Luxembourg Financial Franc", "", "", Count{"", ""}, 0, 0},
+        "LVL": {"Latvian Lats", "", "Ls", Count{"", ""}, 0, 0},
+        "LVR": {"Latvian Ruble", "", "", Count{"", ""}, 0, 0},
+        "LYD": {"Libyan Dinar", "LYD", "", Count{"", ""}, 0, 0},
+        "MAD": {"Moroccan Dirham", "MAD", "", Count{"", ""}, 0, 0},
+        "MAF": {"Moroccan Franc", "", "", Count{"", ""}, 0, 0},
+        "MCF": {"Monegasque Franc", "", "", Count{"", ""}, 0, 0},
+        "MDC": {"Moldovan Cupon", "", "", Count{"", ""}, 0, 0},
+        "MDL": {"Moldovan Leu", "MDL", "", Count{"", ""}, 0, 0},
+        "MGA": {"Malagasy Ariary", "MGA", "Ar", Count{"", ""}, 0, 0},
+        "MGF": {"Malagasy Franc", "", "", Count{"", ""}, 0, 0},
+        "MKD": {"Macedonian Denar", "MKD", "", Count{"", ""}, 0, 0},
+        "MKN": {"Macedonian Denar (1992–1993)", "", "", Count{"", ""}, 0, 0},
+        "MLF": {"Malian Franc", "", "", Count{"", ""}, 0, 0},
+        "MMK": {"Myanmar Kyat", "MMK", "K", Count{"", ""}, 0, 0},
+        "MNT": {"Mongolian Tugrik", "MNT", "₮", Count{"", ""}, 0, 0},
+        "MOP": {"Macanese Pataca", "MOP", "", Count{"", ""}, 0, 0},
+        "MRO": {"Mauritanian Ouguiya (1973–2017)", "", "", Count{"", ""}, 0, 0},
+        "MRU": {"Mauritanian Ouguiya", "MRU", "", Count{"", ""}, 0, 0},
+        "MTL": {"Maltese Lira", "", "", Count{"", ""}, 0, 0},
+        "MTP": {"Maltese Pound", "", "", Count{"", ""}, 0, 0},
+        "MUR": {"Mauritian Rupee", "MUR", "Rs", Count{"", ""}, 0, 0},
+        "MVP": {"Maldivian Rupee (1947–1981)", "", "", Count{"", ""}, 0, 0},
+        "MVR": {"Maldivian Rufiyaa", "MVR", "", Count{"", ""}, 0, 0},
+        "MWK": {"Malawian Kwacha", "MWK", "", Count{"", ""}, 0, 0},
+        "MXN": {"Mexican Peso", "MX$", "$", Count{"Mexican peso", "Mexican pesos"}, 0, 0},
+        "MXP": {"Mexican Silver Peso (1861–1992)", "", "", Count{"", ""}, 0, 0},
+        "MXV": {"Mexican Investment Unit", "", "", Count{"", ""}, 0, 0},
+        "MYR": {"Malaysian Ringgit", "MYR", "RM", Count{"", ""}, 0, 0},
+        "MZE": {"Mozambican Escudo", "", "", Count{"", ""}, 0, 0},
+        "MZM": {"Mozambican Metical (1980–2006)", "", "", Count{"", ""}, 0, 0},
+        "MZN": {"Mozambican Metical", "MZN", "", Count{"", ""}, 0, 0},
+        "NAD": {"Namibian Dollar", "NAD", "$", Count{"", ""}, 0, 0},
+        "NGN": {"Nigerian Naira", "NGN", "₦", Count{"", ""}, 0, 0},
+        "NIC": {"Nicaraguan Córdoba (1988–1991)", "", "", Count{"", ""}, 0, 0},
+        "NIO": {"Nicaraguan Córdoba", "NIO", "C$", Count{"", ""}, 0, 0},
+        "NLG": {"Dutch Guilder", "", "", Count{"", ""}, 0, 0},
+        "NOK": {"Norwegian Krone", "NOK", "kr", Count{"Norwegian krone", "Norwegian kroner"}, 0, 0},
+        "NPR": {"Nepalese Rupee", "NPR", "Rs", Count{"", ""}, 0, 0},
+        "NZD": {"New Zealand Dollar", "NZ$", "$", Count{"", ""}, 0, 0},
+        "OMR": {"Omani Rial", "OMR", "", Count{"", ""}, 0, 0},
+        "PAB": {"Panamanian Balboa", "PAB", "", Count{"", ""}, 0, 0},
+        "PEI": {"Peruvian Inti", "", "", Count{"", ""}, 0, 0},
+        "PEN": {"Peruvian Sol", "PEN", "", Count{"", ""}, 0, 0},
+        "PES": {"Peruvian Sol (1863–1965)", "", "", Count{"", ""}, 0, 0},
+        "PGK": {"Papua New Guinean Kina", "PGK", "", Count{"", ""}, 0, 0},
+        "PHP": {"Philippine Peso", "₱", "₱", Count{"", ""}, 0, 0},
+        "PKR": {"Pakistani Rupee", "PKR", "Rs", Count{"", ""}, 0, 0},
+        "PLN": {"Polish Zloty", "PLN", "zł", Count{"", ""}, 0, 0},
+        "PLZ": {"Polish Zloty (1950–1995)", "", "", Count{"", ""}, 0, 0},
+        "PTE": {"Portuguese Escudo", "", "", Count{"Portuguese escudo", "Portuguese escudos"}, 0, 0},
+        "PYG": {"Paraguayan Guarani", "PYG", "₲", Count{"", ""}, 0, 0},
+        "QAR": {"Qatari Riyal", "QAR", "", Count{"", ""}, 0, 0},
+        "RHD": {"Rhodesian Dollar", "", "", Count{"", ""}, 0, 0},
+        "ROL": {"Romanian Leu (1952–2006)", "", "", Count{"", ""}, 0, 0},
+        "RON": {"Romanian Leu", "RON", "lei", Count{"", ""}, 0, 0},
+        "RSD": {"Serbian Dinar", "RSD", "", Count{"", ""}, 0, 0},
+        "RUB": {"Russian Ruble", "RUB", "₽", Count{"", ""}, 0, 0},
+        "RUR": {"Russian Ruble (1991–1998)", "", "", Count{"", ""}, 0, 0},
+        "RWF": {"Rwandan Franc", "RWF", "RF", Count{"", ""}, 0, 0},
+        "SAR": {"Saudi Riyal", "⃁", "", Count{"", ""}, 0, 0},
+        "SBD": {"Solomon Islands Dollar", "SBD", "$", Count{"", ""}, 0, 0},
+        "SCR": {"Seychellois Rupee", "SCR", "", Count{"", ""}, 0, 0},
+        "SDD": {"Sudanese Dinar (1992–2007)", "", "", Count{"", ""}, 0, 0},
+        "SDG": {"Sudanese Pound", "SDG", "", Count{"", ""}, 0, 0},
+        "SDP": {"Sudanese Pound (1957–1998)", "", "", Count{"", ""}, 0, 0},
+        "SEK": {"Swedish Krona", "SEK", "kr", Count{"Swedish krona", "Swedish kronor"}, 0, 0},
+        "SGD": {"Singapore Dollar", "SGD", "$", Count{"", ""}, 0, 0},
+        "SHP": {"St. Helena Pound", "SHP", "£", Count{"", ""}, 0, 0},
+        "SIT": {"Slovenian Tolar", "", "", Count{"", ""}, 0, 0},
+        "SKK": {"Slovak Koruna", "", "", Count{"", ""}, 0, 0},
+        "SLE": {"Sierra Leonean Leone", "SLE", "", Count{"", ""}, 0, 0},
+        "SLL": {"Sierra Leonean Leone (1964—2022)", "SLL", "", Count{"", ""}, 0, 0},
+        "SOS": {"Somali Shilling", "SOS", "", Count{"", ""}, 0, 0},
+        "SRD": {"Surinamese Dollar", "SRD", "$", Count{"", ""}, 0, 0},
+        "SRG": {"Surinamese Guilder", "", "", Count{"", ""}, 0, 0},
+        "SSP": {"South Sudanese Pound", "SSP", "£", Count{"", ""}, 0, 0},
+        "STD": {"São Tomé & Príncipe Dobra (1977–2017)", "", "", Count{"", ""}, 0, 0},
+        "STN": {"São Tomé & Príncipe Dobra", "STN", "Db", Count{"", ""}, 0, 0},
+        "SUR": {"Soviet Rouble", "", "", Count{"", ""}, 0, 0},
+        "SVC": {"Salvadoran Colón", "", "", Count{"", ""}, 0, 0},
+        "SYP": {"Syrian Pound", "SYP", "£", Count{"", ""}, 0, 0},
+        "SZL": {"Swazi Lilangeni", "SZL", "", Count{"", ""}, 0, 0},
+        "THB": {"Thai Baht", "THB", "฿", Count{"", ""}, 0, 0},
+        "TJR": {"Tajikistani Ruble", "", "", Count{"", ""}, 0, 0},
+        "TJS": {"Tajikistani Somoni", "TJS", "", Count{"", ""}, 0, 0},
+        "TMM": {"Turkmenistani Manat (1993–2009)", "", "", Count{"", ""}, 0, 0},
+        "TMT": {"Turkmenistani Manat", "TMT", "", Count{"", ""}, 0, 0},
+        "TND": {"Tunisian Dinar", "TND", "", Count{"", ""}, 0, 0},
+        "TOP": {"Tongan Paʻanga", "TOP", "T$", Count{"", ""}, 0, 0},
+        "TPE": {"Timorese Escudo", "", "", Count{"", ""}, 0, 0},
+        "TRL": {"Turkish Lira (1922–2005)", "", "", Count{"", ""}, 0, 0},
+        "TRY": {"Turkish Lira", "TL", "₺", Count{"", ""}, 0, 0},
+        "TTD": {"Trinidad & Tobago Dollar", "TTD", "$", Count{"", ""}, 0, 0},
+        "TWD": {"New Taiwan Dollar", "NT$", "$", Count{"", ""}, 0, 0},
+        "TZS": {"Tanzanian Shilling", "TZS", "", Count{"", ""}, 0, 0},
+        "UAH": {"Ukrainian Hryvnia", "UAH", "₴", Count{"", ""}, 0, 0},
+        "UAK": {"Ukrainian Karbovanets", "", "", Count{"", ""}, 0, 0},
+        "UGS": {"Ugandan Shilling (1966–1987)", "", "", Count{"", ""}, 0, 0},
+        "UGX": {"Ugandan Shilling", "UGX", "", Count{"", ""}, 0, 0},
+        "USD": {"US Dollar", "$", "$", Count{"US dollar", "US dollars"}, 0, 0},
+        "USN": {"US Dollar (Next day)", "", "", Count{"", ""}, 0, 0},
+        "USS": {"US Dollar (Same day)", "", "", Count{"", ""}, 0, 0},
+        "UYI": {"Uruguayan Peso (Indexed Units)", "", "", Count{"", ""}, 0, 0},
+        "UYP": {"Uruguayan Peso (1975–1993)", "", "", Count{"", ""}, 0, 0},
+        "UYU": {"Uruguayan Peso", "UYU", "$", Count{"", ""}, 0, 0},
+        "UYW": {"Uruguayan Nominal Wage Index Unit", "", "", Count{"", ""}, 0, 0},
+        "UZS": {"Uzbekistani Som", "UZS", "", Count{"", ""}, 0, 0},
+        "VEB": {"Venezuelan Bolívar (1871–2008)", "", "", Count{"", ""}, 0, 0},
+        "VED": {"Bolívar Soberano", "", "", Count{"", ""}, 0, 0},
+        "VEF": {"Venezuelan Bolívar (2008–2018)", "", "Bs", Count{"", ""}, 0, 0},
+        "VES": {"Venezuelan Bolívar", "VES", "", Count{"", ""}, 0, 0},
+        "VND": {"Vietnamese Dong", "₫", "₫", Count{"", ""}, 0, 0},
+        "VNN": {"Vietnamese Dong (1978–1985)", "", "", Count{"", ""}, 0, 0},
+        "VUV": {"Vanuatu Vatu", "VUV", "", Count{"", ""}, 0, 0},
+        "WST": {"Samoan Tala", "WST", "", Count{"", ""}, 0, 0},
+        "XAF": {"Central African CFA Franc", "FCFA", "", Count{"", ""}, 0, 0},
+        "XAG": {"Silver", "", "", Count{"", ""}, 0, 0},
+        "XAU": {"Gold", "", "", Count{"", ""}, 0, 0},
+        "XBA": {"European Composite Unit", "", "", Count{"", ""}, 0, 0},
+        "XBB": {"European Monetary Unit", "", "", Count{"", ""}, 0, 0},
+        "XBC": {"European Unit of Account (XBC)", "", "", Count{"", ""}, 0, 0},
+        "XBD": {"European Unit of Account (XBD)", "", "", Count{"", ""}, 0, 0},
+        "XCD": {"East Caribbean Dollar", "EC$", "$", Count{"", ""}, 0, 0},
+        "XCG": {"Caribbean guilder", "Cg.", "", Count{"", ""}, 0, 0},
+        "XDR": {"Special Drawing Rights", "", "", Count{"", ""}, 0, 0},
+        "XEU": {"European Currency Unit", "", "", Count{"", ""}, 0, 0},
+        "XFO": {"French Gold Franc", "", "", Count{"", ""}, 0, 0},
+        "XFU": {"French UIC-Franc", "", "", Count{"", ""}, 0, 0},
+        "XOF": {"West African CFA Franc", "F CFA", "", Count{"", ""}, 0, 0},
+        "XPD": {"Palladium", "", "", Count{"", ""}, 0, 0},
+        "XPF": {"CFP Franc", "CFPF", "", Count{"", ""}, 0, 0},
+        "XPT": {"Platinum", "", "", Count{"", ""}, 0, 0},
+        "XRE": {"RINET Funds", "", "", Count{"", ""}, 0, 0},
+        "XSU": {"Sucre", "", "", Count{"", ""}, 0, 0},
+        "XTS": {"Testing Currency Code", "", "", Count{"", ""}, 0, 0},
+        "XUA": {"ADB Unit of Account", "", "", Count{"", ""}, 0, 0},
+        "XXX": {"Unknown Currency", "¤", "", Count{"", ""}, 0, 0},
+        "YDD": {"Yemeni Dinar", "", "", Count{"", ""}, 0, 0},
+        "YER": {"Yemeni Rial", "YER", "", Count{"", ""}, 0, 0},
+        "YUD": {"Yugoslavian Hard Dinar (1966–1990)", "", "", Count{"", ""}, 0, 0},
+        "YUM": {"Yugoslavian New Dinar (1994–2002)", "", "", Count{"", ""}, 0, 0},
+        "YUN": {"Yugoslavian Convertible Dinar (1990–1992)", "", "", Count{"", ""}, 0, 0},
+        "YUR": {"Yugoslavian Reformed Dinar (1992–1993)", "", "", Count{"", ""}, 0, 0},
+        "ZAL": {"South African Rand (financial)", "", "", Count{"", ""}, 0, 0},
+        "ZAR": {"South African Rand", "ZAR", "R", Count{"", ""}, 0, 0},
+        "ZMK": {"Zambian Kwacha (1968–2012)", "", "", Count{"", ""}, 0, 0},
+        "ZMW": {"Zambian Kwacha", "ZMW", "ZK", Count{"", ""}, 0, 0},
+        "ZRN": {"Zairean New Zaire (1993–1998)", "", "", Count{"", ""}, 0, 0},
+        "ZRZ": {"Zairean Zaire (1971–1993)", "", "", Count{"", ""}, 0, 0},
+        "ZWD": {"Zimbabwean Dollar (1980–2008)", "", "", Count{"", ""}, 0, 0},
+        "ZWG": {"Zimbabwean Gold", "ZWG", "", Count{"", ""}, 0, 0},
+        "ZWL": {"Zimbabwean Dollar (2009–2024)", "", "", Count{"", ""}, 0, 0},
+        "ZWR": {"Zimbabwean Dollar (2008)", "", "", Count{"", ""}, 0, 0},
     }, map[string]Unit{
         "duration-century": {Count{"{0} century", "{0} centuries"}, Count{"{0} c", "{0} c"}, Count{"{0}c", "{0}c"}},
         "duration-day": {Count{"{0} day", "{0} days"}, Count{"{0} day", "{0} days"}, Count{"{0}d", "{0}d"}},
@@ -1148,8 +1230,257 @@ var locales = map[string]Locale{
         "ZM": "Zambia",
         "ZW": "Zimbabwe",
         "ZZ": "Unknown Region",
-    }},
-    "es": {"#,##0.###", CurrencyFormat{"#,##0.00 ¤", "#,##0.00", "#,##0.00 ¤"}, CalendarFormat{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy"}, CalendarFormat{"H:mm:ss (zzzz)", "H:mm:ss z", "H:mm:ss", "H:mm"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
+    }, ListPattern{"{0}, {1}", "{0}, {1}", "{0}, and {1}", "{0} and {1}"}, CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}}, "#,##0%", 37, map[string]RelativeTime{
+        "day": {map[string]string{
+            "-1": "yesterday",
+            "0": "today",
+            "1": "tomorrow",
+        }, Count{"in {0} day", "in {0} days"}, Count{"{0} day ago", "{0} days ago"}},
+        "day-narrow": {map[string]string{
+            "-1": "yesterday",
+            "0": "today",
+            "1": "tomorrow",
+        }, Count{"in {0}d", "in {0}d"}, Count{"{0}d ago", "{0}d ago"}},
+        "day-short": {map[string]string{
+            "-1": "yesterday",
+            "0": "today",
+            "1": "tomorrow",
+        }, Count{"in {0} day", "in {0} days"}, Count{"{0} day ago", "{0} days ago"}},
+        "hour": {map[string]string{
+            "0": "this hour",
+        }, Count{"in {0} hour", "in {0} hours"}, Count{"{0} hour ago", "{0} hours ago"}},
+        "hour-narrow": {map[string]string{
+            "0": "this hour",
+        }, Count{"in {0}h", "in {0}h"}, Count{"{0}h ago", "{0}h ago"}},
+        "hour-short": {map[string]string{
+            "0": "this hour",
+        }, Count{"in {0} hr.", "in {0} hr."}, Count{"{0} hr. ago", "{0} hr. ago"}},
+        "minute": {map[string]string{
+            "0": "this minute",
+        }, Count{"in {0} minute", "in {0} minutes"}, Count{"{0} minute ago", "{0} minutes ago"}},
+        "minute-narrow": {map[string]string{
+            "0": "this minute",
+        }, Count{"in {0}m", "in {0}m"}, Count{"{0}m ago", "{0}m ago"}},
+        "minute-short": {map[string]string{
+            "0": "this minute",
+        }, Count{"in {0} min.", "in {0} min."}, Count{"{0} min. ago", "{0} min. ago"}},
+        "month": {map[string]string{
+            "-1": "last month",
+            "0": "this month",
+            "1": "next month",
+        }, Count{"in {0} month", "in {0} months"}, Count{"{0} month ago", "{0} months ago"}},
+        "month-narrow": {map[string]string{
+            "-1": "last mo",
+            "0": "this mo",
+            "1": "next mo",
+        }, Count{"in {0}mo", "in {0}mo"}, Count{"{0}mo ago", "{0}mo ago"}},
+        "month-short": {map[string]string{
+            "-1": "last mo.",
+            "0": "this mo.",
+            "1": "next mo.",
+        }, Count{"in {0} mo.", "in {0} mo."}, Count{"{0} mo. ago", "{0} mo. ago"}},
+        "quarter": {map[string]string{
+            "-1": "last quarter",
+            "0": "this quarter",
+            "1": "next quarter",
+        }, Count{"in {0} quarter", "in {0} quarters"}, Count{"{0} quarter ago", "{0} quarters ago"}},
+        "quarter-narrow": {map[string]string{
+            "-1": "last qtr.",
+            "0": "this qtr.",
+            "1": "next qtr.",
+        }, Count{"in {0}q", "in {0}q"}, Count{"{0}q ago", "{0}q ago"}},
+        "quarter-short": {map[string]string{
+            "-1": "last qtr.",
+            "0": "this qtr.",
+            "1": "next qtr.",
+        }, Count{"in {0} qtr.", "in {0} qtrs."}, Count{"{0} qtr. ago", "{0} qtrs. ago"}},
+        "second": {map[string]string{
+            "0": "now",
+        }, Count{"in {0} second", "in {0} seconds"}, Count{"{0} second ago", "{0} seconds ago"}},
+        "second-narrow": {map[string]string{
+            "0": "now",
+        }, Count{"in {0}s", "in {0}s"}, Count{"{0}s ago", "{0}s ago"}},
+        "second-short": {map[string]string{
+            "0": "now",
+        }, Count{"in {0} sec.", "in {0} sec."}, Count{"{0} sec. ago", "{0} sec. ago"}},
+        "week": {map[string]string{
+            "-1": "last week",
+            "0": "this week",
+            "1": "next week",
+        }, Count{"in {0} week", "in {0} weeks"}, Count{"{0} week ago", "{0} weeks ago"}},
+        "week-narrow": {map[string]string{
+            "-1": "last wk",
+            "0": "this wk",
+            "1": "next wk",
+        }, Count{"in {0}w", "in {0}w"}, Count{"{0}w ago", "{0}w ago"}},
+        "week-short": {map[string]string{
+            "-1": "last wk.",
+            "0": "this wk.",
+            "1": "next wk.",
+        }, Count{"in {0} wk.", "in {0} wk."}, Count{"{0} wk. ago", "{0} wk. ago"}},
+        "year": {map[string]string{
+            "-1": "last year",
+            "0": "this year",
+            "1": "next year",
+        }, Count{"in {0} year", "in {0} years"}, Count{"{0} year ago", "{0} years ago"}},
+        "year-narrow": {map[string]string{
+            "-1": "last yr",
+            "0": "this yr",
+            "1": "next yr",
+        }, Count{"in {0}y", "in {0}y"}, Count{"{0}y ago", "{0}y ago"}},
+        "year-short": {map[string]string{
+            "-1": "last yr.",
+            "0": "this yr.",
+            "1": "next yr.",
+        }, Count{"in {0} yr.", "in {0} yr."}, Count{"{0} yr. ago", "{0} yr. ago"}},
+    }, map[string]string{
+        "Bh": "h B",
+        "Bhm": "h:mm B",
+        "Bhms": "h:mm:ss B",
+        "E": "ccc",
+        "EBhm": "E h:mm B",
+        "EBhms": "E h:mm:ss B",
+        "EHm": "E HH:mm",
+        "EHms": "E HH:mm:ss",
+        "Ed": "d E",
+        "Ehm": "E h:mm a",
+        "Ehms": "E h:mm:ss a",
+        "Gy": "y G",
+        "GyMMM": "MMM y G",
+        "GyMMMEd": "E, MMM d, y G",
+        "GyMMMd": "MMM d, y G",
+        "GyMd": "M/d/y G",
+        "H": "HH",
+        "Hm": "HH:mm",
+        "Hms": "HH:mm:ss",
+        "Hmsv": "HH:mm:ss v",
+        "Hmv": "HH:mm v",
+        "M": "L",
+        "MEd": "E, M/d",
+        "MMM": "LLL",
+        "MMMEd": "E, MMM d",
+        "MMMMd": "MMMM d",
+        "MMMd": "MMM d",
+        "Md": "M/d",
+        "d": "d",
+        "h": "h a",
+        "hm": "h:mm a",
+        "hms": "h:mm:ss a",
+        "hmsv": "h:mm:ss a v",
+        "hmv": "h:mm a v",
+        "ms": "mm:ss",
+        "y": "y",
+        "yM": "M/y",
+        "yMEd": "E, M/d/y",
+        "yMMM": "MMM y",
+        "yMMMEd": "E, MMM d, y",
+        "yMMMM": "MMMM y",
+        "yMMMd": "MMM d, y",
+        "yMd": "M/d/y",
+        "yQQQ": "QQQ y",
+        "yQQQQ": "QQQQ y",
+    }, [2]CalendarSymbol{
+        {"Before Christ", "BC", "B", ""},
+        {"Anno Domini", "AD", "A", ""},
+    }, [4]CalendarSymbol{
+        {"1st quarter", "Q1", "1", ""},
+        {"2nd quarter", "Q2", "2", ""},
+        {"3rd quarter", "Q3", "3", ""},
+        {"4th quarter", "Q4", "4", ""},
+    }, [12]CalendarSymbol{
+        {"January", "Jan", "J", ""},
+        {"February", "Feb", "F", ""},
+        {"March", "Mar", "M", ""},
+        {"April", "Apr", "A", ""},
+        {"May", "May", "M", ""},
+        {"June", "Jun", "J", ""},
+        {"July", "Jul", "J", ""},
+        {"August", "Aug", "A", ""},
+        {"September", "Sep", "S", ""},
+        {"October", "Oct", "O", ""},
+        {"November", "Nov", "N", ""},
+        {"December", "Dec", "D", ""},
+    }, [7]CalendarSymbol{
+        {"Sunday", "Sun", "S", "Su"},
+        {"Monday", "Mon", "M", "Mo"},
+        {"Tuesday", "Tue", "T", "Tu"},
+        {"Wednesday", "Wed", "W", "We"},
+        {"Thursday", "Thu", "T", "Th"},
+        {"Friday", "Fri", "F", "Fr"},
+        {"Saturday", "Sat", "S", "Sa"},
+    }, [4]CalendarSymbol{
+        {"1st quarter", "Q1", "1", ""},
+        {"2nd quarter", "Q2", "2", ""},
+        {"3rd quarter", "Q3", "3", ""},
+        {"4th quarter", "Q4", "4", ""},
+    }, "gregorian", map[string]Calendar{
+        "buddhist": {CalendarFormat{"EEEE, MMMM d, y G", "MMMM d, y G", "MMM d, y G", "M/d/y GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Buddhist Era", "BE", "BE", ""},
+        }},
+        "hebrew": {CalendarFormat{"EEEE, MMMM d, y G", "MMMM d, y G", "MMM d, y G", "M/d/y GGGGG"}, []CalendarSymbol{
+            {"Tishri", "Tishri", "1", ""},
+            {"Heshvan", "Heshvan", "2", ""},
+            {"Kislev", "Kislev", "3", ""},
+            {"Tevet", "Tevet", "4", ""},
+            {"Shevat", "Shevat", "5", ""},
+            {"Adar I", "Adar I", "6", ""},
+            {"Adar", "Adar", "7", ""},
+            {"Nisan", "Nisan", "8", ""},
+            {"Iyar", "Iyar", "9", ""},
+            {"Sivan", "Sivan", "10", ""},
+            {"Tamuz", "Tamuz", "11", ""},
+            {"Av", "Av", "12", ""},
+            {"Elul", "Elul", "13", ""},
+            {"Adar II", "Adar II", "7", ""},
+        }, []CalendarSymbol{
+            {"Anno Mundi", "AM", "AM", ""},
+        }},
+        "islamic": {CalendarFormat{"EEEE, MMMM d, y G", "MMMM d, y G", "MMM d, y G", "M/d/y GGGGG"}, []CalendarSymbol{
+            {"Muharram", "Muh.", "1", ""},
+            {"Safar", "Saf.", "2", ""},
+            {"Rabiʻ I", "Rab. I", "3", ""},
+            {"Rabiʻ II", "Rab. II", "4", ""},
+            {"Jumada I", "Jum. I", "5", ""},
+            {"Jumada II", "Jum. II", "6", ""},
+            {"Rajab", "Raj.", "7", ""},
+            {"Shaʻban", "Sha.", "8", ""},
+            {"Ramadan", "Ram.", "9", ""},
+            {"Shawwal", "Shaw.", "10", ""},
+            {"Dhuʻl-Qiʻdah", "Dhuʻl-Q.", "11", ""},
+            {"Dhuʻl-Hijjah", "Dhuʻl-H.", "12", ""},
+        }, []CalendarSymbol{
+            {"Anno Hegirae", "AH", "AH", ""},
+        }},
+        "japanese": {CalendarFormat{"EEEE, MMMM d, y G", "MMMM d, y G", "MMM d, y G", "M/d/y GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Meiji", "Meiji", "M", ""},
+            {"Taishō", "Taishō", "T", ""},
+            {"Shōwa", "Shōwa", "S", ""},
+            {"Heisei", "Heisei", "H", ""},
+            {"Reiwa", "Reiwa", "R", ""},
+        }},
+        "persian": {CalendarFormat{"EEEE, MMMM d, y G", "MMMM d, y G", "MMM d, y G", "M/d/y GGGGG"}, []CalendarSymbol{
+            {"Farvardin", "Farvardin", "1", ""},
+            {"Ordibehesht", "Ordibehesht", "2", ""},
+            {"Khordad", "Khordad", "3", ""},
+            {"Tir", "Tir", "4", ""},
+            {"Mordad", "Mordad", "5", ""},
+            {"Shahrivar", "Shahrivar", "6", ""},
+            {"Mehr", "Mehr", "7", ""},
+            {"Aban", "Aban", "8", ""},
+            {"Azar", "Azar", "9", ""},
+            {"Dey", "Dey", "10", ""},
+            {"Bahman", "Bahman", "11", ""},
+            {"Esfand", "Esfand", "12", ""},
+        }, []CalendarSymbol{
+            {"Anno Persico", "AP", "AP", ""},
+        }},
+    }, 0, 1, "h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }, "latn", "", "ussystem", "", "GMT{0}", "GMT", "+HH:mm;-HH:mm", "{0} Time", "{0} Daylight Time", "{0} Standard Time", "{1} ({0})"},
+    "es": {"#,##0.###", CurrencyFormat{"#,##0.00 ¤", "#,##0.00", "#,##0.00 ¤", Count{"{0} {1}", "{0} {1}"}}, CalendarFormat{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy"}, CalendarFormat{"H:mm:ss (zzzz)", "H:mm:ss z", "H:mm:ss", "H:mm"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
         "": {
             "": "{0} – {1}",
         },
@@ -1311,39 +1642,39 @@ var locales = map[string]Locale{
             "y": "d/M/y – d/M/y",
         },
     }, "{0} {1}", 44, 46, 44, 46, 43, 45, 58, [12]CalendarSymbol{
-        {"enero", "ene", "E"},
-        {"febrero", "feb", "F"},
-        {"marzo", "mar", "M"},
-        {"abril", "abr", "A"},
-        {"mayo", "may", "M"},
-        {"junio", "jun", "J"},
-        {"julio", "jul", "J"},
-        {"agosto", "ago", "A"},
-        {"septiembre", "sept", "S"},
-        {"octubre", "oct", "O"},
-        {"noviembre", "nov", "N"},
-        {"diciembre", "dic", "D"},
+        {"enero", "ene", "E", ""},
+        {"febrero", "feb", "F", ""},
+        {"marzo", "mar", "M", ""},
+        {"abril", "abr", "A", ""},
+        {"mayo", "may", "M", ""},
+        {"junio", "jun", "J", ""},
+        {"julio", "jul", "J", ""},
+        {"agosto", "ago", "A", ""},
+        {"septiembre", "sept", "S", ""},
+        {"octubre", "oct", "O", ""},
+        {"noviembre", "nov", "N", ""},
+        {"diciembre", "dic", "D", ""},
     }, [7]CalendarSymbol{
-        {"domingo", "dom", "D"},
-        {"lunes", "lun", "L"},
-        {"martes", "mar", "M"},
-        {"miércoles", "mié", "X"},
-        {"jueves", "jue", "J"},
-        {"viernes", "vie", "V"},
-        {"sábado", "sáb", "S"},
+        {"domingo", "dom", "D", "DO"},
+        {"lunes", "lun", "L", "LU"},
+        {"martes", "mar", "M", "MA"},
+        {"miércoles", "mié", "X", "MI"},
+        {"jueves", "jue", "J", "JU"},
+        {"viernes", "vie", "V", "VI"},
+        {"sábado", "sáb", "S", "SA"},
     }, map[string]DayPeriodRule{
         "evening1": {720, 1200},
         "morning1": {0, 360},
         "morning2": {360, 720},
         "night1": {1200, 1440},
     }, map[string]CalendarSymbol{
-        "am": {"a. m.", "a. m.", "AM"},
-        "evening1": {"", "de la tarde", ""},
-        "morning1": {"", "de la madrugada", ""},
-        "morning2": {"", "de la mañana", ""},
-        "night1": {"", "de la noche", ""},
-        "noon": {"", "del mediodía", ""},
-        "pm": {"p. m.", "p. m.", "PM"},
+        "am": {"a. m.", "a. m.", "AM", ""},
+        "evening1": {"", "de la tarde", "", ""},
+        "morning1": {"", "de la madrugada", "", ""},
+        "morning2": {"", "de la mañana", "", ""},
+        "night1": {"", "de la noche", "", ""},
+        "noon": {"", "del mediodía", "", ""},
+        "pm": {"p. m.", "p. m.", "PM", ""},
     }, map[string]string{
         "Africa/Abidjan": "Abiyán",
         "Africa/Accra": "Acra",
@@ -1709,289 +2040,289 @@ var locales = map[string]Locale{
         "Yekaterinburg": {MetazoneSymbol{"hora de Ekaterimburgo", ""}, MetazoneSymbol{"hora estándar de Ekaterimburgo", ""}, MetazoneSymbol{"hora de verano de Ekaterimburgo", ""}},
         "Yukon": {MetazoneSymbol{"", ""}, MetazoneSymbol{"hora de Yukón", ""}, MetazoneSymbol{"", ""}},
     }, map[string]Currency{
-        "ADP": {"peseta andorrana", "", ""},
-        "AED": {"dírham de los Emiratos Árabes Unidos", "", ""},
-        "AFA": {"afgani (1927–2002)", "", ""},
-        "AFN": {"afgani afgano", "", "؋"},
-        "ALL": {"lek albanés", "", ""},
-        "AMD": {"dram armenio", "", "֏"},
-        "ANG": {"florín antillano", "", ""},
-        "AOA": {"kuanza angoleño", "", "Kz"},
-        "AOK": {"kwanza angoleño (1977–1990)", "", ""},
-        "AON": {"nuevo kwanza angoleño (1990–2000)", "", ""},
-        "AOR": {"kwanza reajustado angoleño (1995–1999)", "", ""},
-        "ARA": {"austral argentino", "", ""},
-        "ARP": {"peso argentino (1983–1985)", "", ""},
-        "ARS": {"peso argentino", "", "$"},
-        "ATS": {"chelín austriaco", "", ""},
-        "AUD": {"dólar australiano", "AUD", "$"},
-        "AWG": {"florín arubeño", "", ""},
-        "AZM": {"manat azerí (1993–2006)", "", ""},
-        "AZN": {"manat azerbaiyano", "", "₼"},
-        "BAD": {"dinar bosnio", "", ""},
-        "BAM": {"marco convertible de Bosnia y Herzegovina", "", "KM"},
-        "BBD": {"dólar barbadense", "", "$"},
-        "BDT": {"taka bangladesí", "", "৳"},
-        "BEC": {"franco belga (convertible)", "", ""},
-        "BEF": {"franco belga", "", ""},
-        "BEL": {"franco belga (financiero)", "", ""},
-        "BGL": {"lev fuerte búlgaro", "", ""},
-        "BGN": {"leva búlgara", "", ""},
-        "BHD": {"dinar bareiní", "", ""},
-        "BIF": {"franco burundés", "", ""},
-        "BMD": {"dólar bermudeño", "", "$"},
-        "BND": {"dólar bruneano", "", "$"},
-        "BOB": {"boliviano", "", "Bs"},
-        "BOP": {"peso boliviano", "", ""},
-        "BOV": {"MVDOL boliviano", "", ""},
-        "BRB": {"nuevo cruceiro brasileño (1967–1986)", "", ""},
-        "BRC": {"cruzado brasileño", "", ""},
-        "BRE": {"cruceiro brasileño (1990–1993)", "", ""},
-        "BRL": {"real brasileño", "BRL", "R$"},
-        "BRN": {"nuevo cruzado brasileño", "", ""},
-        "BRR": {"cruceiro brasileño", "", ""},
-        "BSD": {"dólar bahameño", "", "$"},
-        "BTN": {"gultrum butanés", "", ""},
-        "BUK": {"kyat birmano", "", ""},
-        "BWP": {"pula botsuano", "", "P"},
-        "BYB": {"nuevo rublo bielorruso (1994–1999)", "", ""},
-        "BYN": {"rublo bielorruso", "", "р."},
-        "BYR": {"rublo bielorruso (2000–2016)", "", ""},
-        "BZD": {"dólar beliceño", "", "$"},
-        "CAD": {"dólar canadiense", "CAD", "$"},
-        "CDF": {"franco congoleño", "", ""},
-        "CHE": {"euro WIR", "", ""},
-        "CHF": {"franco suizo", "", ""},
-        "CHW": {"franco WIR", "", ""},
-        "CLF": {"unidad de fomento chilena", "", ""},
-        "CLP": {"peso chileno", "", "$"},
-        "CNH": {"yuan chino (extracontinental)", "", ""},
-        "CNY": {"yuan renminbi", "CNY", "¥"},
-        "COP": {"peso colombiano", "", "$"},
-        "COU": {"unidad de valor real colombiana", "", ""},
-        "CRC": {"colón costarricense", "", "₡"},
-        "CSD": {"antiguo dinar serbio", "", ""},
-        "CSK": {"corona fuerte checoslovaca", "", ""},
-        "CUC": {"peso cubano convertible", "", "$"},
-        "CUP": {"peso cubano", "", "$"},
-        "CVE": {"escudo de Cabo Verde", "", ""},
-        "CYP": {"libra chipriota", "", ""},
-        "CZK": {"corona checa", "", "Kč"},
-        "DDM": {"ostmark de Alemania del Este", "", ""},
-        "DEM": {"marco alemán", "", ""},
-        "DJF": {"franco yibutiano", "", ""},
-        "DKK": {"corona danesa", "", "kr"},
-        "DOP": {"peso dominicano", "", "$"},
-        "DZD": {"dinar argelino", "", ""},
-        "ECS": {"sucre ecuatoriano", "", ""},
-        "ECV": {"unidad de valor constante (UVC) ecuatoriana", "", ""},
-        "EEK": {"corona estonia", "", ""},
-        "EGP": {"libra egipcia", "", "EGP"},
-        "ERN": {"nakfa eritreo", "", ""},
-        "ESA": {"peseta española (cuenta A)", "", ""},
-        "ESB": {"peseta española (cuenta convertible)", "", ""},
-        "ESP": {"peseta española", "₧", "₧"},
-        "ETB": {"bir etíope", "", ""},
-        "EUR": {"euro", "€", "€"},
-        "FIM": {"marco finlandés", "", ""},
-        "FJD": {"dólar fiyiano", "", "$"},
-        "FKP": {"libra malvinense", "", "£"},
-        "FRF": {"franco francés", "", ""},
-        "GBP": {"libra esterlina", "GBP", "£"},
-        "GEK": {"kupon larit georgiano", "", ""},
-        "GEL": {"lari georgiano", "", "₾"},
-        "GHC": {"cedi ghanés (1979–2007)", "", ""},
-        "GHS": {"cedi ghanés", "", "GH₵"},
-        "GIP": {"libra gibraltareña", "", "£"},
-        "GMD": {"dalasi gambiano", "", ""},
-        "GNF": {"franco guineano", "", "FG"},
-        "GNS": {"syli guineano", "", ""},
-        "GQE": {"ekuele de Guinea Ecuatorial", "", ""},
-        "GRD": {"dracma griego", "", ""},
-        "GTQ": {"quetzal guatemalteco", "", "Q"},
-        "GWE": {"escudo de Guinea Portuguesa", "", ""},
-        "GWP": {"peso de Guinea-Bissáu", "", ""},
-        "GYD": {"dólar guyanés", "", "$"},
-        "HKD": {"dólar hongkonés", "HKD", "$"},
-        "HNL": {"lempira hondureño", "", "L"},
-        "HRD": {"dinar croata", "", ""},
-        "HRK": {"kuna croata", "", "kn"},
-        "HTG": {"gurde haitiano", "", ""},
-        "HUF": {"forinto húngaro", "", "Ft"},
-        "IDR": {"rupia indonesia", "", "Rp"},
-        "IEP": {"libra irlandesa", "", ""},
-        "ILP": {"libra israelí", "", ""},
-        "ILS": {"nuevo séquel israelí", "ILS", "₪"},
-        "INR": {"rupia india", "INR", "₹"},
-        "IQD": {"dinar iraquí", "", ""},
-        "IRR": {"rial iraní", "", ""},
-        "ISK": {"corona islandesa", "", "kr"},
-        "ITL": {"lira italiana", "", ""},
-        "JMD": {"dólar jamaicano", "", "$"},
-        "JOD": {"dinar jordano", "", ""},
-        "JPY": {"yen japonés", "JPY", "¥"},
-        "KES": {"chelín keniano", "", ""},
-        "KGS": {"som kirguís", "", "⃀"},
-        "KHR": {"riel camboyano", "", "៛"},
-        "KMF": {"franco comorense", "", "CF"},
-        "KPW": {"won norcoreano", "", "₩"},
-        "KRW": {"won surcoreano", "KRW", "₩"},
-        "KWD": {"dinar kuwaití", "", ""},
-        "KYD": {"dólar de las Islas Caimán", "", "$"},
-        "KZT": {"tengue kazajo", "", "₸"},
-        "LAK": {"kip laosiano", "", "₭"},
-        "LBP": {"libra libanesa", "", "L£"},
-        "LKR": {"rupia esrilanquesa", "", "Rs"},
-        "LRD": {"dólar liberiano", "", "$"},
-        "LSL": {"loti lesotense", "", ""},
-        "LTL": {"litas lituano", "", "Lt"},
-        "LTT": {"talonas lituano", "", ""},
-        "LUC": {"franco convertible luxemburgués", "", ""},
-        "LUF": {"franco luxemburgués", "", ""},
-        "LUL": {"franco financiero luxemburgués", "", ""},
-        "LVL": {"lats letón", "", "Ls"},
-        "LVR": {"rublo letón", "", ""},
-        "LYD": {"dinar libio", "", ""},
-        "MAD": {"dírham marroquí", "", ""},
-        "MAF": {"franco marroquí", "", ""},
-        "MDL": {"leu moldavo", "", ""},
-        "MGA": {"ariari malgache", "", "Ar"},
-        "MGF": {"franco malgache", "", ""},
-        "MKD": {"dinar macedonio", "", ""},
-        "MLF": {"franco malí", "", ""},
-        "MMK": {"kiat de Myanmar", "", "K"},
-        "MNT": {"tugrik mongol", "", "₮"},
-        "MOP": {"pataca macaense", "", ""},
-        "MRO": {"uguiya (1973–2017)", "", ""},
-        "MRU": {"uguiya mauritano", "", ""},
-        "MTL": {"lira maltesa", "", ""},
-        "MTP": {"libra maltesa", "", ""},
-        "MUR": {"rupia mauriciana", "", "Rs"},
-        "MVR": {"rufiya maldiva", "", ""},
-        "MWK": {"kuacha malauí", "", ""},
-        "MXN": {"peso mexicano", "MXN", "$"},
-        "MXP": {"peso de plata mexicano (1861–1992)", "", ""},
-        "MXV": {"unidad de inversión (UDI) mexicana", "", ""},
-        "MYR": {"ringit malasio", "", "RM"},
-        "MZE": {"escudo mozambiqueño", "", ""},
-        "MZM": {"antiguo metical mozambiqueño", "", ""},
-        "MZN": {"metical mozambiqueño", "", ""},
-        "NAD": {"dólar namibio", "", "$"},
-        "NGN": {"naira nigeriano", "", "₦"},
-        "NIC": {"córdoba nicaragüense (1988–1991)", "", ""},
-        "NIO": {"córdoba oro", "", "C$"},
-        "NLG": {"florín neerlandés", "", ""},
-        "NOK": {"corona noruega", "", "kr"},
-        "NPR": {"rupia nepalí", "", "Rs"},
-        "NZD": {"dólar neozelandés", "NZD", "$"},
-        "OMR": {"rial omaní", "", ""},
-        "PAB": {"balboa panameño", "", ""},
-        "PEI": {"inti peruano", "", ""},
-        "PEN": {"sol peruano", "", ""},
-        "PES": {"sol peruano (1863–1965)", "", ""},
-        "PGK": {"kina papú", "", ""},
-        "PHP": {"peso filipino", "PHP", "₱"},
-        "PKR": {"rupia pakistaní", "", "Rs"},
-        "PLN": {"esloti polaco", "", "zł"},
-        "PLZ": {"zloty polaco (1950–1995)", "", ""},
-        "PTE": {"escudo portugués", "", ""},
-        "PYG": {"guaraní paraguayo", "", "₲"},
-        "QAR": {"rial catarí", "", ""},
-        "RHD": {"dólar rodesiano", "", ""},
-        "ROL": {"antiguo leu rumano", "", ""},
-        "RON": {"leu rumano", "", "L"},
-        "RSD": {"dinar serbio", "", ""},
-        "RUB": {"rublo ruso", "", "₽"},
-        "RUR": {"rublo ruso (1991–1998)", "", ""},
-        "RWF": {"franco ruandés", "", "RF"},
-        "SAR": {"rial saudí", "⃁", ""},
-        "SBD": {"dólar salomonense", "", "$"},
-        "SCR": {"rupia seychellense", "", ""},
-        "SDD": {"dinar sudanés", "", ""},
-        "SDG": {"libra sudanesa", "", ""},
-        "SDP": {"libra sudanesa antigua", "", ""},
-        "SEK": {"corona sueca", "", "kr"},
-        "SGD": {"dólar singapurense", "", "$"},
-        "SHP": {"libra de Santa Elena", "", "£"},
-        "SIT": {"tólar esloveno", "", ""},
-        "SKK": {"corona eslovaca", "", ""},
-        "SLE": {"leona sierraleonesa", "", ""},
-        "SLL": {"leona sierraleonesa (1964–2022)", "", ""},
-        "SOS": {"chelín somalí", "", ""},
-        "SRD": {"dólar surinamés", "", "$"},
-        "SRG": {"florín surinamés", "", ""},
-        "SSP": {"libra sursudanesa", "", "£"},
-        "STD": {"dobra (1977–2017)", "", ""},
-        "STN": {"dobra santotomense", "", "Db"},
-        "SUR": {"rublo soviético", "", ""},
-        "SVC": {"colón salvadoreño", "", ""},
-        "SYP": {"libra siria", "", "£"},
-        "SZL": {"lilangeni esuatiní", "", ""},
-        "THB": {"bat tailandés", "฿", "฿"},
-        "TJR": {"rublo tayiko", "", ""},
-        "TJS": {"somoni tayiko", "", ""},
-        "TMM": {"manat turcomano (1993–2009)", "", ""},
-        "TMT": {"manat turcomano", "", ""},
-        "TND": {"dinar tunecino", "", ""},
-        "TOP": {"paanga tongano", "", "T$"},
-        "TPE": {"escudo timorense", "", ""},
-        "TRL": {"lira turca (1922–2005)", "", ""},
-        "TRY": {"lira turca", "TL", "₺"},
-        "TTD": {"dólar de Trinidad y Tobago", "", "$"},
-        "TWD": {"nuevo dólar taiwanés", "TWD", "NT$"},
-        "TZS": {"chelín tanzano", "", ""},
-        "UAH": {"grivna ucraniana", "", "₴"},
-        "UAK": {"karbovanet ucraniano", "", ""},
-        "UGS": {"chelín ugandés (1966–1987)", "", ""},
-        "UGX": {"chelín ugandés", "", ""},
-        "USD": {"dólar estadounidense", "US$", "$"},
-        "USN": {"dólar estadounidense (día siguiente)", "", ""},
-        "USS": {"dólar estadounidense (mismo día)", "", ""},
-        "UYI": {"peso uruguayo en unidades indexadas", "", ""},
-        "UYP": {"peso uruguayo (1975–1993)", "", ""},
-        "UYU": {"peso uruguayo", "", "$"},
-        "UYW": {"unidad previsional uruguayo", "", ""},
-        "UZS": {"sum uzbeko", "", ""},
-        "VEB": {"bolívar venezolano (1871–2008)", "", ""},
-        "VEF": {"bolívar venezolano (2008–2018)", "", "Bs"},
-        "VES": {"bolívar venezolano", "", ""},
-        "VND": {"dong vietnamita", "₫", "₫"},
-        "VUV": {"vatu vanuatense", "", ""},
-        "WST": {"tala samoano", "", ""},
-        "XAF": {"franco CFA de África Central", "XAF", ""},
-        "XAG": {"plata", "", ""},
-        "XAU": {"oro", "", ""},
-        "XBA": {"unidad compuesta europea", "", ""},
-        "XBB": {"unidad monetaria europea", "", ""},
-        "XBC": {"unidad de cuenta europea (XBC)", "", ""},
-        "XBD": {"unidad de cuenta europea (XBD)", "", ""},
-        "XCD": {"dólar del Caribe Oriental", "XCD", "$"},
-        "XCG": {"florín caribeño", "Cg.", ""},
-        "XDR": {"derechos especiales de giro", "", ""},
-        "XEU": {"unidad de moneda europea", "", ""},
-        "XFO": {"franco oro francés", "", ""},
-        "XFU": {"franco UIC francés", "", ""},
-        "XOF": {"franco CFA de África Occidental", "XOF", ""},
-        "XPD": {"paladio", "", ""},
-        "XPF": {"franco CFP", "CFPF", ""},
-        "XPT": {"platino", "", ""},
-        "XRE": {"fondos RINET", "", ""},
-        "XTS": {"código reservado para pruebas", "", ""},
-        "XXX": {"moneda desconocida", "¤", ""},
-        "YDD": {"dinar yemení", "", ""},
-        "YER": {"rial yemení", "", ""},
-        "YUD": {"dinar fuerte yugoslavo", "", ""},
-        "YUM": {"super dinar yugoslavo", "", ""},
-        "YUN": {"dinar convertible yugoslavo", "", ""},
-        "ZAL": {"rand sudafricano (financiero)", "", ""},
-        "ZAR": {"rand sudafricano", "", "R"},
-        "ZMK": {"kwacha zambiano (1968–2012)", "", ""},
-        "ZMW": {"kuacha zambiano", "", "ZK"},
-        "ZRN": {"nuevo zaire zaireño", "", ""},
-        "ZRZ": {"zaire zaireño", "", ""},
-        "ZWD": {"dólar de Zimbabue", "", ""},
-        "ZWG": {"oro zimbabuense", "", ""},
-        "ZWL": {"dólar zimbabuense", "", ""},
+        "ADP": {"peseta andorrana", "", "", Count{"", ""}, 0, 0},
+        "AED": {"dírham de los Emiratos Árabes Unidos", "", "", Count{"", ""}, 0, 0},
+        "AFA": {"afgani (1927–2002)", "", "", Count{"", ""}, 0, 0},
+        "AFN": {"afgani afgano", "", "؋", Count{"", ""}, 0, 0},
+        "ALL": {"lek albanés", "", "", Count{"", ""}, 0, 0},
+        "AMD": {"dram armenio", "", "֏", Count{"", ""}, 0, 0},
+        "ANG": {"florín antillano", "", "", Count{"", ""}, 0, 0},
+        "AOA": {"kuanza angoleño", "", "Kz", Count{"", ""}, 0, 0},
+        "AOK": {"kwanza angoleño (1977–1990)", "", "", Count{"", ""}, 0, 0},
+        "AON": {"nuevo kwanza angoleño (1990–2000)", "", "", Count{"", ""}, 0, 0},
+        "AOR": {"kwanza reajustado angoleño (1995–1999)", "", "", Count{"", ""}, 0, 0},
+        "ARA": {"austral argentino", "", "", Count{"", ""}, 0, 0},
+        "ARP": {"peso argentino (1983–1985)", "", "", Count{"", ""}, 0, 0},
+        "ARS": {"peso argentino", "", "$", Count{"peso argentino", "pesos argentinos"}, 0, 0},
+        "ATS": {"chelín austriaco", "", "", Count{"", ""}, 0, 0},
+        "AUD": {"dólar australiano", "AUD", "$", Count{"dólar australiano", "dólares australianos"}, 0, 0},
+        "AWG": {"florín arubeño", "", "", Count{"", ""}, 0, 0},
+        "AZM": {"manat azerí (1993–2006)", "", "", Count{"", ""}, 0, 0},
+        "AZN": {"manat azerbaiyano", "", "₼", Count{"", ""}, 0, 0},
+        "BAD": {"dinar bosnio", "", "", Count{"", ""}, 0, 0},
+        "BAM": {"marco convertible de Bosnia y Herzegovina", "", "KM", Count{"", ""}, 0, 0},
+        "BBD": {"dólar barbadense", "", "$", Count{"", ""}, 0, 0},
+        "BDT": {"taka bangladesí", "", "৳", Count{"", ""}, 0, 0},
+        "BEC": {"franco belga (convertible)", "", "", Count{"", ""}, 0, 0},
+        "BEF": {"franco belga", "", "", Count{"", ""}, 0, 0},
+        "BEL": {"franco belga (financiero)", "", "", Count{"", ""}, 0, 0},
+        "BGL": {"lev fuerte búlgaro", "", "", Count{"", ""}, 0, 0},
+        "BGN": {"leva búlgara", "", "", Count{"", ""}, 0, 0},
+        "BHD": {"dinar bareiní", "", "", Count{"", ""}, 0, 0},
+        "BIF": {"franco burundés", "", "", Count{"", ""}, 0, 0},
+        "BMD": {"dólar bermudeño", "", "$", Count{"", ""}, 0, 0},
+        "BND": {"dólar bruneano", "", "$", Count{"", ""}, 0, 0},
+        "BOB": {"boliviano", "", "Bs", Count{"", ""}, 0, 0},
+        "BOP": {"peso boliviano", "", "", Count{"", ""}, 0, 0},
+        "BOV": {"MVDOL boliviano", "", "", Count{"", ""}, 0, 0},
+        "BRB": {"nuevo cruceiro brasileño (1967–1986)", "", "", Count{"", ""}, 0, 0},
+        "BRC": {"cruzado brasileño", "", "", Count{"", ""}, 0, 0},
+        "BRE": {"cruceiro brasileño (1990–1993)", "", "", Count{"", ""}, 0, 0},
+        "BRL": {"real brasileño", "BRL", "R$", Count{"real brasileño", "reales brasileños"}, 0, 0},
+        "BRN": {"nuevo cruzado brasileño", "", "", Count{"", ""}, 0, 0},
+        "BRR": {"cruceiro brasileño", "", "", Count{"", ""}, 0, 0},
+        "BSD": {"dólar bahameño", "", "$", Count{"", ""}, 0, 0},
+        "BTN": {"gultrum butanés", "", "", Count{"", ""}, 0, 0},
+        "BUK": {"kyat birmano", "", "", Count{"", ""}, 0, 0},
+        "BWP": {"pula botsuano", "", "P", Count{"", ""}, 0, 0},
+        "BYB": {"nuevo rublo bielorruso (1994–1999)", "", "", Count{"", ""}, 0, 0},
+        "BYN": {"rublo bielorruso", "", "р.", Count{"", ""}, 0, 0},
+        "BYR": {"rublo bielorruso (2000–2016)", "", "", Count{"", ""}, 0, 0},
+        "BZD": {"dólar beliceño", "", "$", Count{"", ""}, 0, 0},
+        "CAD": {"dólar canadiense", "CAD", "$", Count{"dólar canadiense", "dólares canadienses"}, 0, 0},
+        "CDF": {"franco congoleño", "", "", Count{"", ""}, 0, 0},
+        "CHE": {"euro WIR", "", "", Count{"", ""}, 0, 0},
+        "CHF": {"franco suizo", "", "", Count{"franco suizo", "francos suizos"}, 0, 0},
+        "CHW": {"franco WIR", "", "", Count{"", ""}, 0, 0},
+        "CLF": {"unidad de fomento chilena", "", "", Count{"", ""}, 0, 0},
+        "CLP": {"peso chileno", "", "$", Count{"peso chileno", "pesos chilenos"}, 0, 0},
+        "CNH": {"yuan chino (extracontinental)", "", "", Count{"", ""}, 0, 0},
+        "CNY": {"yuan renminbi", "CNY", "¥", Count{"", ""}, 0, 0},
+        "COP": {"peso colombiano", "", "$", Count{"peso colombiano", "pesos colombianos"}, 0, 0},
+        "COU": {"unidad de valor real colombiana", "", "", Count{"", ""}, 0, 0},
+        "CRC": {"colón costarricense", "", "₡", Count{"", ""}, 0, 0},
+        "CSD": {"antiguo dinar serbio", "", "", Count{"", ""}, 0, 0},
+        "CSK": {"corona fuerte checoslovaca", "", "", Count{"", ""}, 0, 0},
+        "CUC": {"peso cubano convertible", "", "$", Count{"", ""}, 0, 0},
+        "CUP": {"peso cubano", "", "$", Count{"", ""}, 0, 0},
+        "CVE": {"escudo de Cabo Verde", "", "", Count{"escudo de Cabo Verde", "escudos de Cabo Verde"}, 0, 0},
+        "CYP": {"libra chipriota", "", "", Count{"", ""}, 0, 0},
+        "CZK": {"corona checa", "", "Kč", Count{"", ""}, 0, 0},
+        "DDM": {"ostmark de Alemania del Este", "", "", Count{"", ""}, 0, 0},
+        "DEM": {"marco alemán", "", "", Count{"", ""}, 0, 0},
+        "DJF": {"franco yibutiano", "", "", Count{"", ""}, 0, 0},
+        "DKK": {"corona danesa", "", "kr", Count{"", ""}, 0, 0},
+        "DOP": {"peso dominicano", "", "$", Count{"", ""}, 0, 0},
+        "DZD": {"dinar argelino", "", "", Count{"", ""}, 0, 0},
+        "ECS": {"sucre ecuatoriano", "", "", Count{"", ""}, 0, 0},
+        "ECV": {"unidad de valor constante (UVC) ecuatoriana", "", "", Count{"", ""}, 0, 0},
+        "EEK": {"corona estonia", "", "", Count{"", ""}, 0, 0},
+        "EGP": {"libra egipcia", "", "EGP", Count{"", ""}, 0, 0},
+        "ERN": {"nakfa eritreo", "", "", Count{"", ""}, 0, 0},
+        "ESA": {"peseta española (cuenta A)", "", "", Count{"", ""}, 0, 0},
+        "ESB": {"peseta española (cuenta convertible)", "", "", Count{"", ""}, 0, 0},
+        "ESP": {"peseta española", "₧", "₧", Count{"", ""}, 0, 0},
+        "ETB": {"bir etíope", "", "", Count{"", ""}, 0, 0},
+        "EUR": {"euro", "€", "€", Count{"euro", "euros"}, 0, 0},
+        "FIM": {"marco finlandés", "", "", Count{"", ""}, 0, 0},
+        "FJD": {"dólar fiyiano", "", "$", Count{"", ""}, 0, 0},
+        "FKP": {"libra malvinense", "", "£", Count{"", ""}, 0, 0},
+        "FRF": {"franco francés", "", "", Count{"", ""}, 0, 0},
+        "GBP": {"libra esterlina", "GBP", "£", Count{"libra esterlina", "libras esterlinas"}, 0, 0},
+        "GEK": {"kupon larit georgiano", "", "", Count{"", ""}, 0, 0},
+        "GEL": {"lari georgiano", "", "₾", Count{"", ""}, 0, 0},
+        "GHC": {"cedi ghanés (1979–2007)", "", "", Count{"", ""}, 0, 0},
+        "GHS": {"cedi ghanés", "", "GH₵", Count{"", ""}, 0, 0},
+        "GIP": {"libra gibraltareña", "", "£", Count{"", ""}, 0, 0},
+        "GMD": {"dalasi gambiano", "", "", Count{"", ""}, 0, 0},
+        "GNF": {"franco guineano", "", "FG", Count{"", ""}, 0, 0},
+        "GNS": {"syli guineano", "", "", Count{"", ""}, 0, 0},
+        "GQE": {"ekuele de Guinea Ecuatorial", "", "", Count{"", ""}, 0, 0},
+        "GRD": {"dracma griego", "", "", Count{"", ""}, 0, 0},
+        "GTQ": {"quetzal guatemalteco", "", "Q", Count{"", ""}, 0, 0},
+        "GWE": {"escudo de Guinea Portuguesa", "", "", Count{"", ""}, 0, 0},
+        "GWP": {"peso de Guinea-Bissáu", "", "", Count{"", ""}, 0, 0},
+        "GYD": {"dólar guyanés", "", "$", Count{"", ""}, 0, 0},
+        "HKD": {"dólar hongkonés", "HKD", "$", Count{"", ""}, 0, 0},
+        "HNL": {"lempira hondureño", "", "L", Count{"", ""}, 0, 0},
+        "HRD": {"dinar croata", "", "", Count{"", ""}, 0, 0},
+        "HRK": {"kuna croata", "", "kn", Count{"", ""}, 0, 0},
+        "HTG": {"gurde haitiano", "", "", Count{"", ""}, 0, 0},
+        "HUF": {"forinto húngaro", "", "Ft", Count{"", ""}, 0, 0},
+        "IDR": {"rupia indonesia", "", "Rp", Count{"", ""}, 0, 0},
+        "IEP": {"libra irlandesa", "", "", Count{"", ""}, 0, 0},
+        "ILP": {"libra israelí", "", "", Count{"", ""}, 0, 0},
+        "ILS": {"nuevo séquel israelí", "ILS", "₪", Count{"", ""}, 0, 0},
+        "INR": {"rupia india", "INR", "₹", Count{"", ""}, 0, 0},
+        "IQD": {"dinar iraquí", "", "", Count{"", ""}, 0, 0},
+        "IRR": {"rial iraní", "", "", Count{"", ""}, 0, 0},
+        "ISK": {"corona islandesa", "", "kr", Count{"", ""}, 0, 0},
+        "ITL": {"lira italiana", "", "", Count{"", ""}, 0, 0},
+        "JMD": {"dólar jamaicano", "", "$", Count{"", ""}, 0, 0},
+        "JOD": {"dinar jordano", "", "", Count{"", ""}, 0, 0},
+        "JPY": {"yen japonés", "JPY", "¥", Count{"yen japonés", "yenes japoneses"}, 0, 0},
+        "KES": {"chelín keniano", "", "", Count{"", ""}, 0, 0},
+        "KGS": {"som kirguís", "", "⃀", Count{"", ""}, 0, 0},
+        "KHR": {"riel camboyano", "", "៛", Count{"", ""}, 0, 0},
+        "KMF": {"franco comorense", "", "CF", Count{"", ""}, 0, 0},
+        "KPW": {"won norcoreano", "", "₩", Count{"", ""}, 0, 0},
+        "KRW": {"won surcoreano", "KRW", "₩", Count{"", ""}, 0, 0},
+        "KWD": {"dinar kuwaití", "", "", Count{"", ""}, 0, 0},
+        "KYD": {"dólar de las Islas Caimán", "", "$", Count{"", ""}, 0, 0},
+        "KZT": {"tengue kazajo", "", "₸", Count{"", ""}, 0, 0},
+        "LAK": {"kip laosiano", "", "₭", Count{"", ""}, 0, 0},
+        "LBP": {"libra libanesa", "", "L£", Count{"", ""}, 0, 0},
+        "LKR": {"rupia esrilanquesa", "", "Rs", Count{"", ""}, 0, 0},
+        "LRD": {"dólar liberiano", "", "$", Count{"", ""}, 0, 0},
+        "LSL": {"loti lesotense", "", "", Count{"", ""}, 0, 0},
+        "LTL": {"litas lituano", "", "Lt", Count{"", ""}, 0, 0},
+        "LTT": {"talonas lituano", "", "", Count{"", ""}, 0, 0},
+        "LUC": {"franco convertible luxemburgués", "", "", Count{"", ""}, 0, 0},
+        "LUF": {"franco luxemburgués", "", "", Count{"", ""}, 0, 0},
+        "LUL": {"franco financiero luxemburgués", "", "", Count{"", ""}, 0, 0},
+        "LVL": {"lats letón", "", "Ls", Count{"", ""}, 0, 0},
+        "LVR": {"rublo letón", "", "", Count{"", ""}, 0, 0},
+        "LYD": {"dinar libio", "", "", Count{"", ""}, 0, 0},
+        "MAD": {"dírham marroquí", "", "", Count{"", ""}, 0, 0},
+        "MAF": {"franco marroquí", "", "", Count{"", ""}, 0, 0},
+        "MDL": {"leu moldavo", "", "", Count{"", ""}, 0, 0},
+        "MGA": {"ariari malgache", "", "Ar", Count{"", ""}, 0, 0},
+        "MGF": {"franco malgache", "", "", Count{"", ""}, 0, 0},
+        "MKD": {"dinar macedonio", "", "", Count{"", ""}, 0, 0},
+        "MLF": {"franco malí", "", "", Count{"", ""}, 0, 0},
+        "MMK": {"kiat de Myanmar", "", "K", Count{"", ""}, 0, 0},
+        "MNT": {"tugrik mongol", "", "₮", Count{"", ""}, 0, 0},
+        "MOP": {"pataca macaense", "", "", Count{"", ""}, 0, 0},
+        "MRO": {"uguiya (1973–2017)", "", "", Count{"", ""}, 0, 0},
+        "MRU": {"uguiya mauritano", "", "", Count{"", ""}, 0, 0},
+        "MTL": {"lira maltesa", "", "", Count{"", ""}, 0, 0},
+        "MTP": {"libra maltesa", "", "", Count{"", ""}, 0, 0},
+        "MUR": {"rupia mauriciana", "", "Rs", Count{"", ""}, 0, 0},
+        "MVR": {"rufiya maldiva", "", "", Count{"", ""}, 0, 0},
+        "MWK": {"kuacha malauí", "", "", Count{"", ""}, 0, 0},
+        "MXN": {"peso mexicano", "MXN", "$", Count{"peso mexicano", "pesos mexicanos"}, 0, 0},
+        "MXP": {"peso de plata mexicano (1861–1992)", "", "", Count{"", ""}, 0, 0},
+        "MXV": {"unidad de inversión (UDI) mexicana", "", "", Count{"", ""}, 0, 0},
+        "MYR": {"ringit malasio", "", "RM", Count{"", ""}, 0, 0},
+        "MZE": {"escudo mozambiqueño", "", "", Count{"", ""}, 0, 0},
+        "MZM": {"antiguo metical mozambiqueño", "", "", Count{"", ""}, 0, 0},
+        "MZN": {"metical mozambiqueño", "", "", Count{"", ""}, 0, 0},
+        "NAD": {"dólar namibio", "", "$", Count{"", ""}, 0, 0},
+        "NGN": {"naira nigeriano", "", "₦", Count{"", ""}, 0, 0},
+        "NIC": {"córdoba nicaragüense (1988–1991)", "", "", Count{"", ""}, 0, 0},
+        "NIO": {"córdoba oro", "", "C$", Count{"", ""}, 0, 0},
+        "NLG": {"florín neerlandés", "", "", Count{"", ""}, 0, 0},
+        "NOK": {"corona noruega", "", "kr", Count{"", ""}, 0, 0},
+        "NPR": {"rupia nepalí", "", "Rs", Count{"", ""}, 0, 0},
+        "NZD": {"dólar neozelandés", "NZD", "$", Count{"", ""}, 0, 0},
+        "OMR": {"rial omaní", "", "", Count{"", ""}, 0, 0},
+        "PAB": {"balboa panameño", "", "", Count{"", ""}, 0, 0},
+        "PEI": {"inti peruano", "", "", Count{"", ""}, 0, 0},
+        "PEN": {"sol peruano", "", "", Count{"", ""}, 0, 0},
+        "PES": {"sol peruano (1863–1965)", "", "", Count{"", ""}, 0, 0},
+        "PGK": {"kina papú", "", "", Count{"", ""}, 0, 0},
+        "PHP": {"peso filipino", "PHP", "₱", Count{"", ""}, 0, 0},
+        "PKR": {"rupia pakistaní", "", "Rs", Count{"", ""}, 0, 0},
+        "PLN": {"esloti polaco", "", "zł", Count{"", ""}, 0, 0},
+        "PLZ": {"zloty polaco (1950–1995)", "", "", Count{"", ""}, 0, 0},
+        "PTE": {"escudo portugués", "", "", Count{"escudo portugués", "escudos portugueses"}, 0, 0},
+        "PYG": {"guaraní paraguayo", "", "₲", Count{"", ""}, 0, 0},
+        "QAR": {"rial catarí", "", "", Count{"", ""}, 0, 0},
+        "RHD": {"dólar rodesiano", "", "", Count{"", ""}, 0, 0},
+        "ROL": {"antiguo leu rumano", "", "", Count{"", ""}, 0, 0},
+        "RON": {"leu rumano", "", "L", Count{"", ""}, 0, 0},
+        "RSD": {"dinar serbio", "", "", Count{"", ""}, 0, 0},
+        "RUB": {"rublo ruso", "", "₽", Count{"", ""}, 0, 0},
+        "RUR": {"rublo ruso (1991–1998)", "", "", Count{"", ""}, 0, 0},
+        "RWF": {"franco ruandés", "", "RF", Count{"", ""}, 0, 0},
+        "SAR": {"rial saudí", "⃁", "", Count{"", ""}, 0, 0},
+        "SBD": {"dólar salomonense", "", "$", Count{"", ""}, 0, 0},
+        "SCR": {"rupia seychellense", "", "", Count{"", ""}, 0, 0},
+        "SDD": {"dinar sudanés", "", "", Count{"", ""}, 0, 0},
+        "SDG": {"libra sudanesa", "", "", Count{"", ""}, 0, 0},
+        "SDP": {"libra sudanesa antigua", "", "", Count{"", ""}, 0, 0},
+        "SEK": {"corona sueca", "", "kr", Count{"", ""}, 0, 0},
+        "SGD": {"dólar singapurense", "", "$", Count{"", ""}, 0, 0},
+        "SHP": {"libra de Santa Elena", "", "£", Count{"", ""}, 0, 0},
+        "SIT": {"tólar esloveno", "", "", Count{"", ""}, 0, 0},
+        "SKK": {"corona eslovaca", "", "", Count{"", ""}, 0, 0},
+        "SLE": {"leona sierraleonesa", "", "", Count{"", ""}, 0, 0},
+        "SLL": {"leona sierraleonesa (1964–2022)", "", "", Count{"", ""}, 0, 0},
+        "SOS": {"chelín somalí", "", "", Count{"", ""}, 0, 0},
+        "SRD": {"dólar surinamés", "", "$", Count{"", ""}, 0, 0},
+        "SRG": {"florín surinamés", "", "", Count{"", ""}, 0, 0},
+        "SSP": {"libra sursudanesa", "", "£", Count{"", ""}, 0, 0},
+        "STD": {"dobra (1977–2017)", "", "", Count{"", ""}, 0, 0},
+        "STN": {"dobra santotomense", "", "Db", Count{"", ""}, 0, 0},
+        "SUR": {"rublo soviético", "", "", Count{"", ""}, 0, 0},
+        "SVC": {"colón salvadoreño", "", "", Count{"", ""}, 0, 0},
+        "SYP": {"libra siria", "", "£", Count{"", ""}, 0, 0},
+        "SZL": {"lilangeni esuatiní", "", "", Count{"", ""}, 0, 0},
+        "THB": {"bat tailandés", "฿", "฿", Count{"", ""}, 0, 0},
+        "TJR": {"rublo tayiko", "", "", Count{"", ""}, 0, 0},
+        "TJS": {"somoni tayiko", "", "", Count{"", ""}, 0, 0},
+        "TMM": {"manat turcomano (1993–2009)", "", "", Count{"", ""}, 0, 0},
+        "TMT": {"manat turcomano", "", "", Count{"", ""}, 0, 0},
+        "TND": {"dinar tunecino", "", "", Count{"", ""}, 0, 0},
+        "TOP": {"paanga tongano", "", "T$", Count{"", ""}, 0, 0},
+        "TPE": {"escudo timorense", "", "", Count{"", ""}, 0, 0},
+        "TRL": {"lira turca (1922–2005)", "", "", Count{"", ""}, 0, 0},
+        "TRY": {"lira turca", "TL", "₺", Count{"", ""}, 0, 0},
+        "TTD": {"dólar de Trinidad y Tobago", "", "$", Count{"", ""}, 0, 0},
+        "TWD": {"nuevo dólar taiwanés", "TWD", "NT$", Count{"", ""}, 0, 0},
+        "TZS": {"chelín tanzano", "", "", Count{"", ""}, 0, 0},
+        "UAH": {"grivna ucraniana", "", "₴", Count{"", ""}, 0, 0},
+        "UAK": {"karbovanet ucraniano", "", "", Count{"", ""}, 0, 0},
+        "UGS": {"chelín ugandés (1966–1987)", "", "", Count{"", ""}, 0, 0},
+        "UGX": {"chelín ugandés", "", "", Count{"", ""}, 0, 0},
+        "USD": {"dólar estadounidense", "US$", "$", Count{"dólar estadounidense", "dólares estadounidenses"}, 0, 0},
+        "USN": {"dólar estadounidense (día siguiente)", "", "", Count{"", ""}, 0, 0},
+        "USS": {"dólar estadounidense (mismo día)", "", "", Count{"", ""}, 0, 0},
+        "UYI": {"peso uruguayo en unidades indexadas", "", "", Count{"", ""}, 0, 0},
+        "UYP": {"peso uruguayo (1975–1993)", "", "", Count{"", ""}, 0, 0},
+        "UYU": {"peso uruguayo", "", "$", Count{"", ""}, 0, 0},
+        "UYW": {"unidad previsional uruguayo", "", "", Count{"", ""}, 0, 0},
+        "UZS": {"sum uzbeko", "", "", Count{"", ""}, 0, 0},
+        "VEB": {"bolívar venezolano (1871–2008)", "", "", Count{"", ""}, 0, 0},
+        "VEF": {"bolívar venezolano (2008–2018)", "", "Bs", Count{"", ""}, 0, 0},
+        "VES": {"bolívar venezolano", "", "", Count{"", ""}, 0, 0},
+        "VND": {"dong vietnamita", "₫", "₫", Count{"", ""}, 0, 0},
+        "VUV": {"vatu vanuatense", "", "", Count{"", ""}, 0, 0},
+        "WST": {"tala samoano", "", "", Count{"", ""}, 0, 0},
+        "XAF": {"franco CFA de África Central", "XAF", "", Count{"", ""}, 0, 0},
+        "XAG": {"plata", "", "", Count{"", ""}, 0, 0},
+        "XAU": {"oro", "", "", Count{"", ""}, 0, 0},
+        "XBA": {"unidad compuesta europea", "", "", Count{"", ""}, 0, 0},
+        "XBB": {"unidad monetaria europea", "", "", Count{"", ""}, 0, 0},
+        "XBC": {"unidad de cuenta europea (XBC)", "", "", Count{"", ""}, 0, 0},
+        "XBD": {"unidad de cuenta europea (XBD)", "", "", Count{"", ""}, 0, 0},
+        "XCD": {"dólar del Caribe Oriental", "XCD", "$", Count{"", ""}, 0, 0},
+        "XCG": {"florín caribeño", "Cg.", "", Count{"", ""}, 0, 0},
+        "XDR": {"derechos especiales de giro", "", "", Count{"", ""}, 0, 0},
+        "XEU": {"unidad de moneda europea", "", "", Count{"", ""}, 0, 0},
+        "XFO": {"franco oro francés", "", "", Count{"", ""}, 0, 0},
+        "XFU": {"franco UIC francés", "", "", Count{"", ""}, 0, 0},
+        "XOF": {"franco CFA de África Occidental", "XOF", "", Count{"", ""}, 0, 0},
+        "XPD": {"paladio", "", "", Count{"", ""}, 0, 0},
+        "XPF": {"franco CFP", "CFPF", "", Count{"", ""}, 0, 0},
+        "XPT": {"platino", "", "", Count{"", ""}, 0, 0},
+        "XRE": {"fondos RINET", "", "", Count{"", ""}, 0, 0},
+        "XTS": {"código reservado para pruebas", "", "", Count{"", ""}, 0, 0},
+        "XXX": {"moneda desconocida", "¤", "", Count{"", ""}, 0, 0},
+        "YDD": {"dinar yemení", "", "", Count{"", ""}, 0, 0},
+        "YER": {"rial yemení", "", "", Count{"", ""}, 0, 0},
+        "YUD": {"dinar fuerte yugoslavo", "", "", Count{"", ""}, 0, 0},
+        "YUM": {"super dinar yugoslavo", "", "", Count{"", ""}, 0, 0},
+        "YUN": {"dinar convertible yugoslavo", "", "", Count{"", ""}, 0, 0},
+        "ZAL": {"rand sudafricano (financiero)", "", "", Count{"", ""}, 0, 0},
+        "ZAR": {"rand sudafricano", "", "R", Count{"", ""}, 0, 0},
+        "ZMK": {"kwacha zambiano (1968–2012)", "", "", Count{"", ""}, 0, 0},
+        "ZMW": {"kuacha zambiano", "", "ZK", Count{"", ""}, 0, 0},
+        "ZRN": {"nuevo zaire zaireño", "", "", Count{"", ""}, 0, 0},
+        "ZRZ": {"zaire zaireño", "", "", Count{"", ""}, 0, 0},
+        "ZWD": {"dólar de Zimbabue", "", "", Count{"", ""}, 0, 0},
+        "ZWG": {"oro zimbabuense", "", "", Count{"", ""}, 0, 0},
+        "ZWL": {"dólar zimbabuense", "", "", Count{"", ""}, 0, 0},
     }, map[string]Unit{
         "duration-century": {Count{"{0} siglo", "{0} siglos"}, Count{"", "{0} s."}, Count{"{0}s", "{0}s"}},
         "duration-day": {Count{"{0} día", "{0} días"}, Count{"", "{0} d"}, Count{"{0}d", "{0}d"}},
@@ -2308,8 +2639,274 @@ var locales = map[string]Locale{
         "ZM": "Zambia",
         "ZW": "Zimbabue",
         "ZZ": "Región desconocida",
-    }},
-    "es_419": {"#,##0.###", CurrencyFormat{"¤#,##0.00", "#,##0.00", "¤ #,##0.00"}, CalendarFormat{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy"}, CalendarFormat{"h:mm:ss a zzzz", "h:mm:ss a z", "h:mm:ss a", "h:mm a"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
+    }, ListPattern{"{0}, {1}", "{0}, {1}", "{0} y {1}", "{0} y {1}"}, CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}}, "#,##0 %", 37, map[string]RelativeTime{
+        "day": {map[string]string{
+            "-1": "ayer",
+            "-2": "anteayer",
+            "0": "hoy",
+            "1": "mañana",
+            "2": "pasado mañana",
+        }, Count{"dentro de {0} día", "dentro de {0} días"}, Count{"hace {0} día", "hace {0} días"}},
+        "day-narrow": {map[string]string{
+            "-1": "ayer",
+            "-2": "anteayer",
+            "0": "hoy",
+            "1": "mañana",
+            "2": "pasado mañana",
+        }, Count{"dentro de {0} d", "dentro de {0} d"}, Count{"hace {0} d", "hace {0} d"}},
+        "day-short": {map[string]string{
+            "-1": "ayer",
+            "-2": "anteayer",
+            "0": "hoy",
+            "1": "mañana",
+            "2": "pasado mañana",
+        }, Count{"dentro de {0} d", "dentro de {0} d"}, Count{"hace {0} d", "hace {0} d"}},
+        "hour": {map[string]string{
+            "0": "esta hora",
+        }, Count{"dentro de {0} hora", "dentro de {0} horas"}, Count{"hace {0} hora", "hace {0} horas"}},
+        "hour-narrow": {map[string]string{
+            "0": "esta hora",
+        }, Count{"dentro de {0} h", "dentro de {0} h"}, Count{"hace {0} h", "hace {0} h"}},
+        "hour-short": {map[string]string{
+            "0": "esta hora",
+        }, Count{"dentro de {0} h", "dentro de {0} h"}, Count{"hace {0} h", "hace {0} h"}},
+        "minute": {map[string]string{
+            "0": "este minuto",
+        }, Count{"dentro de {0} minuto", "dentro de {0} minutos"}, Count{"hace {0} minuto", "hace {0} minutos"}},
+        "minute-narrow": {map[string]string{
+            "0": "este minuto",
+        }, Count{"dentro de {0} min", "dentro de {0} min"}, Count{"hace {0} min", "hace {0} min"}},
+        "minute-short": {map[string]string{
+            "0": "este minuto",
+        }, Count{"dentro de {0} min", "dentro de {0} min"}, Count{"hace {0} min", "hace {0} min"}},
+        "month": {map[string]string{
+            "-1": "el mes pasado",
+            "0": "este mes",
+            "1": "el próximo mes",
+        }, Count{"dentro de {0} mes", "dentro de {0} meses"}, Count{"hace {0} mes", "hace {0} meses"}},
+        "month-narrow": {map[string]string{
+            "-1": "el mes pasado",
+            "0": "este mes",
+            "1": "el próximo mes",
+        }, Count{"dentro de {0} m", "dentro de {0} m"}, Count{"hace {0} m", "hace {0} m"}},
+        "month-short": {map[string]string{
+            "-1": "el mes pasado",
+            "0": "este mes",
+            "1": "el próximo mes",
+        }, Count{"dentro de {0} m", "dentro de {0} m"}, Count{"hace {0} m", "hace {0} m"}},
+        "quarter": {map[string]string{
+            "-1": "el trimestre pasado",
+            "0": "este trimestre",
+            "1": "el próximo trimestre",
+        }, Count{"dentro de {0} trimestre", "dentro de {0} trimestres"}, Count{"hace {0} trimestre", "hace {0} trimestres"}},
+        "quarter-narrow": {map[string]string{
+            "-1": "el trim. pasado",
+            "0": "este trim.",
+            "1": "el próximo trim.",
+        }, Count{"dentro de {0} trim.", "dentro de {0} trim."}, Count{"hace {0} trim.", "hace {0} trim."}},
+        "quarter-short": {map[string]string{
+            "-1": "el trim. pasado",
+            "0": "este trim.",
+            "1": "el próximo trim.",
+        }, Count{"dentro de {0} trim.", "dentro de {0} trim."}, Count{"hace {0} trim.", "hace {0} trim."}},
+        "second": {map[string]string{
+            "0": "ahora",
+        }, Count{"dentro de {0} segundo", "dentro de {0} segundos"}, Count{"hace {0} segundo", "hace {0} segundos"}},
+        "second-narrow": {map[string]string{
+            "0": "ahora",
+        }, Count{"dentro de {0} s", "dentro de {0} s"}, Count{"hace {0} s", "hace {0} s"}},
+        "second-short": {map[string]string{
+            "0": "ahora",
+        }, Count{"dentro de {0} s", "dentro de {0} s"}, Count{"hace {0} s", "hace {0} s"}},
+        "week": {map[string]string{
+            "-1": "la semana pasada",
+            "0": "esta semana",
+            "1": "la próxima semana",
+        }, Count{"dentro de {0} semana", "dentro de {0} semanas"}, Count{"hace {0} semana", "hace {0} semanas"}},
+        "week-narrow": {map[string]string{
+            "-1": "sem. ant.",
+            "0": "esta sem.",
+            "1": "próx. sem.",
+        }, Count{"dentro de {0} sem.", "dentro de {0} sem."}, Count{"hace {0} sem.", "hace {0} sem."}},
+        "week-short": {map[string]string{
+            "-1": "sem. ant.",
+            "0": "esta sem.",
+            "1": "próx. sem.",
+        }, Count{"dentro de {0} sem.", "dentro de {0} sem."}, Count{"hace {0} sem.", "hace {0} sem."}},
+        "year": {map[string]string{
+            "-1": "el año pasado",
+            "0": "este año",
+            "1": "el próximo año",
+        }, Count{"dentro de {0} año", "dentro de {0} años"}, Count{"hace {0} año", "hace {0} años"}},
+        "year-narrow": {map[string]string{
+            "-1": "el año pasado",
+            "0": "este año",
+            "1": "el próximo año",
+        }, Count{"dentro de {0} a", "dentro de {0} a"}, Count{"hace {0} a", "hace {0} a"}},
+        "year-short": {map[string]string{
+            "-1": "el año pasado",
+            "0": "este año",
+            "1": "el próximo año",
+        }, Count{"dentro de {0} a", "dentro de {0} a"}, Count{"hace {0} a", "hace {0} a"}},
+    }, map[string]string{
+        "Bh": "h B",
+        "Bhm": "h:mm B",
+        "Bhms": "h:mm:ss B",
+        "E": "ccc",
+        "EBhm": "E h:mm B",
+        "EBhms": "E h:mm:ss B",
+        "EHm": "E, H:mm",
+        "EHms": "E, H:mm:ss",
+        "Ed": "E d",
+        "Ehm": "E, h:mm a",
+        "Ehms": "E, h:mm:ss a",
+        "Gy": "y G",
+        "GyMMM": "MMM y G",
+        "GyMMMEd": "E, d MMM y G",
+        "GyMMMM": "MMMM 'de' y G",
+        "GyMMMMEd": "E, d 'de' MMMM 'de' y G",
+        "GyMMMMd": "d 'de' MMMM 'de' y G",
+        "GyMMMd": "d MMM y G",
+        "GyMd": "d/M/y G",
+        "H": "H",
+        "Hm": "H:mm",
+        "Hms": "H:mm:ss",
+        "Hmsv": "H:mm:ss v",
+        "Hmsvvvv": "H:mm:ss (vvvv)",
+        "Hmv": "H:mm v",
+        "M": "L",
+        "MEd": "E, d/M",
+        "MMM": "LLL",
+        "MMMEd": "E, d MMM",
+        "MMMMEd": "E, d 'de' MMMM",
+        "MMMMd": "d 'de' MMMM",
+        "MMMd": "d MMM",
+        "MMd": "d/M",
+        "MMdd": "d/M",
+        "Md": "d/M",
+        "d": "d",
+        "h": "h a",
+        "hm": "h:mm a",
+        "hms": "h:mm:ss a",
+        "hmsv": "h:mm:ss a v",
+        "hmsvvvv": "h:mm:ss a (vvvv)",
+        "hmv": "h:mm a v",
+        "ms": "mm:ss",
+        "y": "y",
+        "yM": "M/y",
+        "yMEd": "EEE, d/M/y",
+        "yMM": "M/y",
+        "yMMM": "MMM y",
+        "yMMMEd": "EEE, d MMM y",
+        "yMMMM": "MMMM 'de' y",
+        "yMMMMEd": "EEE, d 'de' MMMM 'de' y",
+        "yMMMMd": "d 'de' MMMM 'de' y",
+        "yMMMd": "d MMM y",
+        "yMd": "d/M/y",
+        "yQQQ": "QQQ y",
+        "yQQQQ": "QQQQ 'de' y",
+    }, [2]CalendarSymbol{
+        {"antes de Cristo", "a. C.", "a. C.", ""},
+        {"después de Cristo", "d. C.", "d. C.", ""},
+    }, [4]CalendarSymbol{
+        {"1.er trimestre", "T1", "1", ""},
+        {"2.º trimestre", "T2", "2", ""},
+        {"3.er trimestre", "T3", "3", ""},
+        {"4.º trimestre", "T4", "4", ""},
+    }, [12]CalendarSymbol{
+        {"enero", "ene", "E", ""},
+        {"febrero", "feb", "F", ""},
+        {"marzo", "mar", "M", ""},
+        {"abril", "abr", "A", ""},
+        {"mayo", "may", "M", ""},
+        {"junio", "jun", "J", ""},
+        {"julio", "jul", "J", ""},
+        {"agosto", "ago", "A", ""},
+        {"septiembre", "sept", "S", ""},
+        {"octubre", "oct", "O", ""},
+        {"noviembre", "nov", "N", ""},
+        {"diciembre", "dic", "D", ""},
+    }, [7]CalendarSymbol{
+        {"domingo", "dom", "D", "DO"},
+        {"lunes", "lun", "L", "LU"},
+        {"martes", "mar", "M", "MA"},
+        {"miércoles", "mié", "X", "MI"},
+        {"jueves", "jue", "J", "JU"},
+        {"viernes", "vie", "V", "VI"},
+        {"sábado", "sáb", "S", "SA"},
+    }, [4]CalendarSymbol{
+        {"1.er trimestre", "T1", "1", ""},
+        {"2.º trimestre", "T2", "2", ""},
+        {"3.er trimestre", "T3", "3", ""},
+        {"4.º trimestre", "T4", "4", ""},
+    }, "gregorian", map[string]Calendar{
+        "buddhist": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"EB", "EB", "EB", ""},
+        }},
+        "hebrew": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{
+            {"tishri", "tishri", "1", ""},
+            {"heshvan", "heshvan", "2", ""},
+            {"kislev", "kislev", "3", ""},
+            {"tevet", "tevet", "4", ""},
+            {"shevat", "shevat", "5", ""},
+            {"adar I", "adar I", "6", ""},
+            {"adar", "adar", "7", ""},
+            {"nisán", "nisán", "8", ""},
+            {"iyar", "iyar", "9", ""},
+            {"siván", "siván", "10", ""},
+            {"tamuz", "tamuz", "11", ""},
+            {"av", "av", "12", ""},
+            {"elul", "elul", "13", ""},
+            {"adar II", "adar II", "7", ""},
+        }, []CalendarSymbol{
+            {"AM", "AM", "AM", ""},
+        }},
+        "islamic": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{
+            {"muharram", "muh.", "1", ""},
+            {"safar", "saf.", "2", ""},
+            {"rabía I", "rab. I", "3", ""},
+            {"rabía II", "rab. II", "4", ""},
+            {"yumada I", "yum. I", "5", ""},
+            {"yumada II", "yum. II", "6", ""},
+            {"rayab", "ray.", "7", ""},
+            {"shabán", "sha.", "8", ""},
+            {"ramadán", "ram.", "9", ""},
+            {"shawal", "shaw.", "10", ""},
+            {"dhu l-qa'da", "dhu l-q.", "11", ""},
+            {"dhu l-hiyya", "dhu l-h.", "12", ""},
+        }, []CalendarSymbol{
+            {"a. H.", "a. H.", "a. H.", ""},
+        }},
+        "japanese": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Meiji", "Meiji", "M", ""},
+            {"Taishō", "Taishō", "T", ""},
+            {"Shōwa", "Shōwa", "S", ""},
+            {"Heisei", "Heisei", "H", ""},
+            {"Reiwa", "Reiwa", "R", ""},
+        }},
+        "persian": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{
+            {"farvardin", "farvardin", "1", ""},
+            {"ordibehesht", "ordibehesht", "2", ""},
+            {"jordad", "jordad", "3", ""},
+            {"tir", "tir", "4", ""},
+            {"mordad", "mordad", "5", ""},
+            {"shahrivar", "shahrivar", "6", ""},
+            {"mehr", "mehr", "7", ""},
+            {"aban", "aban", "8", ""},
+            {"azar", "azar", "9", ""},
+            {"dey", "dey", "10", ""},
+            {"bahman", "bahman", "11", ""},
+            {"esfand", "esfand", "12", ""},
+        }, []CalendarSymbol{
+            {"AP", "AP", "AP", ""},
+        }},
+    }, 1, 4, "H", []string{
+        "H",
+        "h",
+        "hB",
+        "hb",
+    }, "latn", "", "metric", "", "GMT{0}", "GMT", "+HH:mm;-HH:mm", "hora de {0}", "horario de verano de {0}", "horario estándar de {0}", "{1} ({0})"},
+    "es_419": {"#,##0.###", CurrencyFormat{"¤#,##0.00", "#,##0.00", "¤ #,##0.00", Count{"{0} {1}", "{0} {1}"}}, CalendarFormat{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy"}, CalendarFormat{"h:mm:ss a zzzz", "h:mm:ss a z", "h:mm:ss a", "h:mm a"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
         "": {
             "": "{0} – {1}",
         },
@@ -2471,39 +3068,39 @@ var locales = map[string]Locale{
             "y": "d/M/y–d/M/y",
         },
     }, "{0} {1}", 46, 44, 46, 44, 43, 45, 58, [12]CalendarSymbol{
-        {"enero", "ene", "E"},
-        {"febrero", "feb", "F"},
-        {"marzo", "mar", "M"},
-        {"abril", "abr", "A"},
-        {"mayo", "may", "M"},
-        {"junio", "jun", "J"},
-        {"julio", "jul", "J"},
-        {"agosto", "ago", "A"},
-        {"septiembre", "sept", "S"},
-        {"octubre", "oct", "O"},
-        {"noviembre", "nov", "N"},
-        {"diciembre", "dic", "D"},
+        {"enero", "ene", "E", ""},
+        {"febrero", "feb", "F", ""},
+        {"marzo", "mar", "M", ""},
+        {"abril", "abr", "A", ""},
+        {"mayo", "may", "M", ""},
+        {"junio", "jun", "J", ""},
+        {"julio", "jul", "J", ""},
+        {"agosto", "ago", "A", ""},
+        {"septiembre", "sept", "S", ""},
+        {"octubre", "oct", "O", ""},
+        {"noviembre", "nov", "N", ""},
+        {"diciembre", "dic", "D", ""},
     }, [7]CalendarSymbol{
-        {"domingo", "dom", "D"},
-        {"lunes", "lun", "L"},
-        {"martes", "mar", "M"},
-        {"miércoles", "mié", "M"},
-        {"jueves", "jue", "J"},
-        {"viernes", "vie", "V"},
-        {"sábado", "sáb", "S"},
+        {"domingo", "dom", "D", "DO"},
+        {"lunes", "lun", "L", "LU"},
+        {"martes", "mar", "M", "MA"},
+        {"miércoles", "mié", "M", "MI"},
+        {"jueves", "jue", "J", "JU"},
+        {"viernes", "vie", "V", "VI"},
+        {"sábado", "sáb", "S", "SA"},
     }, map[string]DayPeriodRule{
         "evening1": {720, 1200},
         "morning1": {0, 360},
         "morning2": {360, 720},
         "night1": {1200, 1440},
     }, map[string]CalendarSymbol{
-        "am": {"a.m.", "a.m.", "AM"},
-        "evening1": {"", "de la tarde", ""},
-        "morning1": {"", "de la madrugada", ""},
-        "morning2": {"", "de la mañana", ""},
-        "night1": {"", "de la noche", ""},
-        "noon": {"", "del mediodía", ""},
-        "pm": {"p.m.", "p.m.", "PM"},
+        "am": {"a.m.", "a.m.", "AM", ""},
+        "evening1": {"", "de la tarde", "", ""},
+        "morning1": {"", "de la madrugada", "", ""},
+        "morning2": {"", "de la mañana", "", ""},
+        "night1": {"", "de la noche", "", ""},
+        "noon": {"", "del mediodía", "", ""},
+        "pm": {"p.m.", "p.m.", "PM", ""},
     }, map[string]string{
         "Africa/Abidjan": "Abiyán",
         "Africa/Accra": "Acra",
@@ -2872,289 +3469,289 @@ var locales = map[string]Locale{
         "Yekaterinburg": {MetazoneSymbol{"hora de Ekaterimburgo", ""}, MetazoneSymbol{"hora estándar de Ekaterimburgo", ""}, MetazoneSymbol{"hora de verano de Ekaterimburgo", ""}},
         "Yukon": {MetazoneSymbol{"", ""}, MetazoneSymbol{"hora de Yukón", ""}, MetazoneSymbol{"", ""}},
     }, map[string]Currency{
-        "ADP": {"peseta andorrana", "", ""},
-        "AED": {"dírham de los Emiratos Árabes Unidos", "", ""},
-        "AFA": {"afgani (1927–2002)", "", ""},
-        "AFN": {"afgani afgano", "", "؋"},
-        "ALL": {"lek albanés", "", ""},
-        "AMD": {"dram armenio", "", "֏"},
-        "ANG": {"florín de las Antillas Neerlandesas", "", ""},
-        "AOA": {"kuanza angoleño", "", "Kz"},
-        "AOK": {"kwanza angoleño (1977–1990)", "", ""},
-        "AON": {"nuevo kwanza angoleño (1990–2000)", "", ""},
-        "AOR": {"kwanza reajustado angoleño (1995–1999)", "", ""},
-        "ARA": {"austral argentino", "", ""},
-        "ARP": {"peso argentino (1983–1985)", "", ""},
-        "ARS": {"peso argentino", "", "$"},
-        "ATS": {"chelín austriaco", "", ""},
-        "AUD": {"dólar australiano", "AUD", "$"},
-        "AWG": {"florín arubeño", "", ""},
-        "AZM": {"manat azerí (1993–2006)", "", ""},
-        "AZN": {"manat azerbaiyano", "", "₼"},
-        "BAD": {"dinar bosnio", "", ""},
-        "BAM": {"marco convertible de Bosnia y Herzegovina", "", "KM"},
-        "BBD": {"dólar barbadense", "", "$"},
-        "BDT": {"taka bangladesí", "", "৳"},
-        "BEC": {"franco belga (convertible)", "", ""},
-        "BEF": {"franco belga", "", ""},
-        "BEL": {"franco belga (financiero)", "", ""},
-        "BGL": {"lev fuerte búlgaro", "", ""},
-        "BGN": {"leva búlgara", "", ""},
-        "BHD": {"dinar bareiní", "", ""},
-        "BIF": {"franco burundés", "", ""},
-        "BMD": {"dólar de Bermudas", "", "$"},
-        "BND": {"dólar bruneano", "", "$"},
-        "BOB": {"boliviano", "", "Bs"},
-        "BOP": {"peso boliviano", "", ""},
-        "BOV": {"MVDOL boliviano", "", ""},
-        "BRB": {"nuevo cruceiro brasileño (1967–1986)", "", ""},
-        "BRC": {"cruzado brasileño", "", ""},
-        "BRE": {"cruceiro brasileño (1990–1993)", "", ""},
-        "BRL": {"real brasileño", "BRL", "R$"},
-        "BRN": {"nuevo cruzado brasileño", "", ""},
-        "BRR": {"cruceiro brasileño", "", ""},
-        "BSD": {"dólar bahameño", "", "$"},
-        "BTN": {"gultrum butanés", "", ""},
-        "BUK": {"kyat birmano", "", ""},
-        "BWP": {"pula botsuano", "", "P"},
-        "BYB": {"nuevo rublo bielorruso (1994–1999)", "", ""},
-        "BYN": {"rublo bielorruso", "", "р."},
-        "BYR": {"rublo bielorruso (2000–2016)", "", ""},
-        "BZD": {"dólar beliceño", "", "$"},
-        "CAD": {"dólar canadiense", "CAD", "$"},
-        "CDF": {"franco congoleño", "", ""},
-        "CHE": {"euro WIR", "", ""},
-        "CHF": {"franco suizo", "", ""},
-        "CHW": {"franco WIR", "", ""},
-        "CLF": {"unidad de fomento chilena", "", ""},
-        "CLP": {"peso chileno", "", "$"},
-        "CNH": {"yuan chino (extracontinental)", "", ""},
-        "CNY": {"yuan renminbi", "CNY", "¥"},
-        "COP": {"peso colombiano", "", "$"},
-        "COU": {"unidad de valor real colombiana", "", ""},
-        "CRC": {"colón costarricense", "", "₡"},
-        "CSD": {"antiguo dinar serbio", "", ""},
-        "CSK": {"corona fuerte checoslovaca", "", ""},
-        "CUC": {"peso cubano convertible", "", "$"},
-        "CUP": {"peso cubano", "", "$"},
-        "CVE": {"escudo de Cabo Verde", "", ""},
-        "CYP": {"libra chipriota", "", ""},
-        "CZK": {"corona checa", "", "Kč"},
-        "DDM": {"ostmark de Alemania del Este", "", ""},
-        "DEM": {"marco alemán", "", ""},
-        "DJF": {"franco yibutiano", "", ""},
-        "DKK": {"corona danesa", "", "kr"},
-        "DOP": {"peso dominicano", "", "$"},
-        "DZD": {"dinar argelino", "", ""},
-        "ECS": {"sucre ecuatoriano", "", ""},
-        "ECV": {"unidad de valor constante (UVC) ecuatoriana", "", ""},
-        "EEK": {"corona estonia", "", ""},
-        "EGP": {"libra egipcia", "", "E£"},
-        "ERN": {"nakfa eritreo", "", ""},
-        "ESA": {"peseta española (cuenta A)", "", ""},
-        "ESB": {"peseta española (cuenta convertible)", "", ""},
-        "ESP": {"peseta española", "₧", "₧"},
-        "ETB": {"bir etíope", "", ""},
-        "EUR": {"euro", "EUR", "€"},
-        "FIM": {"marco finlandés", "", ""},
-        "FJD": {"dólar fiyiano", "", "$"},
-        "FKP": {"libra malvinense", "", "FK£"},
-        "FRF": {"franco francés", "", ""},
-        "GBP": {"libra esterlina", "GBP", "£"},
-        "GEK": {"kupon larit georgiano", "", ""},
-        "GEL": {"lari georgiano", "", "₾"},
-        "GHC": {"cedi ghanés (1979–2007)", "", ""},
-        "GHS": {"cedi ghanés", "", "GH₵"},
-        "GIP": {"libra gibraltareña", "", "£"},
-        "GMD": {"dalasi gambiano", "", ""},
-        "GNF": {"franco guineano", "", "FG"},
-        "GNS": {"syli guineano", "", ""},
-        "GQE": {"ekuele de Guinea Ecuatorial", "", ""},
-        "GRD": {"dracma griego", "", ""},
-        "GTQ": {"quetzal guatemalteco", "", "Q"},
-        "GWE": {"escudo de Guinea Portuguesa", "", ""},
-        "GWP": {"peso de Guinea-Bissáu", "", ""},
-        "GYD": {"dólar guyanés", "", "$"},
-        "HKD": {"dólar hongkonés", "HKD", "$"},
-        "HNL": {"lempira hondureño", "", "L"},
-        "HRD": {"dinar croata", "", ""},
-        "HRK": {"kuna croata", "", "kn"},
-        "HTG": {"gourde haitiano", "", ""},
-        "HUF": {"forinto húngaro", "", "Ft"},
-        "IDR": {"rupia indonesia", "", "Rp"},
-        "IEP": {"libra irlandesa", "", ""},
-        "ILP": {"libra israelí", "", ""},
-        "ILS": {"nuevo séquel israelí", "ILS", "₪"},
-        "INR": {"rupia india", "INR", "₹"},
-        "IQD": {"dinar iraquí", "", ""},
-        "IRR": {"rial iraní", "", ""},
-        "ISK": {"corona islandesa", "", "kr"},
-        "ITL": {"lira italiana", "", ""},
-        "JMD": {"dólar jamaicano", "", "$"},
-        "JOD": {"dinar jordano", "", ""},
-        "JPY": {"yen japonés", "JPY", "¥"},
-        "KES": {"chelín keniano", "", ""},
-        "KGS": {"som kirguís", "", "⃀"},
-        "KHR": {"riel camboyano", "", "៛"},
-        "KMF": {"franco comorense", "", "CF"},
-        "KPW": {"won norcoreano", "", "₩"},
-        "KRW": {"won surcoreano", "KRW", "₩"},
-        "KWD": {"dinar kuwaití", "", ""},
-        "KYD": {"dólar de las Islas Caimán", "", "$"},
-        "KZT": {"tenge kazajo", "", "₸"},
-        "LAK": {"kip laosiano", "", "₭"},
-        "LBP": {"libra libanesa", "", "L£"},
-        "LKR": {"rupia esrilanquesa", "", "Rs"},
-        "LRD": {"dólar liberiano", "", "$"},
-        "LSL": {"loti lesotense", "", ""},
-        "LTL": {"litas lituano", "", "Lt"},
-        "LTT": {"talonas lituano", "", ""},
-        "LUC": {"franco convertible luxemburgués", "", ""},
-        "LUF": {"franco luxemburgués", "", ""},
-        "LUL": {"franco financiero luxemburgués", "", ""},
-        "LVL": {"lats letón", "", "Ls"},
-        "LVR": {"rublo letón", "", ""},
-        "LYD": {"dinar libio", "", ""},
-        "MAD": {"dírham marroquí", "", ""},
-        "MAF": {"franco marroquí", "", ""},
-        "MDL": {"leu moldavo", "", ""},
-        "MGA": {"ariari malgache", "", "Ar"},
-        "MGF": {"franco malgache", "", ""},
-        "MKD": {"dinar macedonio", "", ""},
-        "MLF": {"franco malí", "", ""},
-        "MMK": {"kiat de Myanmar", "", "K"},
-        "MNT": {"tugrik mongol", "", "₮"},
-        "MOP": {"pataca macaense", "", ""},
-        "MRO": {"uguiya (1973–2017)", "", ""},
-        "MRU": {"uguiya mauritano", "", ""},
-        "MTL": {"lira maltesa", "", ""},
-        "MTP": {"libra maltesa", "", ""},
-        "MUR": {"rupia mauriciana", "", "Rs"},
-        "MVR": {"rufiya maldiva", "", ""},
-        "MWK": {"kwacha malauí", "", ""},
-        "MXN": {"peso mexicano", "MXN", "$"},
-        "MXP": {"peso de plata mexicano (1861–1992)", "", ""},
-        "MXV": {"unidad de inversión (UDI) mexicana", "", ""},
-        "MYR": {"ringit malasio", "", "RM"},
-        "MZE": {"escudo mozambiqueño", "", ""},
-        "MZM": {"antiguo metical mozambiqueño", "", ""},
-        "MZN": {"metical mozambiqueño", "", ""},
-        "NAD": {"dólar namibio", "", "$"},
-        "NGN": {"naira nigeriano", "", "₦"},
-        "NIC": {"córdoba nicaragüense (1988–1991)", "", ""},
-        "NIO": {"córdoba nicaragüense", "", "C$"},
-        "NLG": {"florín neerlandés", "", ""},
-        "NOK": {"corona noruega", "", "kr"},
-        "NPR": {"rupia nepalí", "", "Rs"},
-        "NZD": {"dólar neozelandés", "NZD", "$"},
-        "OMR": {"rial omaní", "", ""},
-        "PAB": {"balboa panameño", "", ""},
-        "PEI": {"inti peruano", "", ""},
-        "PEN": {"sol peruano", "", ""},
-        "PES": {"sol peruano (1863–1965)", "", ""},
-        "PGK": {"kina papú", "", ""},
-        "PHP": {"peso filipino", "PHP", "₱"},
-        "PKR": {"rupia pakistaní", "", "Rs"},
-        "PLN": {"esloti polaco", "", "zł"},
-        "PLZ": {"zloty polaco (1950–1995)", "", ""},
-        "PTE": {"escudo portugués", "", ""},
-        "PYG": {"guaraní paraguayo", "", "₲"},
-        "QAR": {"rial catarí", "", ""},
-        "RHD": {"dólar rodesiano", "", ""},
-        "ROL": {"antiguo leu rumano", "", ""},
-        "RON": {"leu rumano", "", "L"},
-        "RSD": {"dinar serbio", "", ""},
-        "RUB": {"rublo ruso", "", "₽"},
-        "RUR": {"rublo ruso (1991–1998)", "", ""},
-        "RWF": {"franco ruandés", "", "RF"},
-        "SAR": {"rial saudí", "⃁", ""},
-        "SBD": {"dólar salomonense", "", "$"},
-        "SCR": {"rupia seychellense", "", ""},
-        "SDD": {"dinar sudanés", "", ""},
-        "SDG": {"libra sudanesa", "", ""},
-        "SDP": {"libra sudanesa antigua", "", ""},
-        "SEK": {"corona sueca", "", "kr"},
-        "SGD": {"dólar singapurense", "", "$"},
-        "SHP": {"libra de Santa Elena", "", "£"},
-        "SIT": {"tólar esloveno", "", ""},
-        "SKK": {"corona eslovaca", "", ""},
-        "SLE": {"leone", "", ""},
-        "SLL": {"leones (1964—2022)", "", ""},
-        "SOS": {"chelín somalí", "", ""},
-        "SRD": {"dólar surinamés", "", "$"},
-        "SRG": {"florín surinamés", "", ""},
-        "SSP": {"libra sursudanesa", "", "SD£"},
-        "STD": {"dobra (1977–2017)", "", ""},
-        "STN": {"dobra santotomense", "", "Db"},
-        "SUR": {"rublo soviético", "", ""},
-        "SVC": {"colón salvadoreño", "", ""},
-        "SYP": {"libra siria", "", "S£"},
-        "SZL": {"lilangeni esuatiní", "", ""},
-        "THB": {"baht tailandes", "THB", "฿"},
-        "TJR": {"rublo tayiko", "", ""},
-        "TJS": {"somoni tayiko", "", ""},
-        "TMM": {"manat turcomano (1993–2009)", "", ""},
-        "TMT": {"manat turcomano", "", ""},
-        "TND": {"dinar tunecino", "", ""},
-        "TOP": {"paanga tongano", "", "T$"},
-        "TPE": {"escudo timorense", "", ""},
-        "TRL": {"lira turca (1922–2005)", "", ""},
-        "TRY": {"lira turca", "TL", "₺"},
-        "TTD": {"dólar de Trinidad y Tobago", "", "$"},
-        "TWD": {"nuevo dólar taiwanés", "TWD", "NT$"},
-        "TZS": {"chelín tanzano", "", ""},
-        "UAH": {"grivna ucraniana", "", "₴"},
-        "UAK": {"karbovanet ucraniano", "", ""},
-        "UGS": {"chelín ugandés (1966–1987)", "", ""},
-        "UGX": {"chelín ugandés", "", ""},
-        "USD": {"dólar estadounidense", "USD", "$"},
-        "USN": {"dólar estadounidense (día siguiente)", "", ""},
-        "USS": {"dólar estadounidense (mismo día)", "", ""},
-        "UYI": {"peso uruguayo en unidades indexadas", "", ""},
-        "UYP": {"peso uruguayo (1975–1993)", "", ""},
-        "UYU": {"peso uruguayo", "", "$"},
-        "UYW": {"unidad previsional uruguayo", "", ""},
-        "UZS": {"som uzbeko", "", ""},
-        "VEB": {"bolívar venezolano (1871–2008)", "", ""},
-        "VEF": {"bolívar venezolano (2008–2018)", "", "BsF"},
-        "VES": {"bolívar venezolano", "", ""},
-        "VND": {"dong vietnamita", "VND", "₫"},
-        "VUV": {"vatu vanuatense", "", ""},
-        "WST": {"tala samoano", "", ""},
-        "XAF": {"franco CFA de África Central", "XAF", ""},
-        "XAG": {"plata", "", ""},
-        "XAU": {"oro", "", ""},
-        "XBA": {"unidad compuesta europea", "", ""},
-        "XBB": {"unidad monetaria europea", "", ""},
-        "XBC": {"unidad de cuenta europea (XBC)", "", ""},
-        "XBD": {"unidad de cuenta europea (XBD)", "", ""},
-        "XCD": {"dólar del Caribe Oriental", "XCD", "$"},
-        "XCG": {"florín caribeño", "Cg.", ""},
-        "XDR": {"derechos especiales de giro", "", ""},
-        "XEU": {"unidad de moneda europea", "", ""},
-        "XFO": {"franco oro francés", "", ""},
-        "XFU": {"franco UIC francés", "", ""},
-        "XOF": {"franco CFA de África Occidental", "XOF", ""},
-        "XPD": {"paladio", "", ""},
-        "XPF": {"franco CFP", "CFPF", ""},
-        "XPT": {"platino", "", ""},
-        "XRE": {"fondos RINET", "", ""},
-        "XTS": {"código reservado para pruebas", "", ""},
-        "XXX": {"moneda desconocida", "¤", ""},
-        "YDD": {"dinar yemení", "", ""},
-        "YER": {"rial yemení", "", ""},
-        "YUD": {"dinar fuerte yugoslavo", "", ""},
-        "YUM": {"super dinar yugoslavo", "", ""},
-        "YUN": {"dinar convertible yugoslavo", "", ""},
-        "ZAL": {"rand sudafricano (financiero)", "", ""},
-        "ZAR": {"rand sudafricano", "", "R"},
-        "ZMK": {"kwacha zambiano (1968–2012)", "", ""},
-        "ZMW": {"kuacha zambiano", "", "ZK"},
-        "ZRN": {"nuevo zaire zaireño", "", ""},
-        "ZRZ": {"zaire zaireño", "", ""},
-        "ZWD": {"dólar de Zimbabue", "", ""},
-        "ZWG": {"oro zimbabuense", "", ""},
-        "ZWL": {"dólar zimbabuense", "", ""},
+        "ADP": {"peseta andorrana", "", "", Count{"", ""}, 0, 0},
+        "AED": {"dírham de los Emiratos Árabes Unidos", "", "", Count{"", ""}, 0, 0},
+        "AFA": {"afgani (1927–2002)", "", "", Count{"", ""}, 0, 0},
+        "AFN": {"afgani afgano", "", "؋", Count{"", ""}, 0, 0},
+        "ALL": {"lek albanés", "", "", Count{"", ""}, 0, 0},
+        "AMD": {"dram armenio", "", "֏", Count{"", ""}, 0, 0},
+        "ANG": {"florín de las Antillas Neerlandesas", "", "", Count{"", ""}, 0, 0},
+        "AOA": {"kuanza angoleño", "", "Kz", Count{"", ""}, 0, 0},
+        "AOK": {"kwanza angoleño (1977–1990)", "", "", Count{"", ""}, 0, 0},
+        "AON": {"nuevo kwanza angoleño (1990–2000)", "", "", Count{"", ""}, 0, 0},
+        "AOR": {"kwanza reajustado angoleño (1995–1999)", "", "", Count{"", ""}, 0, 0},
+        "ARA": {"austral argentino", "", "", Count{"", ""}, 0, 0},
+        "ARP": {"peso argentino (1983–1985)", "", "", Count{"", ""}, 0, 0},
+        "ARS": {"peso argentino", "", "$", Count{"peso argentino", "pesos argentinos"}, 0, 0},
+        "ATS": {"chelín austriaco", "", "", Count{"", ""}, 0, 0},
+        "AUD": {"dólar australiano", "AUD", "$", Count{"dólar australiano", "dólares australianos"}, 0, 0},
+        "AWG": {"florín arubeño", "", "", Count{"", ""}, 0, 0},
+        "AZM": {"manat azerí (1993–2006)", "", "", Count{"", ""}, 0, 0},
+        "AZN": {"manat azerbaiyano", "", "₼", Count{"", ""}, 0, 0},
+        "BAD": {"dinar bosnio", "", "", Count{"", ""}, 0, 0},
+        "BAM": {"marco convertible de Bosnia y Herzegovina", "", "KM", Count{"", ""}, 0, 0},
+        "BBD": {"dólar barbadense", "", "$", Count{"", ""}, 0, 0},
+        "BDT": {"taka bangladesí", "", "৳", Count{"", ""}, 0, 0},
+        "BEC": {"franco belga (convertible)", "", "", Count{"", ""}, 0, 0},
+        "BEF": {"franco belga", "", "", Count{"", ""}, 0, 0},
+        "BEL": {"franco belga (financiero)", "", "", Count{"", ""}, 0, 0},
+        "BGL": {"lev fuerte búlgaro", "", "", Count{"", ""}, 0, 0},
+        "BGN": {"leva búlgara", "", "", Count{"", ""}, 0, 0},
+        "BHD": {"dinar bareiní", "", "", Count{"", ""}, 0, 0},
+        "BIF": {"franco burundés", "", "", Count{"", ""}, 0, 0},
+        "BMD": {"dólar de Bermudas", "", "$", Count{"", ""}, 0, 0},
+        "BND": {"dólar bruneano", "", "$", Count{"", ""}, 0, 0},
+        "BOB": {"boliviano", "", "Bs", Count{"", ""}, 0, 0},
+        "BOP": {"peso boliviano", "", "", Count{"", ""}, 0, 0},
+        "BOV": {"MVDOL boliviano", "", "", Count{"", ""}, 0, 0},
+        "BRB": {"nuevo cruceiro brasileño (1967–1986)", "", "", Count{"", ""}, 0, 0},
+        "BRC": {"cruzado brasileño", "", "", Count{"", ""}, 0, 0},
+        "BRE": {"cruceiro brasileño (1990–1993)", "", "", Count{"", ""}, 0, 0},
+        "BRL": {"real brasileño", "BRL", "R$", Count{"real brasileño", "reales brasileños"}, 0, 0},
+        "BRN": {"nuevo cruzado brasileño", "", "", Count{"", ""}, 0, 0},
+        "BRR": {"cruceiro brasileño", "", "", Count{"", ""}, 0, 0},
+        "BSD": {"dólar bahameño", "", "$", Count{"", ""}, 0, 0},
+        "BTN": {"gultrum butanés", "", "", Count{"", ""}, 0, 0},
+        "BUK": {"kyat birmano", "", "", Count{"", ""}, 0, 0},
+        "BWP": {"pula botsuano", "", "P", Count{"", ""}, 0, 0},
+        "BYB": {"nuevo rublo bielorruso (1994–1999)", "", "", Count{"", ""}, 0, 0},
+        "BYN": {"rublo bielorruso", "", "р.", Count{"", ""}, 0, 0},
+        "BYR": {"rublo bielorruso (2000–2016)", "", "", Count{"", ""}, 0, 0},
+        "BZD": {"dólar beliceño", "", "$", Count{"", ""}, 0, 0},
+        "CAD": {"dólar canadiense", "CAD", "$", Count{"dólar canadiense", "dólares canadienses"}, 0, 0},
+        "CDF": {"franco congoleño", "", "", Count{"", ""}, 0, 0},
+        "CHE": {"euro WIR", "", "", Count{"", ""}, 0, 0},
+        "CHF": {"franco suizo", "", "", Count{"franco suizo", "francos suizos"}, 0, 0},
+        "CHW": {"franco WIR", "", "", Count{"", ""}, 0, 0},
+        "CLF": {"unidad de fomento chilena", "", "", Count{"", ""}, 0, 0},
+        "CLP": {"peso chileno", "", "$", Count{"peso chileno", "pesos chilenos"}, 0, 0},
+        "CNH": {"yuan chino (extracontinental)", "", "", Count{"", ""}, 0, 0},
+        "CNY": {"yuan renminbi", "CNY", "¥", Count{"", ""}, 0, 0},
+        "COP": {"peso colombiano", "", "$", Count{"peso colombiano", "pesos colombianos"}, 0, 0},
+        "COU": {"unidad de valor real colombiana", "", "", Count{"", ""}, 0, 0},
+        "CRC": {"colón costarricense", "", "₡", Count{"", ""}, 0, 0},
+        "CSD": {"antiguo dinar serbio", "", "", Count{"", ""}, 0, 0},
+        "CSK": {"corona fuerte checoslovaca", "", "", Count{"", ""}, 0, 0},
+        "CUC": {"peso cubano convertible", "", "$", Count{"", ""}, 0, 0},
+        "CUP": {"peso cubano", "", "$", Count{"", ""}, 0, 0},
+        "CVE": {"escudo de Cabo Verde", "", "", Count{"escudo de Cabo Verde", "escudos de Cabo Verde"}, 0, 0},
+        "CYP": {"libra chipriota", "", "", Count{"", ""}, 0, 0},
+        "CZK": {"corona checa", "", "Kč", Count{"", ""}, 0, 0},
+        "DDM": {"ostmark de Alemania del Este", "", "", Count{"", ""}, 0, 0},
+        "DEM": {"marco alemán", "", "", Count{"", ""}, 0, 0},
+        "DJF": {"franco yibutiano", "", "", Count{"", ""}, 0, 0},
+        "DKK": {"corona danesa", "", "kr", Count{"", ""}, 0, 0},
+        "DOP": {"peso dominicano", "", "$", Count{"", ""}, 0, 0},
+        "DZD": {"dinar argelino", "", "", Count{"", ""}, 0, 0},
+        "ECS": {"sucre ecuatoriano", "", "", Count{"", ""}, 0, 0},
+        "ECV": {"unidad de valor constante (UVC) ecuatoriana", "", "", Count{"", ""}, 0, 0},
+        "EEK": {"corona estonia", "", "", Count{"", ""}, 0, 0},
+        "EGP": {"libra egipcia", "", "E£", Count{"", ""}, 0, 0},
+        "ERN": {"nakfa eritreo", "", "", Count{"", ""}, 0, 0},
+        "ESA": {"peseta española (cuenta A)", "", "", Count{"", ""}, 0, 0},
+        "ESB": {"peseta española (cuenta convertible)", "", "", Count{"", ""}, 0, 0},
+        "ESP": {"peseta española", "₧", "₧", Count{"", ""}, 0, 0},
+        "ETB": {"bir etíope", "", "", Count{"", ""}, 0, 0},
+        "EUR": {"euro", "EUR", "€", Count{"euro", "euros"}, 0, 0},
+        "FIM": {"marco finlandés", "", "", Count{"", ""}, 0, 0},
+        "FJD": {"dólar fiyiano", "", "$", Count{"", ""}, 0, 0},
+        "FKP": {"libra malvinense", "", "FK£", Count{"", ""}, 0, 0},
+        "FRF": {"franco francés", "", "", Count{"", ""}, 0, 0},
+        "GBP": {"libra esterlina", "GBP", "£", Count{"libra esterlina", "libras esterlinas"}, 0, 0},
+        "GEK": {"kupon larit georgiano", "", "", Count{"", ""}, 0, 0},
+        "GEL": {"lari georgiano", "", "₾", Count{"", ""}, 0, 0},
+        "GHC": {"cedi ghanés (1979–2007)", "", "", Count{"", ""}, 0, 0},
+        "GHS": {"cedi ghanés", "", "GH₵", Count{"", ""}, 0, 0},
+        "GIP": {"libra gibraltareña", "", "£", Count{"", ""}, 0, 0},
+        "GMD": {"dalasi gambiano", "", "", Count{"", ""}, 0, 0},
+        "GNF": {"franco guineano", "", "FG", Count{"", ""}, 0, 0},
+        "GNS": {"syli guineano", "", "", Count{"", ""}, 0, 0},
+        "GQE": {"ekuele de Guinea Ecuatorial", "", "", Count{"", ""}, 0, 0},
+        "GRD": {"dracma griego", "", "", Count{"", ""}, 0, 0},
+        "GTQ": {"quetzal guatemalteco", "", "Q", Count{"", ""}, 0, 0},
+        "GWE": {"escudo de Guinea Portuguesa", "", "", Count{"", ""}, 0, 0},
+        "GWP": {"peso de Guinea-Bissáu", "", "", Count{"", ""}, 0, 0},
+        "GYD": {"dólar guyanés", "", "$", Count{"", ""}, 0, 0},
+        "HKD": {"dólar hongkonés", "HKD", "$", Count{"", ""}, 0, 0},
+        "HNL": {"lempira hondureño", "", "L", Count{"", ""}, 0, 0},
+        "HRD": {"dinar croata", "", "", Count{"", ""}, 0, 0},
+        "HRK": {"kuna croata", "", "kn", Count{"", ""}, 0, 0},
+        "HTG": {"gourde haitiano", "", "", Count{"", ""}, 0, 0},
+        "HUF": {"forinto húngaro", "", "Ft", Count{"", ""}, 0, 0},
+        "IDR": {"rupia indonesia", "", "Rp", Count{"", ""}, 0, 0},
+        "IEP": {"libra irlandesa", "", "", Count{"", ""}, 0, 0},
+        "ILP": {"libra israelí", "", "", Count{"", ""}, 0, 0},
+        "ILS": {"nuevo séquel israelí", "ILS", "₪", Count{"", ""}, 0, 0},
+        "INR": {"rupia india", "INR", "₹", Count{"", ""}, 0, 0},
+        "IQD": {"dinar iraquí", "", "", Count{"", ""}, 0, 0},
+        "IRR": {"rial iraní", "", "", Count{"", ""}, 0, 0},
+        "ISK": {"corona islandesa", "", "kr", Count{"", ""}, 0, 0},
+        "ITL": {"lira italiana", "", "", Count{"", ""}, 0, 0},
+        "JMD": {"dólar jamaicano", "", "$", Count{"", ""}, 0, 0},
+        "JOD": {"dinar jordano", "", "", Count{"", ""}, 0, 0},
+        "JPY": {"yen japonés", "JPY", "¥", Count{"yen japonés", "yenes japoneses"}, 0, 0},
+        "KES": {"chelín keniano", "", "", Count{"", ""}, 0, 0},
+        "KGS": {"som kirguís", "", "⃀", Count{"", ""}, 0, 0},
+        "KHR": {"riel camboyano", "", "៛", Count{"", ""}, 0, 0},
+        "KMF": {"franco comorense", "", "CF", Count{"", ""}, 0, 0},
+        "KPW": {"won norcoreano", "", "₩", Count{"", ""}, 0, 0},
+        "KRW": {"won surcoreano", "KRW", "₩", Count{"", ""}, 0, 0},
+        "KWD": {"dinar kuwaití", "", "", Count{"", ""}, 0, 0},
+        "KYD": {"dólar de las Islas Caimán", "", "$", Count{"", ""}, 0, 0},
+        "KZT": {"tenge kazajo", "", "₸", Count{"", ""}, 0, 0},
+        "LAK": {"kip laosiano", "", "₭", Count{"", ""}, 0, 0},
+        "LBP": {"libra libanesa", "", "L£", Count{"", ""}, 0, 0},
+        "LKR": {"rupia esrilanquesa", "", "Rs", Count{"", ""}, 0, 0},
+        "LRD": {"dólar liberiano", "", "$", Count{"", ""}, 0, 0},
+        "LSL": {"loti lesotense", "", "", Count{"", ""}, 0, 0},
+        "LTL": {"litas lituano", "", "Lt", Count{"", ""}, 0, 0},
+        "LTT": {"talonas lituano", "", "", Count{"", ""}, 0, 0},
+        "LUC": {"franco convertible luxemburgués", "", "", Count{"", ""}, 0, 0},
+        "LUF": {"franco luxemburgués", "", "", Count{"", ""}, 0, 0},
+        "LUL": {"franco financiero luxemburgués", "", "", Count{"", ""}, 0, 0},
+        "LVL": {"lats letón", "", "Ls", Count{"", ""}, 0, 0},
+        "LVR": {"rublo letón", "", "", Count{"", ""}, 0, 0},
+        "LYD": {"dinar libio", "", "", Count{"", ""}, 0, 0},
+        "MAD": {"dírham marroquí", "", "", Count{"", ""}, 0, 0},
+        "MAF": {"franco marroquí", "", "", Count{"", ""}, 0, 0},
+        "MDL": {"leu moldavo", "", "", Count{"", ""}, 0, 0},
+        "MGA": {"ariari malgache", "", "Ar", Count{"", ""}, 0, 0},
+        "MGF": {"franco malgache", "", "", Count{"", ""}, 0, 0},
+        "MKD": {"dinar macedonio", "", "", Count{"", ""}, 0, 0},
+        "MLF": {"franco malí", "", "", Count{"", ""}, 0, 0},
+        "MMK": {"kiat de Myanmar", "", "K", Count{"", ""}, 0, 0},
+        "MNT": {"tugrik mongol", "", "₮", Count{"", ""}, 0, 0},
+        "MOP": {"pataca macaense", "", "", Count{"", ""}, 0, 0},
+        "MRO": {"uguiya (1973–2017)", "", "", Count{"", ""}, 0, 0},
+        "MRU": {"uguiya mauritano", "", "", Count{"", ""}, 0, 0},
+        "MTL": {"lira maltesa", "", "", Count{"", ""}, 0, 0},
+        "MTP": {"libra maltesa", "", "", Count{"", ""}, 0, 0},
+        "MUR": {"rupia mauriciana", "", "Rs", Count{"", ""}, 0, 0},
+        "MVR": {"rufiya maldiva", "", "", Count{"", ""}, 0, 0},
+        "MWK": {"kwacha malauí", "", "", Count{"", ""}, 0, 0},
+        "MXN": {"peso mexicano", "MXN", "$", Count{"peso mexicano", "pesos mexicanos"}, 0, 0},
+        "MXP": {"peso de plata mexicano (1861–1992)", "", "", Count{"", ""}, 0, 0},
+        "MXV": {"unidad de inversión (UDI) mexicana", "", "", Count{"", ""}, 0, 0},
+        "MYR": {"ringit malasio", "", "RM", Count{"", ""}, 0, 0},
+        "MZE": {"escudo mozambiqueño", "", "", Count{"", ""}, 0, 0},
+        "MZM": {"antiguo metical mozambiqueño", "", "", Count{"", ""}, 0, 0},
+        "MZN": {"metical mozambiqueño", "", "", Count{"", ""}, 0, 0},
+        "NAD": {"dólar namibio", "", "$", Count{"", ""}, 0, 0},
+        "NGN": {"naira nigeriano", "", "₦", Count{"", ""}, 0, 0},
+        "NIC": {"córdoba nicaragüense (1988–1991)", "", "", Count{"", ""}, 0, 0},
+        "NIO": {"córdoba nicaragüense", "", "C$", Count{"", ""}, 0, 0},
+        "NLG": {"florín neerlandés", "", "", Count{"", ""}, 0, 0},
+        "NOK": {"corona noruega", "", "kr", Count{"", ""}, 0, 0},
+        "NPR": {"rupia nepalí", "", "Rs", Count{"", ""}, 0, 0},
+        "NZD": {"dólar neozelandés", "NZD", "$", Count{"", ""}, 0, 0},
+        "OMR": {"rial omaní", "", "", Count{"", ""}, 0, 0},
+        "PAB": {"balboa panameño", "", "", Count{"", ""}, 0, 0},
+        "PEI": {"inti peruano", "", "", Count{"", ""}, 0, 0},
+        "PEN": {"sol peruano", "", "", Count{"", ""}, 0, 0},
+        "PES": {"sol peruano (1863–1965)", "", "", Count{"", ""}, 0, 0},
+        "PGK": {"kina papú", "", "", Count{"", ""}, 0, 0},
+        "PHP": {"peso filipino", "PHP", "₱", Count{"", ""}, 0, 0},
+        "PKR": {"rupia pakistaní", "", "Rs", Count{"", ""}, 0, 0},
+        "PLN": {"esloti polaco", "", "zł", Count{"", ""}, 0, 0},
+        "PLZ": {"zloty polaco (1950–1995)", "", "", Count{"", ""}, 0, 0},
+        "PTE": {"escudo portugués", "", "", Count{"escudo portugués", "escudos portugueses"}, 0, 0},
+        "PYG": {"guaraní paraguayo", "", "₲", Count{"", ""}, 0, 0},
+        "QAR": {"rial catarí", "", "", Count{"", ""}, 0, 0},
+        "RHD": {"dólar rodesiano", "", "", Count{"", ""}, 0, 0},
+        "ROL": {"antiguo leu rumano", "", "", Count{"", ""}, 0, 0},
+        "RON": {"leu rumano", "", "L", Count{"", ""}, 0, 0},
+        "RSD": {"dinar serbio", "", "", Count{"", ""}, 0, 0},
+        "RUB": {"rublo ruso", "", "₽", Count{"", ""}, 0, 0},
+        "RUR": {"rublo ruso (1991–1998)", "", "", Count{"", ""}, 0, 0},
+        "RWF": {"franco ruandés", "", "RF", Count{"", ""}, 0, 0},
+        "SAR": {"rial saudí", "⃁", "", Count{"", ""}, 0, 0},
+        "SBD": {"dólar salomonense", "", "$", Count{"", ""}, 0, 0},
+        "SCR": {"rupia seychellense", "", "", Count{"", ""}, 0, 0},
+        "SDD": {"dinar sudanés", "", "", Count{"", ""}, 0, 0},
+        "SDG": {"libra sudanesa", "", "", Count{"", ""}, 0, 0},
+        "SDP": {"libra sudanesa antigua", "", "", Count{"", ""}, 0, 0},
+        "SEK": {"corona sueca", "", "kr", Count{"", ""}, 0, 0},
+        "SGD": {"dólar singapurense", "", "$", Count{"", ""}, 0, 0},
+        "SHP": {"libra de Santa Elena", "", "£", Count{"", ""}, 0, 0},
+        "SIT": {"tólar esloveno", "", "", Count{"", ""}, 0, 0},
+        "SKK": {"corona eslovaca", "", "", Count{"", ""}, 0, 0},
+        "SLE": {"leone", "", "", Count{"", ""}, 0, 0},
+        "SLL": {"leones (1964—2022)", "", "", Count{"", ""}, 0, 0},
+        "SOS": {"chelín somalí", "", "", Count{"", ""}, 0, 0},
+        "SRD": {"dólar surinamés", "", "$", Count{"", ""}, 0, 0},
+        "SRG": {"florín surinamés", "", "", Count{"", ""}, 0, 0},
+        "SSP": {"libra sursudanesa", "", "SD£", Count{"", ""}, 0, 0},
+        "STD": {"dobra (1977–2017)", "", "", Count{"", ""}, 0, 0},
+        "STN": {"dobra santotomense", "", "Db", Count{"", ""}, 0, 0},
+        "SUR": {"rublo soviético", "", "", Count{"", ""}, 0, 0},
+        "SVC": {"colón salvadoreño", "", "", Count{"", ""}, 0, 0},
+        "SYP": {"libra siria", "", "S£", Count{"", ""}, 0, 0},
+        "SZL": {"lilangeni esuatiní", "", "", Count{"", ""}, 0, 0},
+        "THB": {"baht tailandes", "THB", "฿", Count{"", ""}, 0, 0},
+        "TJR": {"rublo tayiko", "", "", Count{"", ""}, 0, 0},
+        "TJS": {"somoni tayiko", "", "", Count{"", ""}, 0, 0},
+        "TMM": {"manat turcomano (1993–2009)", "", "", Count{"", ""}, 0, 0},
+        "TMT": {"manat turcomano", "", "", Count{"", ""}, 0, 0},
+        "TND": {"dinar tunecino", "", "", Count{"", ""}, 0, 0},
+        "TOP": {"paanga tongano", "", "T$", Count{"", ""}, 0, 0},
+        "TPE": {"escudo timorense", "", "", Count{"", ""}, 0, 0},
+        "TRL": {"lira turca (1922–2005)", "", "", Count{"", ""}, 0, 0},
+        "TRY": {"lira turca", "TL", "₺", Count{"", ""}, 0, 0},
+        "TTD": {"dólar de Trinidad y Tobago", "", "$", Count{"", ""}, 0, 0},
+        "TWD": {"nuevo dólar taiwanés", "TWD", "NT$", Count{"", ""}, 0, 0},
+        "TZS": {"chelín tanzano", "", "", Count{"", ""}, 0, 0},
+        "UAH": {"grivna ucraniana", "", "₴", Count{"", ""}, 0, 0},
+        "UAK": {"karbovanet ucraniano", "", "", Count{"", ""}, 0, 0},
+        "UGS": {"chelín ugandés (1966–1987)", "", "", Count{"", ""}, 0, 0},
+        "UGX": {"chelín ugandés", "", "", Count{"", ""}, 0, 0},
+        "USD": {"dólar estadounidense", "USD", "$", Count{"dólar estadounidense", "dólares estadounidenses"}, 0, 0},
+        "USN": {"dólar estadounidense (día siguiente)", "", "", Count{"", ""}, 0, 0},
+        "USS": {"dólar estadounidense (mismo día)", "", "", Count{"", ""}, 0, 0},
+        "UYI": {"peso uruguayo en unidades indexadas", "", "", Count{"", ""}, 0, 0},
+        "UYP": {"peso uruguayo (1975–1993)", "", "", Count{"", ""}, 0, 0},
+        "UYU": {"peso uruguayo", "", "$", Count{"", ""}, 0, 0},
+        "UYW": {"unidad previsional uruguayo", "", "", Count{"", ""}, 0, 0},
+        "UZS": {"som uzbeko", "", "", Count{"", ""}, 0, 0},
+        "VEB": {"bolívar venezolano (1871–2008)", "", "", Count{"", ""}, 0, 0},
+        "VEF": {"bolívar venezolano (2008–2018)", "", "BsF", Count{"", ""}, 0, 0},
+        "VES": {"bolívar venezolano", "", "", Count{"", ""}, 0, 0},
+        "VND": {"dong vietnamita", "VND", "₫", Count{"", ""}, 0, 0},
+        "VUV": {"vatu vanuatense", "", "", Count{"", ""}, 0, 0},
+        "WST": {"tala samoano", "", "", Count{"", ""}, 0, 0},
+        "XAF": {"franco CFA de África Central", "XAF", "", Count{"", ""}, 0, 0},
+        "XAG": {"plata", "", "", Count{"", ""}, 0, 0},
+        "XAU": {"oro", "", "", Count{"", ""}, 0, 0},
+        "XBA": {"unidad compuesta europea", "", "", Count{"", ""}, 0, 0},
+        "XBB": {"unidad monetaria europea", "", "", Count{"", ""}, 0, 0},
+        "XBC": {"unidad de cuenta europea (XBC)", "", "", Count{"", ""}, 0, 0},
+        "XBD": {"unidad de cuenta europea (XBD)", "", "", Count{"", ""}, 0, 0},
+        "XCD": {"dólar del Caribe Oriental", "XCD", "$", Count{"", ""}, 0, 0},
+        "XCG": {"florín caribeño", "Cg.", "", Count{"", ""}, 0, 0},
+        "XDR": {"derechos especiales de giro", "", "", Count{"", ""}, 0, 0},
+        "XEU": {"unidad de moneda europea", "", "", Count{"", ""}, 0, 0},
+        "XFO": {"franco oro francés", "", "", Count{"", ""}, 0, 0},
+        "XFU": {"franco UIC francés", "", "", Count{"", ""}, 0, 0},
+        "XOF": {"franco CFA de África Occidental", "XOF", "", Count{"", ""}, 0, 0},
+        "XPD": {"paladio", "", "", Count{"", ""}, 0, 0},
+        "XPF": {"franco CFP", "CFPF", "", Count{"", ""}, 0, 0},
+        "XPT": {"platino", "", "", Count{"", ""}, 0, 0},
+        "XRE": {"fondos RINET", "", "", Count{"", ""}, 0, 0},
+        "XTS": {"código reservado para pruebas", "", "", Count{"", ""}, 0, 0},
+        "XXX": {"moneda desconocida", "¤", "", Count{"", ""}, 0, 0},
+        "YDD": {"dinar yemení", "", "", Count{"", ""}, 0, 0},
+        "YER": {"rial yemení", "", "", Count{"", ""}, 0, 0},
+        "YUD": {"dinar fuerte yugoslavo", "", "", Count{"", ""}, 0, 0},
+        "YUM": {"super dinar yugoslavo", "", "", Count{"", ""}, 0, 0},
+        "YUN": {"dinar convertible yugoslavo", "", "", Count{"", ""}, 0, 0},
+        "ZAL": {"rand sudafricano (financiero)", "", "", Count{"", ""}, 0, 0},
+        "ZAR": {"rand sudafricano", "", "R", Count{"", ""}, 0, 0},
+        "ZMK": {"kwacha zambiano (1968–2012)", "", "", Count{"", ""}, 0, 0},
+        "ZMW": {"kuacha zambiano", "", "ZK", Count{"", ""}, 0, 0},
+        "ZRN": {"nuevo zaire zaireño", "", "", Count{"", ""}, 0, 0},
+        "ZRZ": {"zaire zaireño", "", "", Count{"", ""}, 0, 0},
+        "ZWD": {"dólar de Zimbabue", "", "", Count{"", ""}, 0, 0},
+        "ZWG": {"oro zimbabuense", "", "", Count{"", ""}, 0, 0},
+        "ZWL": {"dólar zimbabuense", "", "", Count{"", ""}, 0, 0},
     }, map[string]Unit{
         "duration-century": {Count{"{0} siglo", "{0} siglos"}, Count{"", "{0} s."}, Count{"{0}s", "{0}s"}},
         "duration-day": {Count{"{0} día", "{0} días"}, Count{"{0} d.", "{0} dd."}, Count{"{0}d.", "{0}dd."}},
@@ -3471,8 +4068,274 @@ var locales = map[string]Locale{
         "ZM": "Zambia",
         "ZW": "Zimbabue",
         "ZZ": "Región desconocida",
-    }},
-    "es_CL": {"#,##0.###", CurrencyFormat{"¤#,##0.00;¤-#,##0.00", "#,##0.00", "¤ #,##0.00;¤-#,##0.00"}, CalendarFormat{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "dd-MM-y", "dd-MM-yy"}, CalendarFormat{"H:mm:ss zzzz", "H:mm:ss z", "H:mm:ss", "H:mm"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
+    }, ListPattern{"{0}, {1}", "{0}, {1}", "{0} y {1}", "{0} y {1}"}, CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}}, "#,##0 %", 37, map[string]RelativeTime{
+        "day": {map[string]string{
+            "-1": "ayer",
+            "-2": "anteayer",
+            "0": "hoy",
+            "1": "mañana",
+            "2": "pasado mañana",
+        }, Count{"dentro de {0} día", "dentro de {0} días"}, Count{"hace {0} día", "hace {0} días"}},
+        "day-narrow": {map[string]string{
+            "-1": "ayer",
+            "-2": "anteayer",
+            "0": "hoy",
+            "1": "mañana",
+            "2": "pasado mañana",
+        }, Count{"dentro de {0} d", "dentro de {0} d"}, Count{"hace {0} d", "hace {0} d"}},
+        "day-short": {map[string]string{
+            "-1": "ayer",
+            "-2": "anteayer",
+            "0": "hoy",
+            "1": "mañana",
+            "2": "pasado mañana",
+        }, Count{"dentro de {0} d", "dentro de {0} d"}, Count{"hace {0} d", "hace {0} d"}},
+        "hour": {map[string]string{
+            "0": "esta hora",
+        }, Count{"dentro de {0} hora", "dentro de {0} horas"}, Count{"hace {0} hora", "hace {0} horas"}},
+        "hour-narrow": {map[string]string{
+            "0": "esta hora",
+        }, Count{"dentro de {0} h", "dentro de {0} h"}, Count{"hace {0} h", "hace {0} h"}},
+        "hour-short": {map[string]string{
+            "0": "esta hora",
+        }, Count{"dentro de {0} h", "dentro de {0} h"}, Count{"hace {0} h", "hace {0} h"}},
+        "minute": {map[string]string{
+            "0": "este minuto",
+        }, Count{"dentro de {0} minuto", "dentro de {0} minutos"}, Count{"hace {0} minuto", "hace {0} minutos"}},
+        "minute-narrow": {map[string]string{
+            "0": "este minuto",
+        }, Count{"dentro de {0} min", "dentro de {0} min"}, Count{"hace {0} min", "hace {0} min"}},
+        "minute-short": {map[string]string{
+            "0": "este minuto",
+        }, Count{"dentro de {0} min", "dentro de {0} min"}, Count{"hace {0} min", "hace {0} min"}},
+        "month": {map[string]string{
+            "-1": "el mes pasado",
+            "0": "este mes",
+            "1": "el próximo mes",
+        }, Count{"dentro de {0} mes", "dentro de {0} meses"}, Count{"hace {0} mes", "hace {0} meses"}},
+        "month-narrow": {map[string]string{
+            "-1": "el mes pasado",
+            "0": "este mes",
+            "1": "el próximo mes",
+        }, Count{"dentro de {0} m", "dentro de {0} m"}, Count{"hace {0} m", "hace {0} m"}},
+        "month-short": {map[string]string{
+            "-1": "el mes pasado",
+            "0": "este mes",
+            "1": "el próximo mes",
+        }, Count{"dentro de {0} m", "dentro de {0} m"}, Count{"hace {0} m", "hace {0} m"}},
+        "quarter": {map[string]string{
+            "-1": "el trimestre pasado",
+            "0": "este trimestre",
+            "1": "el próximo trimestre",
+        }, Count{"dentro de {0} trimestre", "dentro de {0} trimestres"}, Count{"hace {0} trimestre", "hace {0} trimestres"}},
+        "quarter-narrow": {map[string]string{
+            "-1": "el trim. pasado",
+            "0": "este trim.",
+            "1": "el próximo trim.",
+        }, Count{"dentro de {0} trim.", "dentro de {0} trim."}, Count{"hace {0} trim.", "hace {0} trim."}},
+        "quarter-short": {map[string]string{
+            "-1": "el trim. pasado",
+            "0": "este trim.",
+            "1": "el próximo trim.",
+        }, Count{"dentro de {0} trim.", "dentro de {0} trim."}, Count{"hace {0} trim.", "hace {0} trim."}},
+        "second": {map[string]string{
+            "0": "ahora",
+        }, Count{"dentro de {0} segundo", "dentro de {0} segundos"}, Count{"hace {0} segundo", "hace {0} segundos"}},
+        "second-narrow": {map[string]string{
+            "0": "ahora",
+        }, Count{"dentro de {0} s", "dentro de {0} s"}, Count{"hace {0} s", "hace {0} s"}},
+        "second-short": {map[string]string{
+            "0": "ahora",
+        }, Count{"dentro de {0} s", "dentro de {0} s"}, Count{"hace {0} s", "hace {0} s"}},
+        "week": {map[string]string{
+            "-1": "la semana pasada",
+            "0": "esta semana",
+            "1": "la próxima semana",
+        }, Count{"dentro de {0} semana", "dentro de {0} semanas"}, Count{"hace {0} semana", "hace {0} semanas"}},
+        "week-narrow": {map[string]string{
+            "-1": "sem. ant.",
+            "0": "esta sem.",
+            "1": "próx. sem.",
+        }, Count{"dentro de {0} sem.", "dentro de {0} sem."}, Count{"hace {0} sem.", "hace {0} sem."}},
+        "week-short": {map[string]string{
+            "-1": "sem. ant.",
+            "0": "esta sem.",
+            "1": "próx. sem.",
+        }, Count{"dentro de {0} sem.", "dentro de {0} sem."}, Count{"hace {0} sem.", "hace {0} sem."}},
+        "year": {map[string]string{
+            "-1": "el año pasado",
+            "0": "este año",
+            "1": "el próximo año",
+        }, Count{"dentro de {0} año", "dentro de {0} años"}, Count{"hace {0} año", "hace {0} años"}},
+        "year-narrow": {map[string]string{
+            "-1": "el año pasado",
+            "0": "este año",
+            "1": "el próximo año",
+        }, Count{"dentro de {0} a", "dentro de {0} a"}, Count{"hace {0} a", "hace {0} a"}},
+        "year-short": {map[string]string{
+            "-1": "el año pasado",
+            "0": "este año",
+            "1": "el próximo año",
+        }, Count{"dentro de {0} a", "dentro de {0} a"}, Count{"hace {0} a", "hace {0} a"}},
+    }, map[string]string{
+        "Bh": "h B",
+        "Bhm": "h:mm B",
+        "Bhms": "h:mm:ss B",
+        "E": "ccc",
+        "EBhm": "E h:mm B",
+        "EBhms": "E h:mm:ss B",
+        "EHm": "E, H:mm",
+        "EHms": "E, H:mm:ss",
+        "Ed": "E d",
+        "Ehm": "E, h:mm a",
+        "Ehms": "E, h:mm:ss a",
+        "Gy": "y G",
+        "GyMMM": "MMM y G",
+        "GyMMMEd": "E, d MMM y G",
+        "GyMMMM": "MMMM 'de' y G",
+        "GyMMMMEd": "E, d 'de' MMMM 'de' y G",
+        "GyMMMMd": "d 'de' MMMM 'de' y G",
+        "GyMMMd": "d MMM y G",
+        "GyMd": "d/M/y G",
+        "H": "H",
+        "Hm": "H:mm",
+        "Hms": "H:mm:ss",
+        "Hmsv": "H:mm:ss v",
+        "Hmsvvvv": "H:mm:ss (vvvv)",
+        "Hmv": "H:mm v",
+        "M": "L",
+        "MEd": "E, d/M",
+        "MMM": "LLL",
+        "MMMEd": "E, d MMM",
+        "MMMMEd": "E, d 'de' MMMM",
+        "MMMMd": "d 'de' MMMM",
+        "MMMd": "d MMM",
+        "MMd": "d/M",
+        "MMdd": "d/M",
+        "Md": "d/M",
+        "d": "d",
+        "h": "h a",
+        "hm": "h:mm a",
+        "hms": "h:mm:ss a",
+        "hmsv": "h:mm:ss a v",
+        "hmsvvvv": "h:mm:ss a (vvvv)",
+        "hmv": "h:mm a v",
+        "ms": "mm:ss",
+        "y": "y",
+        "yM": "M/y",
+        "yMEd": "EEE, d/M/y",
+        "yMM": "M/y",
+        "yMMM": "MMM y",
+        "yMMMEd": "EEE, d MMM y",
+        "yMMMM": "MMMM 'de' y",
+        "yMMMMEd": "EEE, d 'de' MMMM 'de' y",
+        "yMMMMd": "d 'de' MMMM 'de' y",
+        "yMMMd": "d MMM y",
+        "yMd": "d/M/y",
+        "yQQQ": "QQQ y",
+        "yQQQQ": "QQQQ 'de' y",
+    }, [2]CalendarSymbol{
+        {"antes de Cristo", "a. C.", "a. C.", ""},
+        {"después de Cristo", "d. C.", "d. C.", ""},
+    }, [4]CalendarSymbol{
+        {"1.er trimestre", "T1", "1", ""},
+        {"2.º trimestre", "T2", "2", ""},
+        {"3.er trimestre", "T3", "3", ""},
+        {"4.º trimestre", "T4", "4", ""},
+    }, [12]CalendarSymbol{
+        {"enero", "ene", "E", ""},
+        {"febrero", "feb", "F", ""},
+        {"marzo", "mar", "M", ""},
+        {"abril", "abr", "A", ""},
+        {"mayo", "may", "M", ""},
+        {"junio", "jun", "J", ""},
+        {"julio", "jul", "J", ""},
+        {"agosto", "ago", "A", ""},
+        {"septiembre", "sept", "S", ""},
+        {"octubre", "oct", "O", ""},
+        {"noviembre", "nov", "N", ""},
+        {"diciembre", "dic", "D", ""},
+    }, [7]CalendarSymbol{
+        {"domingo", "dom", "D", "DO"},
+        {"lunes", "lun", "L", "LU"},
+        {"martes", "mar", "M", "MA"},
+        {"miércoles", "mié", "M", "MI"},
+        {"jueves", "jue", "J", "JU"},
+        {"viernes", "vie", "V", "VI"},
+        {"sábado", "sáb", "S", "SA"},
+    }, [4]CalendarSymbol{
+        {"1.er trimestre", "T1", "1", ""},
+        {"2.º trimestre", "T2", "2", ""},
+        {"3.er trimestre", "T3", "3", ""},
+        {"4.º trimestre", "T4", "4", ""},
+    }, "gregorian", map[string]Calendar{
+        "buddhist": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"EB", "EB", "EB", ""},
+        }},
+        "hebrew": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{
+            {"tishri", "tishri", "1", ""},
+            {"heshvan", "heshvan", "2", ""},
+            {"kislev", "kislev", "3", ""},
+            {"tevet", "tevet", "4", ""},
+            {"shevat", "shevat", "5", ""},
+            {"adar I", "adar I", "6", ""},
+            {"adar", "adar", "7", ""},
+            {"nisán", "nisán", "8", ""},
+            {"iyar", "iyar", "9", ""},
+            {"siván", "siván", "10", ""},
+            {"tamuz", "tamuz", "11", ""},
+            {"av", "av", "12", ""},
+            {"elul", "elul", "13", ""},
+            {"adar II", "adar II", "7", ""},
+        }, []CalendarSymbol{
+            {"AM", "AM", "AM", ""},
+        }},
+        "islamic": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{
+            {"muharram", "muh.", "1", ""},
+            {"safar", "saf.", "2", ""},
+            {"rabía I", "rab. I", "3", ""},
+            {"rabía II", "rab. II", "4", ""},
+            {"yumada I", "yum. I", "5", ""},
+            {"yumada II", "yum. II", "6", ""},
+            {"rayab", "ray.", "7", ""},
+            {"shabán", "sha.", "8", ""},
+            {"ramadán", "ram.", "9", ""},
+            {"shawal", "shaw.", "10", ""},
+            {"dhu l-qa'da", "dhu l-q.", "11", ""},
+            {"dhu l-hiyya", "dhu l-h.", "12", ""},
+        }, []CalendarSymbol{
+            {"a. H.", "a. H.", "a. H.", ""},
+        }},
+        "japanese": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Meiji", "Meiji", "M", ""},
+            {"Taishō", "Taishō", "T", ""},
+            {"Shōwa", "Shōwa", "S", ""},
+            {"Heisei", "Heisei", "H", ""},
+            {"Reiwa", "Reiwa", "R", ""},
+        }},
+        "persian": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{
+            {"farvardin", "farvardin", "1", ""},
+            {"ordibehesht", "ordibehesht", "2", ""},
+            {"jordad", "jordad", "3", ""},
+            {"tir", "tir", "4", ""},
+            {"mordad", "mordad", "5", ""},
+            {"shahrivar", "shahrivar", "6", ""},
+            {"mehr", "mehr", "7", ""},
+            {"aban", "aban", "8", ""},
+            {"azar", "azar", "9", ""},
+            {"dey", "dey", "10", ""},
+            {"bahman", "bahman", "11", ""},
+            {"esfand", "esfand", "12", ""},
+        }, []CalendarSymbol{
+            {"AP", "AP", "AP", ""},
+        }},
+    }, 1, 1, "h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }, "latn", "", "metric", "", "GMT{0}", "GMT", "+HH:mm;-HH:mm", "hora de {0}", "horario de verano de {0}", "horario estándar de {0}", "{1} ({0})"},
+    "es_CL": {"#,##0.###", CurrencyFormat{"¤#,##0.00;¤-#,##0.00", "#,##0.00", "¤ #,##0.00;¤-#,##0.00", Count{"{0} {1}", "{0} {1}"}}, CalendarFormat{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "dd-MM-y", "dd-MM-yy"}, CalendarFormat{"H:mm:ss zzzz", "H:mm:ss z", "H:mm:ss", "H:mm"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
         "": {
             "": "{0} a el {1}",
         },
@@ -3634,39 +4497,39 @@ var locales = map[string]Locale{
             "y": "dd-MM-y – dd-MM-y",
         },
     }, "{0} {1}", 44, 46, 44, 46, 43, 45, 58, [12]CalendarSymbol{
-        {"enero", "ene", "E"},
-        {"febrero", "feb", "F"},
-        {"marzo", "mar", "M"},
-        {"abril", "abr", "A"},
-        {"mayo", "may", "M"},
-        {"junio", "jun", "J"},
-        {"julio", "jul", "J"},
-        {"agosto", "ago", "A"},
-        {"septiembre", "sept", "S"},
-        {"octubre", "oct", "O"},
-        {"noviembre", "nov", "N"},
-        {"diciembre", "dic", "D"},
+        {"enero", "ene", "E", ""},
+        {"febrero", "feb", "F", ""},
+        {"marzo", "mar", "M", ""},
+        {"abril", "abr", "A", ""},
+        {"mayo", "may", "M", ""},
+        {"junio", "jun", "J", ""},
+        {"julio", "jul", "J", ""},
+        {"agosto", "ago", "A", ""},
+        {"septiembre", "sept", "S", ""},
+        {"octubre", "oct", "O", ""},
+        {"noviembre", "nov", "N", ""},
+        {"diciembre", "dic", "D", ""},
     }, [7]CalendarSymbol{
-        {"domingo", "dom", "D"},
-        {"lunes", "lun", "L"},
-        {"martes", "mar", "M"},
-        {"miércoles", "mié", "M"},
-        {"jueves", "jue", "J"},
-        {"viernes", "vie", "V"},
-        {"sábado", "sáb", "S"},
+        {"domingo", "dom", "D", "do"},
+        {"lunes", "lun", "L", "lu"},
+        {"martes", "mar", "M", "ma"},
+        {"miércoles", "mié", "M", "mi"},
+        {"jueves", "jue", "J", "ju"},
+        {"viernes", "vie", "V", "vi"},
+        {"sábado", "sáb", "S", "sá"},
     }, map[string]DayPeriodRule{
         "evening1": {720, 1200},
         "morning1": {0, 360},
         "morning2": {360, 720},
         "night1": {1200, 1440},
     }, map[string]CalendarSymbol{
-        "am": {"a. m.", "a. m.", "a. m."},
-        "evening1": {"", "de la tarde", ""},
-        "morning1": {"", "de la madrugada", ""},
-        "morning2": {"", "de la mañana", ""},
-        "night1": {"", "de la noche", ""},
-        "noon": {"", "del mediodía", ""},
-        "pm": {"p. m.", "p. m.", "p. m."},
+        "am": {"a. m.", "a. m.", "a. m.", ""},
+        "evening1": {"", "de la tarde", "", ""},
+        "morning1": {"", "de la madrugada", "", ""},
+        "morning2": {"", "de la mañana", "", ""},
+        "night1": {"", "de la noche", "", ""},
+        "noon": {"", "del mediodía", "", ""},
+        "pm": {"p. m.", "p. m.", "p. m.", ""},
     }, map[string]string{
         "Africa/Abidjan": "Abiyán",
         "Africa/Accra": "Acra",
@@ -4035,289 +4898,289 @@ var locales = map[string]Locale{
         "Yekaterinburg": {MetazoneSymbol{"hora de Ekaterimburgo", ""}, MetazoneSymbol{"hora estándar de Ekaterimburgo", ""}, MetazoneSymbol{"hora de verano de Ekaterimburgo", ""}},
         "Yukon": {MetazoneSymbol{"", ""}, MetazoneSymbol{"hora de Yukón", ""}, MetazoneSymbol{"", ""}},
     }, map[string]Currency{
-        "ADP": {"peseta andorrana", "", ""},
-        "AED": {"dírham de los Emiratos Árabes Unidos", "", ""},
-        "AFA": {"afgani (1927–2002)", "", ""},
-        "AFN": {"afgani afgano", "", "؋"},
-        "ALL": {"lek albanés", "", ""},
-        "AMD": {"dram armenio", "", "֏"},
-        "ANG": {"florín de las Antillas Neerlandesas", "", ""},
-        "AOA": {"kuanza angoleño", "", "Kz"},
-        "AOK": {"kwanza angoleño (1977–1990)", "", ""},
-        "AON": {"nuevo kwanza angoleño (1990–2000)", "", ""},
-        "AOR": {"kwanza reajustado angoleño (1995–1999)", "", ""},
-        "ARA": {"austral argentino", "", ""},
-        "ARP": {"peso argentino (1983–1985)", "", ""},
-        "ARS": {"peso argentino", "", "$"},
-        "ATS": {"chelín austriaco", "", ""},
-        "AUD": {"dólar australiano", "AUD", "$"},
-        "AWG": {"florín arubeño", "", ""},
-        "AZM": {"manat azerí (1993–2006)", "", ""},
-        "AZN": {"manat azerbaiyano", "", "₼"},
-        "BAD": {"dinar bosnio", "", ""},
-        "BAM": {"marco convertible de Bosnia y Herzegovina", "", "KM"},
-        "BBD": {"dólar barbadense", "", "$"},
-        "BDT": {"taka bangladesí", "", "৳"},
-        "BEC": {"franco belga (convertible)", "", ""},
-        "BEF": {"franco belga", "", ""},
-        "BEL": {"franco belga (financiero)", "", ""},
-        "BGL": {"lev fuerte búlgaro", "", ""},
-        "BGN": {"leva búlgara", "", ""},
-        "BHD": {"dinar bareiní", "", ""},
-        "BIF": {"franco burundés", "", ""},
-        "BMD": {"dólar de Bermudas", "", "$"},
-        "BND": {"dólar bruneano", "", "$"},
-        "BOB": {"boliviano", "", "Bs"},
-        "BOP": {"peso boliviano", "", ""},
-        "BOV": {"MVDOL boliviano", "", ""},
-        "BRB": {"nuevo cruceiro brasileño (1967–1986)", "", ""},
-        "BRC": {"cruzado brasileño", "", ""},
-        "BRE": {"cruceiro brasileño (1990–1993)", "", ""},
-        "BRL": {"real brasileño", "BRL", "R$"},
-        "BRN": {"nuevo cruzado brasileño", "", ""},
-        "BRR": {"cruceiro brasileño", "", ""},
-        "BSD": {"dólar bahameño", "", "$"},
-        "BTN": {"gultrum butanés", "", ""},
-        "BUK": {"kyat birmano", "", ""},
-        "BWP": {"pula botsuano", "", "P"},
-        "BYB": {"nuevo rublo bielorruso (1994–1999)", "", ""},
-        "BYN": {"rublo bielorruso", "", "р."},
-        "BYR": {"rublo bielorruso (2000–2016)", "", ""},
-        "BZD": {"dólar beliceño", "", "$"},
-        "CAD": {"dólar canadiense", "CAD", "$"},
-        "CDF": {"franco congoleño", "", ""},
-        "CHE": {"euro WIR", "", ""},
-        "CHF": {"franco suizo", "", ""},
-        "CHW": {"franco WIR", "", ""},
-        "CLF": {"unidad de fomento chilena", "", ""},
-        "CLP": {"Peso chileno", "$", "$"},
-        "CNH": {"yuan chino (extracontinental)", "", ""},
-        "CNY": {"yuan renminbi", "CNY", "¥"},
-        "COP": {"peso colombiano", "", "$"},
-        "COU": {"unidad de valor real colombiana", "", ""},
-        "CRC": {"colón costarricense", "", "₡"},
-        "CSD": {"antiguo dinar serbio", "", ""},
-        "CSK": {"corona fuerte checoslovaca", "", ""},
-        "CUC": {"peso cubano convertible", "", "$"},
-        "CUP": {"peso cubano", "", "$"},
-        "CVE": {"escudo de Cabo Verde", "", ""},
-        "CYP": {"libra chipriota", "", ""},
-        "CZK": {"corona checa", "", "Kč"},
-        "DDM": {"ostmark de Alemania del Este", "", ""},
-        "DEM": {"marco alemán", "", ""},
-        "DJF": {"franco yibutiano", "", ""},
-        "DKK": {"corona danesa", "", "kr"},
-        "DOP": {"peso dominicano", "", "$"},
-        "DZD": {"dinar argelino", "", ""},
-        "ECS": {"sucre ecuatoriano", "", ""},
-        "ECV": {"unidad de valor constante (UVC) ecuatoriana", "", ""},
-        "EEK": {"corona estonia", "", ""},
-        "EGP": {"libra egipcia", "", "E£"},
-        "ERN": {"nakfa eritreo", "", ""},
-        "ESA": {"peseta española (cuenta A)", "", ""},
-        "ESB": {"peseta española (cuenta convertible)", "", ""},
-        "ESP": {"peseta española", "₧", "₧"},
-        "ETB": {"bir etíope", "", ""},
-        "EUR": {"euro", "EUR", "€"},
-        "FIM": {"marco finlandés", "", ""},
-        "FJD": {"dólar fiyiano", "", "$"},
-        "FKP": {"libra malvinense", "", "FK£"},
-        "FRF": {"franco francés", "", ""},
-        "GBP": {"libra esterlina", "GBP", "£"},
-        "GEK": {"kupon larit georgiano", "", ""},
-        "GEL": {"lari georgiano", "", "₾"},
-        "GHC": {"cedi ghanés (1979–2007)", "", ""},
-        "GHS": {"cedi ghanés", "", "GH₵"},
-        "GIP": {"libra gibraltareña", "", "£"},
-        "GMD": {"dalasi gambiano", "", ""},
-        "GNF": {"franco guineano", "", "FG"},
-        "GNS": {"syli guineano", "", ""},
-        "GQE": {"ekuele de Guinea Ecuatorial", "", ""},
-        "GRD": {"dracma griego", "", ""},
-        "GTQ": {"quetzal guatemalteco", "", "Q"},
-        "GWE": {"escudo de Guinea Portuguesa", "", ""},
-        "GWP": {"peso de Guinea-Bissáu", "", ""},
-        "GYD": {"dólar guyanés", "", "$"},
-        "HKD": {"dólar hongkonés", "HKD", "$"},
-        "HNL": {"lempira hondureño", "", "L"},
-        "HRD": {"dinar croata", "", ""},
-        "HRK": {"kuna croata", "", "kn"},
-        "HTG": {"gourde haitiano", "", ""},
-        "HUF": {"forinto húngaro", "", "Ft"},
-        "IDR": {"rupia indonesia", "", "Rp"},
-        "IEP": {"libra irlandesa", "", ""},
-        "ILP": {"libra israelí", "", ""},
-        "ILS": {"nuevo séquel israelí", "ILS", "₪"},
-        "INR": {"rupia india", "INR", "₹"},
-        "IQD": {"dinar iraquí", "", ""},
-        "IRR": {"rial iraní", "", ""},
-        "ISK": {"corona islandesa", "", "kr"},
-        "ITL": {"lira italiana", "", ""},
-        "JMD": {"dólar jamaicano", "", "$"},
-        "JOD": {"dinar jordano", "", ""},
-        "JPY": {"yen japonés", "JPY", "¥"},
-        "KES": {"chelín keniano", "", ""},
-        "KGS": {"som kirguís", "", "⃀"},
-        "KHR": {"riel camboyano", "", "៛"},
-        "KMF": {"franco comorense", "", "CF"},
-        "KPW": {"won norcoreano", "", "₩"},
-        "KRW": {"won surcoreano", "KRW", "₩"},
-        "KWD": {"dinar kuwaití", "", ""},
-        "KYD": {"dólar de las Islas Caimán", "", "$"},
-        "KZT": {"tenge kazajo", "", "₸"},
-        "LAK": {"kip laosiano", "", "₭"},
-        "LBP": {"libra libanesa", "", "L£"},
-        "LKR": {"rupia esrilanquesa", "", "Rs"},
-        "LRD": {"dólar liberiano", "", "$"},
-        "LSL": {"loti lesotense", "", ""},
-        "LTL": {"litas lituano", "", "Lt"},
-        "LTT": {"talonas lituano", "", ""},
-        "LUC": {"franco convertible luxemburgués", "", ""},
-        "LUF": {"franco luxemburgués", "", ""},
-        "LUL": {"franco financiero luxemburgués", "", ""},
-        "LVL": {"lats letón", "", "Ls"},
-        "LVR": {"rublo letón", "", ""},
-        "LYD": {"dinar libio", "", ""},
-        "MAD": {"dírham marroquí", "", ""},
-        "MAF": {"franco marroquí", "", ""},
-        "MDL": {"leu moldavo", "", ""},
-        "MGA": {"ariari malgache", "", "Ar"},
-        "MGF": {"franco malgache", "", ""},
-        "MKD": {"dinar macedonio", "", ""},
-        "MLF": {"franco malí", "", ""},
-        "MMK": {"kiat de Myanmar", "", "K"},
-        "MNT": {"tugrik mongol", "", "₮"},
-        "MOP": {"pataca macaense", "", ""},
-        "MRO": {"uguiya (1973–2017)", "", ""},
-        "MRU": {"uguiya mauritano", "", ""},
-        "MTL": {"lira maltesa", "", ""},
-        "MTP": {"libra maltesa", "", ""},
-        "MUR": {"rupia mauriciana", "", "Rs"},
-        "MVR": {"rufiya maldiva", "", ""},
-        "MWK": {"kwacha malauí", "", ""},
-        "MXN": {"peso mexicano", "MXN", "$"},
-        "MXP": {"peso de plata mexicano (1861–1992)", "", ""},
-        "MXV": {"unidad de inversión (UDI) mexicana", "", ""},
-        "MYR": {"ringit malasio", "", "RM"},
-        "MZE": {"escudo mozambiqueño", "", ""},
-        "MZM": {"antiguo metical mozambiqueño", "", ""},
-        "MZN": {"metical mozambiqueño", "", ""},
-        "NAD": {"dólar namibio", "", "$"},
-        "NGN": {"naira nigeriano", "", "₦"},
-        "NIC": {"córdoba nicaragüense (1988–1991)", "", ""},
-        "NIO": {"córdoba nicaragüense", "", "C$"},
-        "NLG": {"florín neerlandés", "", ""},
-        "NOK": {"corona noruega", "", "kr"},
-        "NPR": {"rupia nepalí", "", "Rs"},
-        "NZD": {"dólar neozelandés", "NZD", "$"},
-        "OMR": {"rial omaní", "", ""},
-        "PAB": {"balboa panameño", "", ""},
-        "PEI": {"inti peruano", "", ""},
-        "PEN": {"sol peruano", "", ""},
-        "PES": {"sol peruano (1863–1965)", "", ""},
-        "PGK": {"kina papú", "", ""},
-        "PHP": {"peso filipino", "PHP", "₱"},
-        "PKR": {"rupia pakistaní", "", "Rs"},
-        "PLN": {"esloti polaco", "", "zł"},
-        "PLZ": {"zloty polaco (1950–1995)", "", ""},
-        "PTE": {"escudo portugués", "", ""},
-        "PYG": {"guaraní paraguayo", "", "₲"},
-        "QAR": {"rial catarí", "", ""},
-        "RHD": {"dólar rodesiano", "", ""},
-        "ROL": {"antiguo leu rumano", "", ""},
-        "RON": {"leu rumano", "", "L"},
-        "RSD": {"dinar serbio", "", ""},
-        "RUB": {"rublo ruso", "", "₽"},
-        "RUR": {"rublo ruso (1991–1998)", "", ""},
-        "RWF": {"franco ruandés", "", "RF"},
-        "SAR": {"rial saudí", "⃁", ""},
-        "SBD": {"dólar salomonense", "", "$"},
-        "SCR": {"rupia seychellense", "", ""},
-        "SDD": {"dinar sudanés", "", ""},
-        "SDG": {"libra sudanesa", "", ""},
-        "SDP": {"libra sudanesa antigua", "", ""},
-        "SEK": {"corona sueca", "", "kr"},
-        "SGD": {"dólar singapurense", "", "$"},
-        "SHP": {"libra de Santa Elena", "", "£"},
-        "SIT": {"tólar esloveno", "", ""},
-        "SKK": {"corona eslovaca", "", ""},
-        "SLE": {"leone", "", ""},
-        "SLL": {"leones (1964—2022)", "", ""},
-        "SOS": {"chelín somalí", "", ""},
-        "SRD": {"dólar surinamés", "", "$"},
-        "SRG": {"florín surinamés", "", ""},
-        "SSP": {"libra sursudanesa", "", "SD£"},
-        "STD": {"dobra (1977–2017)", "", ""},
-        "STN": {"dobra santotomense", "", "Db"},
-        "SUR": {"rublo soviético", "", ""},
-        "SVC": {"colón salvadoreño", "", ""},
-        "SYP": {"libra siria", "", "S£"},
-        "SZL": {"lilangeni esuatiní", "", ""},
-        "THB": {"baht tailandes", "THB", "฿"},
-        "TJR": {"rublo tayiko", "", ""},
-        "TJS": {"somoni tayiko", "", ""},
-        "TMM": {"manat turcomano (1993–2009)", "", ""},
-        "TMT": {"manat turcomano", "", ""},
-        "TND": {"dinar tunecino", "", ""},
-        "TOP": {"paanga tongano", "", "T$"},
-        "TPE": {"escudo timorense", "", ""},
-        "TRL": {"lira turca (1922–2005)", "", ""},
-        "TRY": {"lira turca", "TL", "₺"},
-        "TTD": {"dólar de Trinidad y Tobago", "", "$"},
-        "TWD": {"nuevo dólar taiwanés", "TWD", "NT$"},
-        "TZS": {"chelín tanzano", "", ""},
-        "UAH": {"grivna ucraniana", "", "₴"},
-        "UAK": {"karbovanet ucraniano", "", ""},
-        "UGS": {"chelín ugandés (1966–1987)", "", ""},
-        "UGX": {"chelín ugandés", "", ""},
-        "USD": {"dólar estadounidense", "US$", "$"},
-        "USN": {"dólar estadounidense (día siguiente)", "", ""},
-        "USS": {"dólar estadounidense (mismo día)", "", ""},
-        "UYI": {"peso uruguayo en unidades indexadas", "", ""},
-        "UYP": {"peso uruguayo (1975–1993)", "", ""},
-        "UYU": {"peso uruguayo", "", "$"},
-        "UYW": {"unidad previsional uruguayo", "", ""},
-        "UZS": {"som uzbeko", "", ""},
-        "VEB": {"bolívar venezolano (1871–2008)", "", ""},
-        "VEF": {"bolívar venezolano (2008–2018)", "", "BsF"},
-        "VES": {"bolívar venezolano", "", ""},
-        "VND": {"dong vietnamita", "VND", "₫"},
-        "VUV": {"vatu vanuatense", "", ""},
-        "WST": {"tala samoano", "", ""},
-        "XAF": {"franco CFA de África Central", "XAF", ""},
-        "XAG": {"plata", "", ""},
-        "XAU": {"oro", "", ""},
-        "XBA": {"unidad compuesta europea", "", ""},
-        "XBB": {"unidad monetaria europea", "", ""},
-        "XBC": {"unidad de cuenta europea (XBC)", "", ""},
-        "XBD": {"unidad de cuenta europea (XBD)", "", ""},
-        "XCD": {"dólar del Caribe Oriental", "XCD", "$"},
-        "XCG": {"florín caribeño", "Cg.", ""},
-        "XDR": {"derechos especiales de giro", "", ""},
-        "XEU": {"unidad de moneda europea", "", ""},
-        "XFO": {"franco oro francés", "", ""},
-        "XFU": {"franco UIC francés", "", ""},
-        "XOF": {"franco CFA de África Occidental", "XOF", ""},
-        "XPD": {"paladio", "", ""},
-        "XPF": {"franco CFP", "CFPF", ""},
-        "XPT": {"platino", "", ""},
-        "XRE": {"fondos RINET", "", ""},
-        "XTS": {"código reservado para pruebas", "", ""},
-        "XXX": {"moneda desconocida", "¤", ""},
-        "YDD": {"dinar yemení", "", ""},
-        "YER": {"rial yemení", "", ""},
-        "YUD": {"dinar fuerte yugoslavo", "", ""},
-        "YUM": {"super dinar yugoslavo", "", ""},
-        "YUN": {"dinar convertible yugoslavo", "", ""},
-        "ZAL": {"rand sudafricano (financiero)", "", ""},
-        "ZAR": {"rand sudafricano", "", "R"},
-        "ZMK": {"kwacha zambiano (1968–2012)", "", ""},
-        "ZMW": {"kuacha zambiano", "", "ZK"},
-        "ZRN": {"nuevo zaire zaireño", "", ""},
-        "ZRZ": {"zaire zaireño", "", ""},
-        "ZWD": {"dólar de Zimbabue", "", ""},
-        "ZWG": {"oro zimbabuense", "", ""},
-        "ZWL": {"dólar zimbabuense", "", ""},
+        "ADP": {"peseta andorrana", "", "", Count{"", ""}, 0, 0},
+        "AED": {"dírham de los Emiratos Árabes Unidos", "", "", Count{"", ""}, 0, 0},
+        "AFA": {"afgani (1927–2002)", "", "", Count{"", ""}, 0, 0},
+        "AFN": {"afgani afgano", "", "؋", Count{"", ""}, 0, 0},
+        "ALL": {"lek albanés", "", "", Count{"", ""}, 0, 0},
+        "AMD": {"dram armenio", "", "֏", Count{"", ""}, 0, 0},
+        "ANG": {"florín de las Antillas Neerlandesas", "", "", Count{"", ""}, 0, 0},
+        "AOA": {"kuanza angoleño", "", "Kz", Count{"", ""}, 0, 0},
+        "AOK": {"kwanza angoleño (1977–1990)", "", "", Count{"", ""}, 0, 0},
+        "AON": {"nuevo kwanza angoleño (1990–2000)", "", "", Count{"", ""}, 0, 0},
+        "AOR": {"kwanza reajustado angoleño (1995–1999)", "", "", Count{"", ""}, 0, 0},
+        "ARA": {"austral argentino", "", "", Count{"", ""}, 0, 0},
+        "ARP": {"peso argentino (1983–1985)", "", "", Count{"", ""}, 0, 0},
+        "ARS": {"peso argentino", "", "$", Count{"peso argentino", "pesos argentinos"}, 0, 0},
+        "ATS": {"chelín austriaco", "", "", Count{"", ""}, 0, 0},
+        "AUD": {"dólar australiano", "AUD", "$", Count{"dólar australiano", "dólares australianos"}, 0, 0},
+        "AWG": {"florín arubeño", "", "", Count{"", ""}, 0, 0},
+        "AZM": {"manat azerí (1993–2006)", "", "", Count{"", ""}, 0, 0},
+        "AZN": {"manat azerbaiyano", "", "₼", Count{"", ""}, 0, 0},
+        "BAD": {"dinar bosnio", "", "", Count{"", ""}, 0, 0},
+        "BAM": {"marco convertible de Bosnia y Herzegovina", "", "KM", Count{"", ""}, 0, 0},
+        "BBD": {"dólar barbadense", "", "$", Count{"", ""}, 0, 0},
+        "BDT": {"taka bangladesí", "", "৳", Count{"", ""}, 0, 0},
+        "BEC": {"franco belga (convertible)", "", "", Count{"", ""}, 0, 0},
+        "BEF": {"franco belga", "", "", Count{"", ""}, 0, 0},
+        "BEL": {"franco belga (financiero)", "", "", Count{"", ""}, 0, 0},
+        "BGL": {"lev fuerte búlgaro", "", "", Count{"", ""}, 0, 0},
+        "BGN": {"leva búlgara", "", "", Count{"", ""}, 0, 0},
+        "BHD": {"dinar bareiní", "", "", Count{"", ""}, 0, 0},
+        "BIF": {"franco burundés", "", "", Count{"", ""}, 0, 0},
+        "BMD": {"dólar de Bermudas", "", "$", Count{"", ""}, 0, 0},
+        "BND": {"dólar bruneano", "", "$", Count{"", ""}, 0, 0},
+        "BOB": {"boliviano", "", "Bs", Count{"", ""}, 0, 0},
+        "BOP": {"peso boliviano", "", "", Count{"", ""}, 0, 0},
+        "BOV": {"MVDOL boliviano", "", "", Count{"", ""}, 0, 0},
+        "BRB": {"nuevo cruceiro brasileño (1967–1986)", "", "", Count{"", ""}, 0, 0},
+        "BRC": {"cruzado brasileño", "", "", Count{"", ""}, 0, 0},
+        "BRE": {"cruceiro brasileño (1990–1993)", "", "", Count{"", ""}, 0, 0},
+        "BRL": {"real brasileño", "BRL", "R$", Count{"real brasileño", "reales brasileños"}, 0, 0},
+        "BRN": {"nuevo cruzado brasileño", "", "", Count{"", ""}, 0, 0},
+        "BRR": {"cruceiro brasileño", "", "", Count{"", ""}, 0, 0},
+        "BSD": {"dólar bahameño", "", "$", Count{"", ""}, 0, 0},
+        "BTN": {"gultrum butanés", "", "", Count{"", ""}, 0, 0},
+        "BUK": {"kyat birmano", "", "", Count{"", ""}, 0, 0},
+        "BWP": {"pula botsuano", "", "P", Count{"", ""}, 0, 0},
+        "BYB": {"nuevo rublo bielorruso (1994–1999)", "", "", Count{"", ""}, 0, 0},
+        "BYN": {"rublo bielorruso", "", "р.", Count{"", ""}, 0, 0},
+        "BYR": {"rublo bielorruso (2000–2016)", "", "", Count{"", ""}, 0, 0},
+        "BZD": {"dólar beliceño", "", "$", Count{"", ""}, 0, 0},
+        "CAD": {"dólar canadiense", "CAD", "$", Count{"dólar canadiense", "dólares canadienses"}, 0, 0},
+        "CDF": {"franco congoleño", "", "", Count{"", ""}, 0, 0},
+        "CHE": {"euro WIR", "", "", Count{"", ""}, 0, 0},
+        "CHF": {"franco suizo", "", "", Count{"franco suizo", "francos suizos"}, 0, 0},
+        "CHW": {"franco WIR", "", "", Count{"", ""}, 0, 0},
+        "CLF": {"unidad de fomento chilena", "", "", Count{"", ""}, 0, 0},
+        "CLP": {"Peso chileno", "$", "$", Count{"peso chileno", "pesos chilenos"}, 0, 0},
+        "CNH": {"yuan chino (extracontinental)", "", "", Count{"", ""}, 0, 0},
+        "CNY": {"yuan renminbi", "CNY", "¥", Count{"", ""}, 0, 0},
+        "COP": {"peso colombiano", "", "$", Count{"peso colombiano", "pesos colombianos"}, 0, 0},
+        "COU": {"unidad de valor real colombiana", "", "", Count{"", ""}, 0, 0},
+        "CRC": {"colón costarricense", "", "₡", Count{"", ""}, 0, 0},
+        "CSD": {"antiguo dinar serbio", "", "", Count{"", ""}, 0, 0},
+        "CSK": {"corona fuerte checoslovaca", "", "", Count{"", ""}, 0, 0},
+        "CUC": {"peso cubano convertible", "", "$", Count{"", ""}, 0, 0},
+        "CUP": {"peso cubano", "", "$", Count{"", ""}, 0, 0},
+        "CVE": {"escudo de Cabo Verde", "", "", Count{"escudo de Cabo Verde", "escudos de Cabo Verde"}, 0, 0},
+        "CYP": {"libra chipriota", "", "", Count{"", ""}, 0, 0},
+        "CZK": {"corona checa", "", "Kč", Count{"", ""}, 0, 0},
+        "DDM": {"ostmark de Alemania del Este", "", "", Count{"", ""}, 0, 0},
+        "DEM": {"marco alemán", "", "", Count{"", ""}, 0, 0},
+        "DJF": {"franco yibutiano", "", "", Count{"", ""}, 0, 0},
+        "DKK": {"corona danesa", "", "kr", Count{"", ""}, 0, 0},
+        "DOP": {"peso dominicano", "", "$", Count{"", ""}, 0, 0},
+        "DZD": {"dinar argelino", "", "", Count{"", ""}, 0, 0},
+        "ECS": {"sucre ecuatoriano", "", "", Count{"", ""}, 0, 0},
+        "ECV": {"unidad de valor constante (UVC) ecuatoriana", "", "", Count{"", ""}, 0, 0},
+        "EEK": {"corona estonia", "", "", Count{"", ""}, 0, 0},
+        "EGP": {"libra egipcia", "", "E£", Count{"", ""}, 0, 0},
+        "ERN": {"nakfa eritreo", "", "", Count{"", ""}, 0, 0},
+        "ESA": {"peseta española (cuenta A)", "", "", Count{"", ""}, 0, 0},
+        "ESB": {"peseta española (cuenta convertible)", "", "", Count{"", ""}, 0, 0},
+        "ESP": {"peseta española", "₧", "₧", Count{"", ""}, 0, 0},
+        "ETB": {"bir etíope", "", "", Count{"", ""}, 0, 0},
+        "EUR": {"euro", "EUR", "€", Count{"euro", "euros"}, 0, 0},
+        "FIM": {"marco finlandés", "", "", Count{"", ""}, 0, 0},
+        "FJD": {"dólar fiyiano", "", "$", Count{"", ""}, 0, 0},
+        "FKP": {"libra malvinense", "", "FK£", Count{"", ""}, 0, 0},
+        "FRF": {"franco francés", "", "", Count{"", ""}, 0, 0},
+        "GBP": {"libra esterlina", "GBP", "£", Count{"libra esterlina", "libras esterlinas"}, 0, 0},
+        "GEK": {"kupon larit georgiano", "", "", Count{"", ""}, 0, 0},
+        "GEL": {"lari georgiano", "", "₾", Count{"", ""}, 0, 0},
+        "GHC": {"cedi ghanés (1979–2007)", "", "", Count{"", ""}, 0, 0},
+        "GHS": {"cedi ghanés", "", "GH₵", Count{"", ""}, 0, 0},
+        "GIP": {"libra gibraltareña", "", "£", Count{"", ""}, 0, 0},
+        "GMD": {"dalasi gambiano", "", "", Count{"", ""}, 0, 0},
+        "GNF": {"franco guineano", "", "FG", Count{"", ""}, 0, 0},
+        "GNS": {"syli guineano", "", "", Count{"", ""}, 0, 0},
+        "GQE": {"ekuele de Guinea Ecuatorial", "", "", Count{"", ""}, 0, 0},
+        "GRD": {"dracma griego", "", "", Count{"", ""}, 0, 0},
+        "GTQ": {"quetzal guatemalteco", "", "Q", Count{"", ""}, 0, 0},
+        "GWE": {"escudo de Guinea Portuguesa", "", "", Count{"", ""}, 0, 0},
+        "GWP": {"peso de Guinea-Bissáu", "", "", Count{"", ""}, 0, 0},
+        "GYD": {"dólar guyanés", "", "$", Count{"", ""}, 0, 0},
+        "HKD": {"dólar hongkonés", "HKD", "$", Count{"", ""}, 0, 0},
+        "HNL": {"lempira hondureño", "", "L", Count{"", ""}, 0, 0},
+        "HRD": {"dinar croata", "", "", Count{"", ""}, 0, 0},
+        "HRK": {"kuna croata", "", "kn", Count{"", ""}, 0, 0},
+        "HTG": {"gourde haitiano", "", "", Count{"", ""}, 0, 0},
+        "HUF": {"forinto húngaro", "", "Ft", Count{"", ""}, 0, 0},
+        "IDR": {"rupia indonesia", "", "Rp", Count{"", ""}, 0, 0},
+        "IEP": {"libra irlandesa", "", "", Count{"", ""}, 0, 0},
+        "ILP": {"libra israelí", "", "", Count{"", ""}, 0, 0},
+        "ILS": {"nuevo séquel israelí", "ILS", "₪", Count{"", ""}, 0, 0},
+        "INR": {"rupia india", "INR", "₹", Count{"", ""}, 0, 0},
+        "IQD": {"dinar iraquí", "", "", Count{"", ""}, 0, 0},
+        "IRR": {"rial iraní", "", "", Count{"", ""}, 0, 0},
+        "ISK": {"corona islandesa", "", "kr", Count{"", ""}, 0, 0},
+        "ITL": {"lira italiana", "", "", Count{"", ""}, 0, 0},
+        "JMD": {"dólar jamaicano", "", "$", Count{"", ""}, 0, 0},
+        "JOD": {"dinar jordano", "", "", Count{"", ""}, 0, 0},
+        "JPY": {"yen japonés", "JPY", "¥", Count{"yen japonés", "yenes japoneses"}, 0, 0},
+        "KES": {"chelín keniano", "", "", Count{"", ""}, 0, 0},
+        "KGS": {"som kirguís", "", "⃀", Count{"", ""}, 0, 0},
+        "KHR": {"riel camboyano", "", "៛", Count{"", ""}, 0, 0},
+        "KMF": {"franco comorense", "", "CF", Count{"", ""}, 0, 0},
+        "KPW": {"won norcoreano", "", "₩", Count{"", ""}, 0, 0},
+        "KRW": {"won surcoreano", "KRW", "₩", Count{"", ""}, 0, 0},
+        "KWD": {"dinar kuwaití", "", "", Count{"", ""}, 0, 0},
+        "KYD": {"dólar de las Islas Caimán", "", "$", Count{"", ""}, 0, 0},
+        "KZT": {"tenge kazajo", "", "₸", Count{"", ""}, 0, 0},
+        "LAK": {"kip laosiano", "", "₭", Count{"", ""}, 0, 0},
+        "LBP": {"libra libanesa", "", "L£", Count{"", ""}, 0, 0},
+        "LKR": {"rupia esrilanquesa", "", "Rs", Count{"", ""}, 0, 0},
+        "LRD": {"dólar liberiano", "", "$", Count{"", ""}, 0, 0},
+        "LSL": {"loti lesotense", "", "", Count{"", ""}, 0, 0},
+        "LTL": {"litas lituano", "", "Lt", Count{"", ""}, 0, 0},
+        "LTT": {"talonas lituano", "", "", Count{"", ""}, 0, 0},
+        "LUC": {"franco convertible luxemburgués", "", "", Count{"", ""}, 0, 0},
+        "LUF": {"franco luxemburgués", "", "", Count{"", ""}, 0, 0},
+        "LUL": {"franco financiero luxemburgués", "", "", Count{"", ""}, 0, 0},
+        "LVL": {"lats letón", "", "Ls", Count{"", ""}, 0, 0},
+        "LVR": {"rublo letón", "", "", Count{"", ""}, 0, 0},
+        "LYD": {"dinar libio", "", "", Count{"", ""}, 0, 0},
+        "MAD": {"dírham marroquí", "", "", Count{"", ""}, 0, 0},
+        "MAF": {"franco marroquí", "", "", Count{"", ""}, 0, 0},
+        "MDL": {"leu moldavo", "", "", Count{"", ""}, 0, 0},
+        "MGA": {"ariari malgache", "", "Ar", Count{"", ""}, 0, 0},
+        "MGF": {"franco malgache", "", "", Count{"", ""}, 0, 0},
+        "MKD": {"dinar macedonio", "", "", Count{"", ""}, 0, 0},
+        "MLF": {"franco malí", "", "", Count{"", ""}, 0, 0},
+        "MMK": {"kiat de Myanmar", "", "K", Count{"", ""}, 0, 0},
+        "MNT": {"tugrik mongol", "", "₮", Count{"", ""}, 0, 0},
+        "MOP": {"pataca macaense", "", "", Count{"", ""}, 0, 0},
+        "MRO": {"uguiya (1973–2017)", "", "", Count{"", ""}, 0, 0},
+        "MRU": {"uguiya mauritano", "", "", Count{"", ""}, 0, 0},
+        "MTL": {"lira maltesa", "", "", Count{"", ""}, 0, 0},
+        "MTP": {"libra maltesa", "", "", Count{"", ""}, 0, 0},
+        "MUR": {"rupia mauriciana", "", "Rs", Count{"", ""}, 0, 0},
+        "MVR": {"rufiya maldiva", "", "", Count{"", ""}, 0, 0},
+        "MWK": {"kwacha malauí", "", "", Count{"", ""}, 0, 0},
+        "MXN": {"peso mexicano", "MXN", "$", Count{"peso mexicano", "pesos mexicanos"}, 0, 0},
+        "MXP": {"peso de plata mexicano (1861–1992)", "", "", Count{"", ""}, 0, 0},
+        "MXV": {"unidad de inversión (UDI) mexicana", "", "", Count{"", ""}, 0, 0},
+        "MYR": {"ringit malasio", "", "RM", Count{"", ""}, 0, 0},
+        "MZE": {"escudo mozambiqueño", "", "", Count{"", ""}, 0, 0},
+        "MZM": {"antiguo metical mozambiqueño", "", "", Count{"", ""}, 0, 0},
+        "MZN": {"metical mozambiqueño", "", "", Count{"", ""}, 0, 0},
+        "NAD": {"dólar namibio", "", "$", Count{"", ""}, 0, 0},
+        "NGN": {"naira nigeriano", "", "₦", Count{"", ""}, 0, 0},
+        "NIC": {"córdoba nicaragüense (1988–1991)", "", "", Count{"", ""}, 0, 0},
+        "NIO": {"córdoba nicaragüense", "", "C$", Count{"", ""}, 0, 0},
+        "NLG": {"florín neerlandés", "", "", Count{"", ""}, 0, 0},
+        "NOK": {"corona noruega", "", "kr", Count{"", ""}, 0, 0},
+        "NPR": {"rupia nepalí", "", "Rs", Count{"", ""}, 0, 0},
+        "NZD": {"dólar neozelandés", "NZD", "$", Count{"", ""}, 0, 0},
+        "OMR": {"rial omaní", "", "", Count{"", ""}, 0, 0},
+        "PAB": {"balboa panameño", "", "", Count{"", ""}, 0, 0},
+        "PEI": {"inti peruano", "", "", Count{"", ""}, 0, 0},
+        "PEN": {"sol peruano", "", "", Count{"", ""}, 0, 0},
+        "PES": {"sol peruano (1863–1965)", "", "", Count{"", ""}, 0, 0},
+        "PGK": {"kina papú", "", "", Count{"", ""}, 0, 0},
+        "PHP": {"peso filipino", "PHP", "₱", Count{"", ""}, 0, 0},
+        "PKR": {"rupia pakistaní", "", "Rs", Count{"", ""}, 0, 0},
+        "PLN": {"esloti polaco", "", "zł", Count{"", ""}, 0, 0},
+        "PLZ": {"zloty polaco (1950–1995)", "", "", Count{"", ""}, 0, 0},
+        "PTE": {"escudo portugués", "", "", Count{"escudo portugués", "escudos portugueses"}, 0, 0},
+        "PYG": {"guaraní paraguayo", "", "₲", Count{"", ""}, 0, 0},
+        "QAR": {"rial catarí", "", "", Count{"", ""}, 0, 0},
+        "RHD": {"dólar rodesiano", "", "", Count{"", ""}, 0, 0},
+        "ROL": {"antiguo leu rumano", "", "", Count{"", ""}, 0, 0},
+        "RON": {"leu rumano", "", "L", Count{"", ""}, 0, 0},
+        "RSD": {"dinar serbio", "", "", Count{"", ""}, 0, 0},
+        "RUB": {"rublo ruso", "", "₽", Count{"", ""}, 0, 0},
+        "RUR": {"rublo ruso (1991–1998)", "", "", Count{"", ""}, 0, 0},
+        "RWF": {"franco ruandés", "", "RF", Count{"", ""}, 0, 0},
+        "SAR": {"rial saudí", "⃁", "", Count{"", ""}, 0, 0},
+        "SBD": {"dólar salomonense", "", "$", Count{"", ""}, 0, 0},
+        "SCR": {"rupia seychellense", "", "", Count{"", ""}, 0, 0},
+        "SDD": {"dinar sudanés", "", "", Count{"", ""}, 0, 0},
+        "SDG": {"libra sudanesa", "", "", Count{"", ""}, 0, 0},
+        "SDP": {"libra sudanesa antigua", "", "", Count{"", ""}, 0, 0},
+        "SEK": {"corona sueca", "", "kr", Count{"", ""}, 0, 0},
+        "SGD": {"dólar singapurense", "", "$", Count{"", ""}, 0, 0},
+        "SHP": {"libra de Santa Elena", "", "£", Count{"", ""}, 0, 0},
+        "SIT": {"tólar esloveno", "", "", Count{"", ""}, 0, 0},
+        "SKK": {"corona eslovaca", "", "", Count{"", ""}, 0, 0},
+        "SLE": {"leone", "", "", Count{"", ""}, 0, 0},
+        "SLL": {"leones (1964—2022)", "", "", Count{"", ""}, 0, 0},
+        "SOS": {"chelín somalí", "", "", Count{"", ""}, 0, 0},
+        "SRD": {"dólar surinamés", "", "$", Count{"", ""}, 0, 0},
+        "SRG": {"florín surinamés", "", "", Count{"", ""}, 0, 0},
+        "SSP": {"libra sursudanesa", "", "SD£", Count{"", ""}, 0, 0},
+        "STD": {"dobra (1977–2017)", "", "", Count{"", ""}, 0, 0},
+        "STN": {"dobra santotomense", "", "Db", Count{"", ""}, 0, 0},
+        "SUR": {"rublo soviético", "", "", Count{"", ""}, 0, 0},
+        "SVC": {"colón salvadoreño", "", "", Count{"", ""}, 0, 0},
+        "SYP": {"libra siria", "", "S£", Count{"", ""}, 0, 0},
+        "SZL": {"lilangeni esuatiní", "", "", Count{"", ""}, 0, 0},
+        "THB": {"baht tailandes", "THB", "฿", Count{"", ""}, 0, 0},
+        "TJR": {"rublo tayiko", "", "", Count{"", ""}, 0, 0},
+        "TJS": {"somoni tayiko", "", "", Count{"", ""}, 0, 0},
+        "TMM": {"manat turcomano (1993–2009)", "", "", Count{"", ""}, 0, 0},
+        "TMT": {"manat turcomano", "", "", Count{"", ""}, 0, 0},
+        "TND": {"dinar tunecino", "", "", Count{"", ""}, 0, 0},
+        "TOP": {"paanga tongano", "", "T$", Count{"", ""}, 0, 0},
+        "TPE": {"escudo timorense", "", "", Count{"", ""}, 0, 0},
+        "TRL": {"lira turca (1922–2005)", "", "", Count{"", ""}, 0, 0},
+        "TRY": {"lira turca", "TL", "₺", Count{"", ""}, 0, 0},
+        "TTD": {"dólar de Trinidad y Tobago", "", "$", Count{"", ""}, 0, 0},
+        "TWD": {"nuevo dólar taiwanés", "TWD", "NT$", Count{"", ""}, 0, 0},
+        "TZS": {"chelín tanzano", "", "", Count{"", ""}, 0, 0},
+        "UAH": {"grivna ucraniana", "", "₴", Count{"", ""}, 0, 0},
+        "UAK": {"karbovanet ucraniano", "", "", Count{"", ""}, 0, 0},
+        "UGS": {"chelín ugandés (1966–1987)", "", "", Count{"", ""}, 0, 0},
+        "UGX": {"chelín ugandés", "", "", Count{"", ""}, 0, 0},
+        "USD": {"dólar estadounidense", "US$", "$", Count{"dólar estadounidense", "dólares estadounidenses"}, 0, 0},
+        "USN": {"dólar estadounidense (día siguiente)", "", "", Count{"", ""}, 0, 0},
+        "USS": {"dólar estadounidense (mismo día)", "", "", Count{"", ""}, 0, 0},
+        "UYI": {"peso uruguayo en unidades indexadas", "", "", Count{"", ""}, 0, 0},
+        "UYP": {"peso uruguayo (1975–1993)", "", "", Count{"", ""}, 0, 0},
+        "UYU": {"peso uruguayo", "", "$", Count{"", ""}, 0, 0},
+        "UYW": {"unidad previsional uruguayo", "", "", Count{"", ""}, 0, 0},
+        "UZS": {"som uzbeko", "", "", Count{"", ""}, 0, 0},
+        "VEB": {"bolívar venezolano (1871–2008)", "", "", Count{"", ""}, 0, 0},
+        "VEF": {"bolívar venezolano (2008–2018)", "", "BsF", Count{"", ""}, 0, 0},
+        "VES": {"bolívar venezolano", "", "", Count{"", ""}, 0, 0},
+        "VND": {"dong vietnamita", "VND", "₫", Count{"", ""}, 0, 0},
+        "VUV": {"vatu vanuatense", "", "", Count{"", ""}, 0, 0},
+        "WST": {"tala samoano", "", "", Count{"", ""}, 0, 0},
+        "XAF": {"franco CFA de África Central", "XAF", "", Count{"", ""}, 0, 0},
+        "XAG": {"plata", "", "", Count{"", ""}, 0, 0},
+        "XAU": {"oro", "", "", Count{"", ""}, 0, 0},
+        "XBA": {"unidad compuesta europea", "", "", Count{"", ""}, 0, 0},
+        "XBB": {"unidad monetaria europea", "", "", Count{"", ""}, 0, 0},
+        "XBC": {"unidad de cuenta europea (XBC)", "", "", Count{"", ""}, 0, 0},
+        "XBD": {"unidad de cuenta europea (XBD)", "", "", Count{"", ""}, 0, 0},
+        "XCD": {"dólar del Caribe Oriental", "XCD", "$", Count{"", ""}, 0, 0},
+        "XCG": {"florín caribeño", "Cg.", "", Count{"", ""}, 0, 0},
+        "XDR": {"derechos especiales de giro", "", "", Count{"", ""}, 0, 0},
+        "XEU": {"unidad de moneda europea", "", "", Count{"", ""}, 0, 0},
+        "XFO": {"franco oro francés", "", "", Count{"", ""}, 0, 0},
+        "XFU": {"franco UIC francés", "", "", Count{"", ""}, 0, 0},
+        "XOF": {"franco CFA de África Occidental", "XOF", "", Count{"", ""}, 0, 0},
+        "XPD": {"paladio", "", "", Count{"", ""}, 0, 0},
+        "XPF": {"franco CFP", "CFPF", "", Count{"", ""}, 0, 0},
+        "XPT": {"platino", "", "", Count{"", ""}, 0, 0},
+        "XRE": {"fondos RINET", "", "", Count{"", ""}, 0, 0},
+        "XTS": {"código reservado para pruebas", "", "", Count{"", ""}, 0, 0},
+        "XXX": {"moneda desconocida", "¤", "", Count{"", ""}, 0, 0},
+        "YDD": {"dinar yemení", "", "", Count{"", ""}, 0, 0},
+        "YER": {"rial yemení", "", "", Count{"", ""}, 0, 0},
+        "YUD": {"dinar fuerte yugoslavo", "", "", Count{"", ""}, 0, 0},
+        "YUM": {"super dinar yugoslavo", "", "", Count{"", ""}, 0, 0},
+        "YUN": {"dinar convertible yugoslavo", "", "", Count{"", ""}, 0, 0},
+        "ZAL": {"rand sudafricano (financiero)", "", "", Count{"", ""}, 0, 0},
+        "ZAR": {"rand sudafricano", "", "R", Count{"", ""}, 0, 0},
+        "ZMK": {"kwacha zambiano (1968–2012)", "", "", Count{"", ""}, 0, 0},
+        "ZMW": {"kuacha zambiano", "", "ZK", Count{"", ""}, 0, 0},
+        "ZRN": {"nuevo zaire zaireño", "", "", Count{"", ""}, 0, 0},
+        "ZRZ": {"zaire zaireño", "", "", Count{"", ""}, 0, 0},
+        "ZWD": {"dólar de Zimbabue", "", "", Count{"", ""}, 0, 0},
+        "ZWG": {"oro zimbabuense", "", "", Count{"", ""}, 0, 0},
+        "ZWL": {"dólar zimbabuense", "", "", Count{"", ""}, 0, 0},
     }, map[string]Unit{
         "duration-century": {Count{"{0} siglo", "{0} siglos"}, Count{"", "{0} s."}, Count{"{0}s", "{0}s"}},
         "duration-day": {Count{"{0} día", "{0} días"}, Count{"{0} d.", "{0} dd."}, Count{"{0}d.", "{0}dd."}},
@@ -4634,8 +5497,274 @@ var locales = map[string]Locale{
         "ZM": "Zambia",
         "ZW": "Zimbabue",
         "ZZ": "Región desconocida",
-    }},
-    "nl": {"#,##0.###", CurrencyFormat{"¤ #,##0.00;¤ -#,##0.00", "#,##0.00", "¤ #,##0.00;¤ -#,##0.00"}, CalendarFormat{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd-MM-y"}, CalendarFormat{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
+    }, ListPattern{"{0}, {1}", "{0}, {1}", "{0} y {1}", "{0} y {1}"}, CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}}, "#,##0 %", 37, map[string]RelativeTime{
+        "day": {map[string]string{
+            "-1": "ayer",
+            "-2": "anteayer",
+            "0": "hoy",
+            "1": "mañana",
+            "2": "pasado mañana",
+        }, Count{"dentro de {0} día", "dentro de {0} días"}, Count{"hace {0} día", "hace {0} días"}},
+        "day-narrow": {map[string]string{
+            "-1": "ayer",
+            "-2": "anteayer",
+            "0": "hoy",
+            "1": "mañana",
+            "2": "pasado mañana",
+        }, Count{"dentro de {0} d", "dentro de {0} d"}, Count{"hace {0} d", "hace {0} d"}},
+        "day-short": {map[string]string{
+            "-1": "ayer",
+            "-2": "anteayer",
+            "0": "hoy",
+            "1": "mañana",
+            "2": "pasado mañana",
+        }, Count{"dentro de {0} d", "dentro de {0} d"}, Count{"hace {0} d", "hace {0} d"}},
+        "hour": {map[string]string{
+            "0": "esta hora",
+        }, Count{"dentro de {0} hora", "dentro de {0} horas"}, Count{"hace {0} hora", "hace {0} horas"}},
+        "hour-narrow": {map[string]string{
+            "0": "esta hora",
+        }, Count{"dentro de {0} h", "dentro de {0} h"}, Count{"hace {0} h", "hace {0} h"}},
+        "hour-short": {map[string]string{
+            "0": "esta hora",
+        }, Count{"dentro de {0} h", "dentro de {0} h"}, Count{"hace {0} h", "hace {0} h"}},
+        "minute": {map[string]string{
+            "0": "este minuto",
+        }, Count{"dentro de {0} minuto", "dentro de {0} minutos"}, Count{"hace {0} minuto", "hace {0} minutos"}},
+        "minute-narrow": {map[string]string{
+            "0": "este minuto",
+        }, Count{"dentro de {0} min", "dentro de {0} min"}, Count{"hace {0} min", "hace {0} min"}},
+        "minute-short": {map[string]string{
+            "0": "este minuto",
+        }, Count{"dentro de {0} min", "dentro de {0} min"}, Count{"hace {0} min", "hace {0} min"}},
+        "month": {map[string]string{
+            "-1": "el mes pasado",
+            "0": "este mes",
+            "1": "el próximo mes",
+        }, Count{"dentro de {0} mes", "dentro de {0} meses"}, Count{"hace {0} mes", "hace {0} meses"}},
+        "month-narrow": {map[string]string{
+            "-1": "el mes pasado",
+            "0": "este mes",
+            "1": "el próximo mes",
+        }, Count{"dentro de {0} m", "dentro de {0} m"}, Count{"hace {0} m", "hace {0} m"}},
+        "month-short": {map[string]string{
+            "-1": "el mes pasado",
+            "0": "este mes",
+            "1": "el próximo mes",
+        }, Count{"dentro de {0} m", "dentro de {0} m"}, Count{"hace {0} m", "hace {0} m"}},
+        "quarter": {map[string]string{
+            "-1": "el trimestre pasado",
+            "0": "este trimestre",
+            "1": "el próximo trimestre",
+        }, Count{"dentro de {0} trimestre", "dentro de {0} trimestres"}, Count{"hace {0} trimestre", "hace {0} trimestres"}},
+        "quarter-narrow": {map[string]string{
+            "-1": "el trim. pasado",
+            "0": "este trim.",
+            "1": "el próximo trim.",
+        }, Count{"dentro de {0} trim.", "dentro de {0} trim."}, Count{"hace {0} trim.", "hace {0} trim."}},
+        "quarter-short": {map[string]string{
+            "-1": "el trim. pasado",
+            "0": "este trim.",
+            "1": "el próximo trim.",
+        }, Count{"dentro de {0} trim.", "dentro de {0} trim."}, Count{"hace {0} trim.", "hace {0} trim."}},
+        "second": {map[string]string{
+            "0": "ahora",
+        }, Count{"dentro de {0} segundo", "dentro de {0} segundos"}, Count{"hace {0} segundo", "hace {0} segundos"}},
+        "second-narrow": {map[string]string{
+            "0": "ahora",
+        }, Count{"dentro de {0} s", "dentro de {0} s"}, Count{"hace {0} s", "hace {0} s"}},
+        "second-short": {map[string]string{
+            "0": "ahora",
+        }, Count{"dentro de {0} s", "dentro de {0} s"}, Count{"hace {0} s", "hace {0} s"}},
+        "week": {map[string]string{
+            "-1": "la semana pasada",
+            "0": "esta semana",
+            "1": "la próxima semana",
+        }, Count{"dentro de {0} semana", "dentro de {0} semanas"}, Count{"hace {0} semana", "hace {0} semanas"}},
+        "week-narrow": {map[string]string{
+            "-1": "sem. ant.",
+            "0": "esta sem.",
+            "1": "próx. sem.",
+        }, Count{"dentro de {0} sem.", "dentro de {0} sem."}, Count{"hace {0} sem.", "hace {0} sem."}},
+        "week-short": {map[string]string{
+            "-1": "sem. ant.",
+            "0": "esta sem.",
+            "1": "próx. sem.",
+        }, Count{"dentro de {0} sem.", "dentro de {0} sem."}, Count{"hace {0} sem.", "hace {0} sem."}},
+        "year": {map[string]string{
+            "-1": "el año pasado",
+            "0": "este año",
+            "1": "el próximo año",
+        }, Count{"dentro de {0} año", "dentro de {0} años"}, Count{"hace {0} año", "hace {0} años"}},
+        "year-narrow": {map[string]string{
+            "-1": "el año pasado",
+            "0": "este año",
+            "1": "el próximo año",
+        }, Count{"dentro de {0} a", "dentro de {0} a"}, Count{"hace {0} a", "hace {0} a"}},
+        "year-short": {map[string]string{
+            "-1": "el año pasado",
+            "0": "este año",
+            "1": "el próximo año",
+        }, Count{"dentro de {0} a", "dentro de {0} a"}, Count{"hace {0} a", "hace {0} a"}},
+    }, map[string]string{
+        "Bh": "h B",
+        "Bhm": "h:mm B",
+        "Bhms": "h:mm:ss B",
+        "E": "ccc",
+        "EBhm": "E h:mm B",
+        "EBhms": "E h:mm:ss B",
+        "EHm": "E, H:mm",
+        "EHms": "E, H:mm:ss",
+        "Ed": "E d",
+        "Ehm": "E, h:mm a",
+        "Ehms": "E, h:mm:ss a",
+        "Gy": "y G",
+        "GyMMM": "MMM y G",
+        "GyMMMEd": "E, d MMM y G",
+        "GyMMMM": "MMMM 'de' y G",
+        "GyMMMMEd": "E, d 'de' MMMM 'de' y G",
+        "GyMMMMd": "d 'de' MMMM 'de' y G",
+        "GyMMMd": "d MMM y G",
+        "GyMd": "d/M/y G",
+        "H": "H",
+        "Hm": "H:mm",
+        "Hms": "H:mm:ss",
+        "Hmsv": "H:mm:ss v",
+        "Hmsvvvv": "H:mm:ss (vvvv)",
+        "Hmv": "H:mm v",
+        "M": "L",
+        "MEd": "E, dd-MM",
+        "MMM": "LLL",
+        "MMMEd": "E, d MMM",
+        "MMMMEd": "E, d 'de' MMMM",
+        "MMMMd": "d 'de' MMMM",
+        "MMMd": "d MMM",
+        "MMd": "d/M",
+        "MMdd": "dd-MM",
+        "Md": "dd-MM",
+        "d": "d",
+        "h": "h a",
+        "hm": "h:mm a",
+        "hms": "h:mm:ss a",
+        "hmsv": "h:mm:ss a v",
+        "hmsvvvv": "h:mm:ss a (vvvv)",
+        "hmv": "h:mm a v",
+        "ms": "mm:ss",
+        "y": "y",
+        "yM": "MM-y",
+        "yMEd": "E, dd-MM-y",
+        "yMM": "MM-y",
+        "yMMM": "MMM y",
+        "yMMMEd": "EEE, d MMM y",
+        "yMMMM": "MMMM 'de' y",
+        "yMMMMEd": "EEE, d 'de' MMMM 'de' y",
+        "yMMMMd": "d 'de' MMMM 'de' y",
+        "yMMMd": "d MMM y",
+        "yMd": "dd-MM-y",
+        "yQQQ": "QQQ y",
+        "yQQQQ": "QQQQ 'de' y",
+    }, [2]CalendarSymbol{
+        {"antes de Cristo", "a. C.", "a. C.", ""},
+        {"después de Cristo", "d. C.", "d. C.", ""},
+    }, [4]CalendarSymbol{
+        {"1.er trimestre", "T1", "1", ""},
+        {"2.º trimestre", "T2", "2", ""},
+        {"3.er trimestre", "T3", "3", ""},
+        {"4.º trimestre", "T4", "4", ""},
+    }, [12]CalendarSymbol{
+        {"enero", "ene", "E", ""},
+        {"febrero", "feb", "F", ""},
+        {"marzo", "mar", "M", ""},
+        {"abril", "abr", "A", ""},
+        {"mayo", "may", "M", ""},
+        {"junio", "jun", "J", ""},
+        {"julio", "jul", "J", ""},
+        {"agosto", "ago", "A", ""},
+        {"septiembre", "sept", "S", ""},
+        {"octubre", "oct", "O", ""},
+        {"noviembre", "nov", "N", ""},
+        {"diciembre", "dic", "D", ""},
+    }, [7]CalendarSymbol{
+        {"domingo", "dom", "D", "do"},
+        {"lunes", "lun", "L", "lu"},
+        {"martes", "mar", "M", "ma"},
+        {"miércoles", "mié", "M", "mi"},
+        {"jueves", "jue", "J", "ju"},
+        {"viernes", "vie", "V", "vi"},
+        {"sábado", "sáb", "S", "sá"},
+    }, [4]CalendarSymbol{
+        {"1.er trimestre", "T1", "1", ""},
+        {"2.º trimestre", "T2", "2", ""},
+        {"3.er trimestre", "T3", "3", ""},
+        {"4.º trimestre", "T4", "4", ""},
+    }, "gregorian", map[string]Calendar{
+        "buddhist": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"EB", "EB", "EB", ""},
+        }},
+        "hebrew": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{
+            {"tishri", "tishri", "1", ""},
+            {"heshvan", "heshvan", "2", ""},
+            {"kislev", "kislev", "3", ""},
+            {"tevet", "tevet", "4", ""},
+            {"shevat", "shevat", "5", ""},
+            {"adar I", "adar I", "6", ""},
+            {"adar", "adar", "7", ""},
+            {"nisán", "nisán", "8", ""},
+            {"iyar", "iyar", "9", ""},
+            {"siván", "siván", "10", ""},
+            {"tamuz", "tamuz", "11", ""},
+            {"av", "av", "12", ""},
+            {"elul", "elul", "13", ""},
+            {"adar II", "adar II", "7", ""},
+        }, []CalendarSymbol{
+            {"AM", "AM", "AM", ""},
+        }},
+        "islamic": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{
+            {"muharram", "muh.", "1", ""},
+            {"safar", "saf.", "2", ""},
+            {"rabía I", "rab. I", "3", ""},
+            {"rabía II", "rab. II", "4", ""},
+            {"yumada I", "yum. I", "5", ""},
+            {"yumada II", "yum. II", "6", ""},
+            {"rayab", "ray.", "7", ""},
+            {"shabán", "sha.", "8", ""},
+            {"ramadán", "ram.", "9", ""},
+            {"shawal", "shaw.", "10", ""},
+            {"dhu l-qa'da", "dhu l-q.", "11", ""},
+            {"dhu l-hiyya", "dhu l-h.", "12", ""},
+        }, []CalendarSymbol{
+            {"a. H.", "a. H.", "a. H.", ""},
+        }},
+        "japanese": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Meiji", "Meiji", "M", ""},
+            {"Taishō", "Taishō", "T", ""},
+            {"Shōwa", "Shōwa", "S", ""},
+            {"Heisei", "Heisei", "H", ""},
+            {"Reiwa", "Reiwa", "R", ""},
+        }},
+        "persian": {CalendarFormat{"EEEE, d 'de' MMMM 'de' y G", "d 'de' MMMM 'de' y G", "d/M/y G", "d/M/yy GGGGG"}, []CalendarSymbol{
+            {"farvardin", "farvardin", "1", ""},
+            {"ordibehesht", "ordibehesht", "2", ""},
+            {"jordad", "jordad", "3", ""},
+            {"tir", "tir", "4", ""},
+            {"mordad", "mordad", "5", ""},
+            {"shahrivar", "shahrivar", "6", ""},
+            {"mehr", "mehr", "7", ""},
+            {"aban", "aban", "8", ""},
+            {"azar", "azar", "9", ""},
+            {"dey", "dey", "10", ""},
+            {"bahman", "bahman", "11", ""},
+            {"esfand", "esfand", "12", ""},
+        }, []CalendarSymbol{
+            {"AP", "AP", "AP", ""},
+        }},
+    }, 1, 1, "H", []string{
+        "H",
+        "h",
+        "hB",
+        "hb",
+    }, "latn", "", "metric", "", "GMT{0}", "GMT", "+HH:mm;-HH:mm", "hora de {0}", "horario de verano de {0}", "horario estándar de {0}", "{1} ({0})"},
+    "nl": {"#,##0.###", CurrencyFormat{"¤ #,##0.00;¤ -#,##0.00", "#,##0.00", "¤ #,##0.00;¤ -#,##0.00", Count{"{0} {1}", "{0} {1}"}}, CalendarFormat{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd-MM-y"}, CalendarFormat{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
         "": {
             "": "{0} – {1}",
         },
@@ -4782,39 +5911,39 @@ var locales = map[string]Locale{
             "y": "dd-MM-y – dd-MM-y",
         },
     }, "{0} {1}", 44, 46, 44, 46, 43, 45, 58, [12]CalendarSymbol{
-        {"januari", "jan", "J"},
-        {"februari", "feb", "F"},
-        {"maart", "mrt", "M"},
-        {"april", "apr", "A"},
-        {"mei", "mei", "M"},
-        {"juni", "jun", "J"},
-        {"juli", "jul", "J"},
-        {"augustus", "aug", "A"},
-        {"september", "sep", "S"},
-        {"oktober", "okt", "O"},
-        {"november", "nov", "N"},
-        {"december", "dec", "D"},
+        {"januari", "jan", "J", ""},
+        {"februari", "feb", "F", ""},
+        {"maart", "mrt", "M", ""},
+        {"april", "apr", "A", ""},
+        {"mei", "mei", "M", ""},
+        {"juni", "jun", "J", ""},
+        {"juli", "jul", "J", ""},
+        {"augustus", "aug", "A", ""},
+        {"september", "sep", "S", ""},
+        {"oktober", "okt", "O", ""},
+        {"november", "nov", "N", ""},
+        {"december", "dec", "D", ""},
     }, [7]CalendarSymbol{
-        {"zondag", "zo", "Z"},
-        {"maandag", "ma", "M"},
-        {"dinsdag", "di", "D"},
-        {"woensdag", "wo", "W"},
-        {"donderdag", "do", "D"},
-        {"vrijdag", "vr", "V"},
-        {"zaterdag", "za", "Z"},
+        {"zondag", "zo", "Z", "zo"},
+        {"maandag", "ma", "M", "ma"},
+        {"dinsdag", "di", "D", "di"},
+        {"woensdag", "wo", "W", "wo"},
+        {"donderdag", "do", "D", "do"},
+        {"vrijdag", "vr", "V", "vr"},
+        {"zaterdag", "za", "Z", "za"},
     }, map[string]DayPeriodRule{
         "afternoon1": {720, 1080},
         "evening1": {1080, 1440},
         "morning1": {360, 720},
         "night1": {0, 360},
     }, map[string]CalendarSymbol{
-        "afternoon1": {"", "’s middags", ""},
-        "am": {"AM", "a.m.", "AM"},
-        "evening1": {"", "’s avonds", ""},
-        "midnight": {"", "middernacht", ""},
-        "morning1": {"", "’s ochtends", ""},
-        "night1": {"", "’s nachts", ""},
-        "pm": {"PM", "p.m.", "PM"},
+        "afternoon1": {"", "’s middags", "", ""},
+        "am": {"AM", "a.m.", "AM", ""},
+        "evening1": {"", "’s avonds", "", ""},
+        "midnight": {"", "middernacht", "", ""},
+        "morning1": {"", "’s ochtends", "", ""},
+        "night1": {"", "’s nachts", "", ""},
+        "pm": {"PM", "p.m.", "PM", ""},
     }, map[string]string{
         "Africa/Addis_Ababa": "Addis Abeba",
         "Africa/Asmera": "Asmara",
@@ -5110,313 +6239,313 @@ var locales = map[string]Locale{
         "Yekaterinburg": {MetazoneSymbol{"Jekaterinenburg-tijd", ""}, MetazoneSymbol{"Jekaterinenburg-standaardtijd", ""}, MetazoneSymbol{"Jekaterinenburg-zomertijd", ""}},
         "Yukon": {MetazoneSymbol{"", ""}, MetazoneSymbol{"Yukon-tijd", ""}, MetazoneSymbol{"", ""}},
     }, map[string]Currency{
-        "ADP": {"Andorrese peseta", "", ""},
-        "AED": {"Verenigde Arabische Emiraten-dirham", "", ""},
-        "AFA": {"Afghani (1927–2002)", "", ""},
-        "AFN": {"Afghaanse afghani", "", "؋"},
-        "ALK": {"Albanese lek (1946–1965)", "", ""},
-        "ALL": {"Albanese lek", "", ""},
-        "AMD": {"Armeense dram", "", "֏"},
-        "ANG": {"Nederlands-Antilliaanse gulden", "", ""},
-        "AOA": {"Angolese kwanza", "", "Kz"},
-        "AOK": {"Angolese kwanza (1977–1990)", "", ""},
-        "AON": {"Angolese nieuwe kwanza (1990–2000)", "", ""},
-        "AOR": {"Angolese kwanza reajustado (1995–1999)", "", ""},
-        "ARA": {"Argentijnse austral", "", ""},
-        "ARL": {"Argentijnse peso ley (1970–1983)", "", ""},
-        "ARM": {"Argentijnse peso (1881–1970)", "", ""},
-        "ARP": {"Argentijnse peso (1983–1985)", "", ""},
-        "ARS": {"Argentijnse peso", "", "$"},
-        "ATS": {"Oostenrijkse schilling", "", ""},
-        "AUD": {"Australische dollar", "AU$", "$"},
-        "AWG": {"Arubaanse gulden", "", ""},
-        "AZM": {"Azerbeidzjaanse manat (1993–2006)", "", ""},
-        "AZN": {"Azerbeidzjaanse manat", "", "₼"},
-        "BAD": {"Bosnische dinar", "", ""},
-        "BAM": {"Bosnische convertibele mark", "", "KM"},
-        "BAN": {"Nieuwe Bosnische dinar (1994–1997)", "", ""},
-        "BBD": {"Barbadaanse dollar", "", "$"},
-        "BDT": {"Bengalese taka", "", "৳"},
-        "BEC": {"Belgische frank (convertibel)", "", ""},
-        "BEF": {"Belgische frank", "", ""},
-        "BEL": {"Belgische frank (financieel)", "", ""},
-        "BGL": {"Bulgaarse harde lev", "", ""},
-        "BGM": {"Bulgaarse socialistische lev", "", ""},
-        "BGN": {"Bulgaarse lev", "", ""},
-        "BGO": {"Bulgaarse lev (1879–1952)", "", ""},
-        "BHD": {"Bahreinse dinar", "", ""},
-        "BIF": {"Burundese frank", "", ""},
-        "BMD": {"Bermuda-dollar", "", "$"},
-        "BND": {"Bruneise dollar", "", "$"},
-        "BOB": {"Boliviaanse boliviano", "", "Bs"},
-        "BOL": {"Boliviaanse boliviano (1863–1963)", "", ""},
-        "BOP": {"Boliviaanse peso", "", ""},
-        "BOV": {"Boliviaanse mvdol", "", ""},
-        "BRB": {"Braziliaanse cruzeiro novo (1967–1986)", "", ""},
-        "BRC": {"Braziliaanse cruzado", "", ""},
-        "BRE": {"Braziliaanse cruzeiro (1990–1993)", "", ""},
-        "BRL": {"Braziliaanse real", "R$", "R$"},
-        "BRN": {"Braziliaanse nieuwe cruzado (1989–1990)", "", ""},
-        "BRR": {"Braziliaanse cruzeiro", "", ""},
-        "BRZ": {"Braziliaanse cruzeiro (1942–1967)", "", ""},
-        "BSD": {"Bahamaanse dollar", "", "$"},
-        "BTN": {"Bhutaanse ngultrum", "", ""},
-        "BUK": {"Birmese kyat", "", ""},
-        "BWP": {"Botswaanse pula", "", "P"},
-        "BYB": {"Wit-Russische nieuwe roebel (1994–1999)", "", ""},
-        "BYN": {"Belarussische roebel", "", "р."},
-        "BYR": {"Wit-Russische roebel (2000–2016)", "", ""},
-        "BZD": {"Belizaanse dollar", "", "$"},
-        "CAD": {"Canadese dollar", "C$", "$"},
-        "CDF": {"Congolese frank", "", ""},
-        "CHE": {"WIR euro", "", ""},
-        "CHF": {"Zwitserse frank", "", ""},
-        "CHW": {"WIR franc", "", ""},
-        "CLE": {"Chileense escudo", "", ""},
-        "CLF": {"Chileense unidades de fomento", "", ""},
-        "CLP": {"Chileense peso", "", "$"},
-        "CNH": {"Chinese yuan (offshore)", "", ""},
-        "CNX": {"dollar van de Chinese Volksbank", "", ""},
-        "CNY": {"Chinese yuan", "CN¥", "¥"},
-        "COP": {"Colombiaanse peso", "", "$"},
-        "COU": {"Unidad de Valor Real", "", ""},
-        "CRC": {"Costa Ricaanse colon", "", "₡"},
-        "CSD": {"Oude Servische dinar", "", ""},
-        "CSK": {"Tsjechoslowaakse harde koruna", "", ""},
-        "CUC": {"Cubaanse convertibele peso", "", "$"},
-        "CUP": {"Cubaanse peso", "", "$"},
-        "CVE": {"Kaapverdische escudo", "", ""},
-        "CYP": {"Cyprisch pond", "", ""},
-        "CZK": {"Tsjechische kroon", "", "Kč"},
-        "DDM": {"Oost-Duitse ostmark", "", ""},
-        "DEM": {"Duitse mark", "", ""},
-        "DJF": {"Djiboutiaanse frank", "", ""},
-        "DKK": {"Deense kroon", "", "kr"},
-        "DOP": {"Dominicaanse peso", "", "$"},
-        "DZD": {"Algerijnse dinar", "", ""},
-        "ECS": {"Ecuadoraanse sucre", "", ""},
-        "ECV": {"Ecuadoraanse unidad de valor constante (UVC)", "", ""},
-        "EEK": {"Estlandse kroon", "", ""},
-        "EGP": {"Egyptisch pond", "", "E£"},
-        "ERN": {"Eritrese nakfa", "", ""},
-        "ESA": {"Spaanse peseta (account A)", "", ""},
-        "ESB": {"Spaanse peseta (convertibele account)", "", ""},
-        "ESP": {"Spaanse peseta", "", "₧"},
-        "ETB": {"Ethiopische birr", "", ""},
-        "EUR": {"Euro", "€", "€"},
-        "FIM": {"Finse markka", "", ""},
-        "FJD": {"Fiji-dollar", "FJ$", "$"},
-        "FKP": {"Falklandeilands pond", "", "£"},
-        "FRF": {"Franse franc", "", ""},
-        "GBP": {"Britse pond", "£", "£"},
-        "GEK": {"Georgische kupon larit", "", ""},
-        "GEL": {"Georgische lari", "ლ", "₾"},
-        "GHC": {"Ghanese cedi (1979–2007)", "", ""},
-        "GHS": {"Ghanese cedi", "", "GH₵"},
-        "GIP": {"Gibraltarees pond", "", "£"},
-        "GMD": {"Gambiaanse dalasi", "", ""},
-        "GNF": {"Guinese frank", "", "FG"},
-        "GNS": {"Guinese syli", "", ""},
-        "GQE": {"Equatoriaal-Guinese ekwele guineana", "", ""},
-        "GRD": {"Griekse drachme", "", ""},
-        "GTQ": {"Guatemalteekse quetzal", "", "Q"},
-        "GWE": {"Portugees-Guinese escudo", "", ""},
-        "GWP": {"Guinee-Bissause peso", "", ""},
-        "GYD": {"Guyaanse dollar", "", "$"},
-        "HKD": {"Hongkongse dollar", "HK$", "$"},
-        "HNL": {"Hondurese lempira", "", "L"},
-        "HRD": {"Kroatische dinar", "", ""},
-        "HRK": {"Kroatische kuna", "", "kn"},
-        "HTG": {"Haïtiaanse gourde", "", ""},
-        "HUF": {"Hongaarse forint", "", "Ft"},
-        "IDR": {"Indonesische roepia", "", "Rp"},
-        "IEP": {"Iers pond", "", ""},
-        "ILP": {"Israëlisch pond", "", ""},
-        "ILR": {"Israëlische sjekel (1980–1985)", "", ""},
-        "ILS": {"Israëlische nieuwe shekel", "₪", "₪"},
-        "INR": {"Indiase roepie", "₹", "₹"},
-        "IQD": {"Iraakse dinar", "", ""},
-        "IRR": {"Iraanse rial", "", ""},
-        "ISJ": {"IJslandse kroon (1918–1981)", "", ""},
-        "ISK": {"IJslandse kroon", "", "kr"},
-        "ITL": {"Italiaanse lire", "", ""},
-        "JMD": {"Jamaicaanse dollar", "", "$"},
-        "JOD": {"Jordaanse dinar", "", ""},
-        "JPY": {"Japanse yen", "JP¥", "¥"},
-        "KES": {"Keniaanse shilling", "", ""},
-        "KGS": {"Kirgizische som", "", "⃀"},
-        "KHR": {"Cambodjaanse riel", "", "៛"},
-        "KMF": {"Comorese frank", "", "CF"},
-        "KPW": {"Noord-Koreaanse won", "", "₩"},
-        "KRH": {"Zuid-Koreaanse hwan (1953–1962)", "", ""},
-        "KRO": {"Oude Zuid-Koreaanse won (1945–1953)", "", ""},
-        "KRW": {"Zuid-Koreaanse won", "₩", "₩"},
-        "KWD": {"Koeweitse dinar", "", ""},
-        "KYD": {"Kaaimaneilandse dollar", "", "$"},
-        "KZT": {"Kazachse tenge", "", "₸"},
-        "LAK": {"Laotiaanse kip", "", "₭"},
-        "LBP": {"Libanees pond", "", "L£"},
-        "LKR": {"Sri Lankaanse roepie", "", "Rs"},
-        "LRD": {"Liberiaanse dollar", "", "$"},
-        "LSL": {"Lesothaanse loti", "", ""},
-        "LTL": {"Litouwse litas", "", "Lt"},
-        "LTT": {"Litouwse talonas", "", ""},
-        "LUC": {"Luxemburgse convertibele franc", "", ""},
-        "LUF": {"Luxemburgse frank", "", ""},
-        "LUL": {"Luxemburgse financiële franc", "", ""},
-        "LVL": {"Letse lats", "", "Ls"},
-        "LVR": {"Letse roebel", "", ""},
-        "LYD": {"Libische dinar", "", ""},
-        "MAD": {"Marokkaanse dirham", "", ""},
-        "MAF": {"Marokkaanse franc", "", ""},
-        "MCF": {"Monegaskische frank", "", ""},
-        "MDC": {"Moldavische cupon", "", ""},
-        "MDL": {"Moldavische leu", "", ""},
-        "MGA": {"Malagassische ariary", "", "Ar"},
-        "MGF": {"Malagassische franc", "", ""},
-        "MKD": {"Macedonische denar", "", ""},
-        "MKN": {"Macedonische denar (1992–1993)", "", ""},
-        "MLF": {"Malinese franc", "", ""},
-        "MMK": {"Myanmarese kyat", "", "K"},
-        "MNT": {"Mongoolse tugrik", "", "₮"},
-        "MOP": {"Macause pataca", "", ""},
-        "MRO": {"Mauritaanse ouguiya (1973–2017)", "", ""},
-        "MRU": {"Mauritaanse ouguiya", "", ""},
-        "MTL": {"Maltese lire", "", ""},
-        "MTP": {"Maltees pond", "", ""},
-        "MUR": {"Mauritiaanse roepie", "", "Rs"},
-        "MVP": {"Maldivische roepie", "", ""},
-        "MVR": {"Maldivische rufiyaa", "", ""},
-        "MWK": {"Malawische kwacha", "", ""},
-        "MXN": {"Mexicaanse peso", "MX$", "$"},
-        "MXP": {"Mexicaanse zilveren peso (1861–1992)", "", ""},
-        "MXV": {"Mexicaanse unidad de inversion (UDI)", "", ""},
-        "MYR": {"Maleisische ringgit", "", "RM"},
-        "MZE": {"Mozambikaanse escudo", "", ""},
-        "MZM": {"Oude Mozambikaanse metical", "", ""},
-        "MZN": {"Mozambikaanse metical", "", ""},
-        "NAD": {"Namibische dollar", "", "$"},
-        "NGN": {"Nigeriaanse naira", "", "₦"},
-        "NIC": {"Nicaraguaanse córdoba (1988–1991)", "", ""},
-        "NIO": {"Nicaraguaanse córdoba", "", "C$"},
-        "NLG": {"Nederlandse gulden", "", ""},
-        "NOK": {"Noorse kroon", "", "kr"},
-        "NPR": {"Nepalese roepie", "", "Rs"},
-        "NZD": {"Nieuw-Zeelandse dollar", "NZ$", "$"},
-        "OMR": {"Omaanse rial", "", ""},
-        "PAB": {"Panamese balboa", "", ""},
-        "PEI": {"Peruaanse inti", "", ""},
-        "PEN": {"Peruaanse sol", "", ""},
-        "PES": {"Peruaanse sol (1863–1965)", "", ""},
-        "PGK": {"Papoea-Nieuw-Guinese kina", "", ""},
-        "PHP": {"Filipijnse peso", "PHP", "₱"},
-        "PKR": {"Pakistaanse roepie", "", "Rs"},
-        "PLN": {"Poolse zloty", "", "zł"},
-        "PLZ": {"Poolse zloty (1950–1995)", "", ""},
-        "PTE": {"Portugese escudo", "", ""},
-        "PYG": {"Paraguayaanse guarani", "", "₲"},
-        "QAR": {"Qatarese rial", "", ""},
-        "RHD": {"Rhodesische dollar", "", ""},
-        "ROL": {"Oude Roemeense leu", "", ""},
-        "RON": {"Roemeense leu", "", "lei"},
-        "RSD": {"Servische dinar", "", ""},
-        "RUB": {"Russische roebel", "", "₽"},
-        "RUR": {"Russische roebel (1991–1998)", "", "р."},
-        "RWF": {"Rwandese frank", "", "RF"},
-        "SAR": {"Saoedi-Arabische riyal", "⃁", ""},
-        "SBD": {"Salomon-dollar", "SI$", "$"},
-        "SCR": {"Seychelse roepie", "", ""},
-        "SDD": {"Soedanese dinar", "", ""},
-        "SDG": {"Soedanees pond", "", ""},
-        "SDP": {"Soedanees pond (1957–1998)", "", ""},
-        "SEK": {"Zweedse kroon", "", "kr"},
-        "SGD": {"Singaporese dollar", "", "$"},
-        "SHP": {"Sint-Heleens pond", "", "£"},
-        "SIT": {"Sloveense tolar", "", ""},
-        "SKK": {"Slowaakse koruna", "", ""},
-        "SLE": {"Sierra Leoonse leone", "", ""},
-        "SLL": {"Sierra Leoonse leone (1964–2022)", "", ""},
-        "SOS": {"Somalische shilling", "", ""},
-        "SRD": {"Surinaamse dollar", "", "$"},
-        "SRG": {"Surinaamse gulden", "", ""},
-        "SSP": {"Zuid-Soedanees pond", "", "£"},
-        "STD": {"Santomese dobra (1977–2017)", "", ""},
-        "STN": {"Santomese dobra", "", "Db"},
-        "SUR": {"Sovjet-roebel", "", ""},
-        "SVC": {"Salvadoraanse colón", "", ""},
-        "SYP": {"Syrisch pond", "", "£"},
-        "SZL": {"Swazische lilangeni", "", ""},
-        "THB": {"Thaise baht", "฿", "฿"},
-        "TJR": {"Tadzjikistaanse roebel", "", ""},
-        "TJS": {"Tadzjiekse somoni", "", ""},
-        "TMM": {"Turkmeense manat (1993–2009)", "", ""},
-        "TMT": {"Turkmeense manat", "", ""},
-        "TND": {"Tunesische dinar", "", ""},
-        "TOP": {"Tongaanse paʻanga", "", "T$"},
-        "TPE": {"Timorese escudo", "", ""},
-        "TRL": {"Turkse lire", "", ""},
-        "TRY": {"Turkse lira", "TL", "₺"},
-        "TTD": {"Trinidad en Tobago-dollar", "", "$"},
-        "TWD": {"Nieuwe Taiwanese dollar", "NT$", "NT$"},
-        "TZS": {"Tanzaniaanse shilling", "", ""},
-        "UAH": {"Oekraïense hryvnia", "", "₴"},
-        "UAK": {"Oekraïense karbovanetz", "", ""},
-        "UGS": {"Oegandese shilling (1966–1987)", "", ""},
-        "UGX": {"Oegandese shilling", "", ""},
-        "USD": {"Amerikaanse dollar", "US$", "$"},
-        "USN": {"Amerikaanse dollar (volgende dag)", "", ""},
-        "USS": {"Amerikaanse dollar (zelfde dag)", "", ""},
-        "UYI": {"Uruguayaanse peso en geïndexeerde eenheden", "", ""},
-        "UYP": {"Uruguayaanse peso (1975–1993)", "", ""},
-        "UYU": {"Uruguayaanse peso", "", "$"},
-        "UYW": {"Uruguayaanse nominale salarisindexeenheid", "", ""},
-        "UZS": {"Oezbeekse sum", "", ""},
-        "VEB": {"Venezolaanse bolivar (1871–2008)", "", ""},
-        "VED": {"Bolívar Soberano", "", ""},
-        "VEF": {"Venezolaanse bolivar (2008–2018)", "", "Bs"},
-        "VES": {"Venezolaanse bolivar", "", ""},
-        "VND": {"Vietnamese dong", "₫", "₫"},
-        "VNN": {"Vietnamese dong (1978–1985)", "", ""},
-        "VUV": {"Vanuatuaanse vatu", "", ""},
-        "WST": {"Samoaanse tala", "", ""},
-        "XAF": {"CFA-frank", "FCFA", ""},
-        "XAG": {"Zilver", "", ""},
-        "XAU": {"Goud", "", ""},
-        "XBA": {"Europese samengestelde eenheid", "", ""},
-        "XBB": {"Europese monetaire eenheid", "", ""},
-        "XBC": {"Europese rekeneenheid (XBC)", "", ""},
-        "XBD": {"Europese rekeneenheid (XBD)", "", ""},
-        "XCD": {"Oost-Caribische dollar", "EC$", "$"},
-        "XCG": {"Caribische gulden", "Cg.", "Cg"},
-        "XDR": {"Special Drawing Rights", "", ""},
-        "XEU": {"European Currency Unit", "", ""},
-        "XFO": {"Franse gouden franc", "", ""},
-        "XFU": {"Franse UIC-franc", "", ""},
-        "XOF": {"CFA-franc BCEAO", "F CFA", ""},
-        "XPD": {"Palladium", "", ""},
-        "XPF": {"CFP-frank", "XPF", ""},
-        "XPT": {"Platina", "", ""},
-        "XRE": {"RINET-fondsen", "", ""},
-        "XSU": {"Sucre", "", ""},
-        "XTS": {"Valutacode voor testdoeleinden", "", ""},
-        "XUA": {"ADB-rekeneenheid", "", ""},
-        "XXX": {"onbekende munteenheid", "XXX", ""},
-        "YDD": {"Jemenitische dinar", "", ""},
-        "YER": {"Jemenitische rial", "", ""},
-        "YUD": {"Joegoslavische harde dinar", "", ""},
-        "YUM": {"Joegoslavische noviy-dinar", "", ""},
-        "YUN": {"Joegoslavische convertibele dinar", "", ""},
-        "YUR": {"Joegoslavische hervormde dinar (1992–1993)", "", ""},
-        "ZAL": {"Zuid-Afrikaanse rand (financieel)", "", ""},
-        "ZAR": {"Zuid-Afrikaanse rand", "", "R"},
-        "ZMK": {"Zambiaanse kwacha (1968–2012)", "", ""},
-        "ZMW": {"Zambiaanse kwacha", "", "ZK"},
-        "ZRN": {"Zaïrese nieuwe zaïre", "", ""},
-        "ZRZ": {"Zaïrese zaïre", "", ""},
-        "ZWD": {"Zimbabwaanse dollar", "", ""},
-        "ZWG": {"Zimbabwe Gold", "ZiG", ""},
-        "ZWL": {"Zimbabwaanse dollar (2009)", "", ""},
-        "ZWR": {"Zimbabwaanse dollar (2008)", "", ""},
+        "ADP": {"Andorrese peseta", "", "", Count{"", ""}, 0, 0},
+        "AED": {"Verenigde Arabische Emiraten-dirham", "", "", Count{"", ""}, 0, 0},
+        "AFA": {"Afghani (1927–2002)", "", "", Count{"", ""}, 0, 0},
+        "AFN": {"Afghaanse afghani", "", "؋", Count{"", ""}, 0, 0},
+        "ALK": {"Albanese lek (1946–1965)", "", "", Count{"", ""}, 0, 0},
+        "ALL": {"Albanese lek", "", "", Count{"", ""}, 0, 0},
+        "AMD": {"Armeense dram", "", "֏", Count{"", ""}, 0, 0},
+        "ANG": {"Nederlands-Antilliaanse gulden", "", "", Count{"", ""}, 0, 0},
+        "AOA": {"Angolese kwanza", "", "Kz", Count{"", ""}, 0, 0},
+        "AOK": {"Angolese kwanza (1977–1990)", "", "", Count{"", ""}, 0, 0},
+        "AON": {"Angolese nieuwe kwanza (1990–2000)", "", "", Count{"", ""}, 0, 0},
+        "AOR": {"Angolese kwanza reajustado (1995–1999)", "", "", Count{"", ""}, 0, 0},
+        "ARA": {"Argentijnse austral", "", "", Count{"", ""}, 0, 0},
+        "ARL": {"Argentijnse peso ley (1970–1983)", "", "", Count{"", ""}, 0, 0},
+        "ARM": {"Argentijnse peso (1881–1970)", "", "", Count{"", ""}, 0, 0},
+        "ARP": {"Argentijnse peso (1983–1985)", "", "", Count{"", ""}, 0, 0},
+        "ARS": {"Argentijnse peso", "", "$", Count{"", ""}, 0, 0},
+        "ATS": {"Oostenrijkse schilling", "", "", Count{"", ""}, 0, 0},
+        "AUD": {"Australische dollar", "AU$", "$", Count{"Australische dollar", "Australische dollar"}, 0, 0},
+        "AWG": {"Arubaanse gulden", "", "", Count{"", ""}, 0, 0},
+        "AZM": {"Azerbeidzjaanse manat (1993–2006)", "", "", Count{"", ""}, 0, 0},
+        "AZN": {"Azerbeidzjaanse manat", "", "₼", Count{"", ""}, 0, 0},
+        "BAD": {"Bosnische dinar", "", "", Count{"", ""}, 0, 0},
+        "BAM": {"Bosnische convertibele mark", "", "KM", Count{"", ""}, 0, 0},
+        "BAN": {"Nieuwe Bosnische dinar (1994–1997)", "", "", Count{"", ""}, 0, 0},
+        "BBD": {"Barbadaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "BDT": {"Bengalese taka", "", "৳", Count{"", ""}, 0, 0},
+        "BEC": {"Belgische frank (convertibel)", "", "", Count{"", ""}, 0, 0},
+        "BEF": {"Belgische frank", "", "", Count{"", ""}, 0, 0},
+        "BEL": {"Belgische frank (financieel)", "", "", Count{"", ""}, 0, 0},
+        "BGL": {"Bulgaarse harde lev", "", "", Count{"", ""}, 0, 0},
+        "BGM": {"Bulgaarse socialistische lev", "", "", Count{"", ""}, 0, 0},
+        "BGN": {"Bulgaarse lev", "", "", Count{"", ""}, 0, 0},
+        "BGO": {"Bulgaarse lev (1879–1952)", "", "", Count{"", ""}, 0, 0},
+        "BHD": {"Bahreinse dinar", "", "", Count{"", ""}, 0, 0},
+        "BIF": {"Burundese frank", "", "", Count{"", ""}, 0, 0},
+        "BMD": {"Bermuda-dollar", "", "$", Count{"", ""}, 0, 0},
+        "BND": {"Bruneise dollar", "", "$", Count{"", ""}, 0, 0},
+        "BOB": {"Boliviaanse boliviano", "", "Bs", Count{"", ""}, 0, 0},
+        "BOL": {"Boliviaanse boliviano (1863–1963)", "", "", Count{"", ""}, 0, 0},
+        "BOP": {"Boliviaanse peso", "", "", Count{"", ""}, 0, 0},
+        "BOV": {"Boliviaanse mvdol", "", "", Count{"", ""}, 0, 0},
+        "BRB": {"Braziliaanse cruzeiro novo (1967–1986)", "", "", Count{"", ""}, 0, 0},
+        "BRC": {"Braziliaanse cruzado", "", "", Count{"", ""}, 0, 0},
+        "BRE": {"Braziliaanse cruzeiro (1990–1993)", "", "", Count{"", ""}, 0, 0},
+        "BRL": {"Braziliaanse real", "R$", "R$", Count{"", ""}, 0, 0},
+        "BRN": {"Braziliaanse nieuwe cruzado (1989–1990)", "", "", Count{"", ""}, 0, 0},
+        "BRR": {"Braziliaanse cruzeiro", "", "", Count{"", ""}, 0, 0},
+        "BRZ": {"Braziliaanse cruzeiro (1942–1967)", "", "", Count{"", ""}, 0, 0},
+        "BSD": {"Bahamaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "BTN": {"Bhutaanse ngultrum", "", "", Count{"", ""}, 0, 0},
+        "BUK": {"Birmese kyat", "", "", Count{"", ""}, 0, 0},
+        "BWP": {"Botswaanse pula", "", "P", Count{"", ""}, 0, 0},
+        "BYB": {"Wit-Russische nieuwe roebel (1994–1999)", "", "", Count{"", ""}, 0, 0},
+        "BYN": {"Belarussische roebel", "", "р.", Count{"", ""}, 0, 0},
+        "BYR": {"Wit-Russische roebel (2000–2016)", "", "", Count{"", ""}, 0, 0},
+        "BZD": {"Belizaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "CAD": {"Canadese dollar", "C$", "$", Count{"Canadese dollar", "Canadese dollar"}, 0, 0},
+        "CDF": {"Congolese frank", "", "", Count{"", ""}, 0, 0},
+        "CHE": {"WIR euro", "", "", Count{"", ""}, 0, 0},
+        "CHF": {"Zwitserse frank", "", "", Count{"Zwitserse frank", "Zwitserse frank"}, 0, 0},
+        "CHW": {"WIR franc", "", "", Count{"", ""}, 0, 0},
+        "CLE": {"Chileense escudo", "", "", Count{"", ""}, 0, 0},
+        "CLF": {"Chileense unidades de fomento", "", "", Count{"", ""}, 0, 0},
+        "CLP": {"Chileense peso", "", "$", Count{"", ""}, 0, 0},
+        "CNH": {"Chinese yuan (offshore)", "", "", Count{"", ""}, 0, 0},
+        "CNX": {"dollar van de Chinese Volksbank", "", "", Count{"", ""}, 0, 0},
+        "CNY": {"Chinese yuan", "CN¥", "¥", Count{"", ""}, 0, 0},
+        "COP": {"Colombiaanse peso", "", "$", Count{"", ""}, 0, 0},
+        "COU": {"Unidad de Valor Real", "", "", Count{"", ""}, 0, 0},
+        "CRC": {"Costa Ricaanse colon", "", "₡", Count{"", ""}, 0, 0},
+        "CSD": {"Oude Servische dinar", "", "", Count{"", ""}, 0, 0},
+        "CSK": {"Tsjechoslowaakse harde koruna", "", "", Count{"", ""}, 0, 0},
+        "CUC": {"Cubaanse convertibele peso", "", "$", Count{"", ""}, 0, 0},
+        "CUP": {"Cubaanse peso", "", "$", Count{"", ""}, 0, 0},
+        "CVE": {"Kaapverdische escudo", "", "", Count{"Kaapverdische escudo", "Kaapverdische escudo"}, 0, 0},
+        "CYP": {"Cyprisch pond", "", "", Count{"", ""}, 0, 0},
+        "CZK": {"Tsjechische kroon", "", "Kč", Count{"", ""}, 0, 0},
+        "DDM": {"Oost-Duitse ostmark", "", "", Count{"", ""}, 0, 0},
+        "DEM": {"Duitse mark", "", "", Count{"", ""}, 0, 0},
+        "DJF": {"Djiboutiaanse frank", "", "", Count{"", ""}, 0, 0},
+        "DKK": {"Deense kroon", "", "kr", Count{"", ""}, 0, 0},
+        "DOP": {"Dominicaanse peso", "", "$", Count{"", ""}, 0, 0},
+        "DZD": {"Algerijnse dinar", "", "", Count{"", ""}, 0, 0},
+        "ECS": {"Ecuadoraanse sucre", "", "", Count{"", ""}, 0, 0},
+        "ECV": {"Ecuadoraanse unidad de valor constante (UVC)", "", "", Count{"", ""}, 0, 0},
+        "EEK": {"Estlandse kroon", "", "", Count{"", ""}, 0, 0},
+        "EGP": {"Egyptisch pond", "", "E£", Count{"", ""}, 0, 0},
+        "ERN": {"Eritrese nakfa", "", "", Count{"", ""}, 0, 0},
+        "ESA": {"Spaanse peseta (account A)", "", "", Count{"", ""}, 0, 0},
+        "ESB": {"Spaanse peseta (convertibele account)", "", "", Count{"", ""}, 0, 0},
+        "ESP": {"Spaanse peseta", "", "₧", Count{"", ""}, 0, 0},
+        "ETB": {"Ethiopische birr", "", "", Count{"", ""}, 0, 0},
+        "EUR": {"Euro", "€", "€", Count{"euro", "euro"}, 0, 0},
+        "FIM": {"Finse markka", "", "", Count{"", ""}, 0, 0},
+        "FJD": {"Fiji-dollar", "FJ$", "$", Count{"", ""}, 0, 0},
+        "FKP": {"Falklandeilands pond", "", "£", Count{"", ""}, 0, 0},
+        "FRF": {"Franse franc", "", "", Count{"", ""}, 0, 0},
+        "GBP": {"Britse pond", "£", "£", Count{"Brits pond", "Brits pond"}, 0, 0},
+        "GEK": {"Georgische kupon larit", "", "", Count{"", ""}, 0, 0},
+        "GEL": {"Georgische lari", "ლ", "₾", Count{"", ""}, 0, 0},
+        "GHC": {"Ghanese cedi (1979–2007)", "", "", Count{"", ""}, 0, 0},
+        "GHS": {"Ghanese cedi", "", "GH₵", Count{"", ""}, 0, 0},
+        "GIP": {"Gibraltarees pond", "", "£", Count{"", ""}, 0, 0},
+        "GMD": {"Gambiaanse dalasi", "", "", Count{"", ""}, 0, 0},
+        "GNF": {"Guinese frank", "", "FG", Count{"", ""}, 0, 0},
+        "GNS": {"Guinese syli", "", "", Count{"", ""}, 0, 0},
+        "GQE": {"Equatoriaal-Guinese ekwele guineana", "", "", Count{"", ""}, 0, 0},
+        "GRD": {"Griekse drachme", "", "", Count{"", ""}, 0, 0},
+        "GTQ": {"Guatemalteekse quetzal", "", "Q", Count{"", ""}, 0, 0},
+        "GWE": {"Portugees-Guinese escudo", "", "", Count{"", ""}, 0, 0},
+        "GWP": {"Guinee-Bissause peso", "", "", Count{"", ""}, 0, 0},
+        "GYD": {"Guyaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "HKD": {"Hongkongse dollar", "HK$", "$", Count{"", ""}, 0, 0},
+        "HNL": {"Hondurese lempira", "", "L", Count{"", ""}, 0, 0},
+        "HRD": {"Kroatische dinar", "", "", Count{"", ""}, 0, 0},
+        "HRK": {"Kroatische kuna", "", "kn", Count{"", ""}, 0, 0},
+        "HTG": {"Haïtiaanse gourde", "", "", Count{"", ""}, 0, 0},
+        "HUF": {"Hongaarse forint", "", "Ft", Count{"", ""}, 0, 0},
+        "IDR": {"Indonesische roepia", "", "Rp", Count{"", ""}, 0, 0},
+        "IEP": {"Iers pond", "", "", Count{"", ""}, 0, 0},
+        "ILP": {"Israëlisch pond", "", "", Count{"", ""}, 0, 0},
+        "ILR": {"Israëlische sjekel (1980–1985)", "", "", Count{"", ""}, 0, 0},
+        "ILS": {"Israëlische nieuwe shekel", "₪", "₪", Count{"", ""}, 0, 0},
+        "INR": {"Indiase roepie", "₹", "₹", Count{"", ""}, 0, 0},
+        "IQD": {"Iraakse dinar", "", "", Count{"", ""}, 0, 0},
+        "IRR": {"Iraanse rial", "", "", Count{"", ""}, 0, 0},
+        "ISJ": {"IJslandse kroon (1918–1981)", "", "", Count{"", ""}, 0, 0},
+        "ISK": {"IJslandse kroon", "", "kr", Count{"", ""}, 0, 0},
+        "ITL": {"Italiaanse lire", "", "", Count{"", ""}, 0, 0},
+        "JMD": {"Jamaicaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "JOD": {"Jordaanse dinar", "", "", Count{"", ""}, 0, 0},
+        "JPY": {"Japanse yen", "JP¥", "¥", Count{"Japanse yen", "Japanse yen"}, 0, 0},
+        "KES": {"Keniaanse shilling", "", "", Count{"", ""}, 0, 0},
+        "KGS": {"Kirgizische som", "", "⃀", Count{"", ""}, 0, 0},
+        "KHR": {"Cambodjaanse riel", "", "៛", Count{"", ""}, 0, 0},
+        "KMF": {"Comorese frank", "", "CF", Count{"", ""}, 0, 0},
+        "KPW": {"Noord-Koreaanse won", "", "₩", Count{"", ""}, 0, 0},
+        "KRH": {"Zuid-Koreaanse hwan (1953–1962)", "", "", Count{"", ""}, 0, 0},
+        "KRO": {"Oude Zuid-Koreaanse won (1945–1953)", "", "", Count{"", ""}, 0, 0},
+        "KRW": {"Zuid-Koreaanse won", "₩", "₩", Count{"", ""}, 0, 0},
+        "KWD": {"Koeweitse dinar", "", "", Count{"", ""}, 0, 0},
+        "KYD": {"Kaaimaneilandse dollar", "", "$", Count{"", ""}, 0, 0},
+        "KZT": {"Kazachse tenge", "", "₸", Count{"", ""}, 0, 0},
+        "LAK": {"Laotiaanse kip", "", "₭", Count{"", ""}, 0, 0},
+        "LBP": {"Libanees pond", "", "L£", Count{"", ""}, 0, 0},
+        "LKR": {"Sri Lankaanse roepie", "", "Rs", Count{"", ""}, 0, 0},
+        "LRD": {"Liberiaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "LSL": {"Lesothaanse loti", "", "", Count{"", ""}, 0, 0},
+        "LTL": {"Litouwse litas", "", "Lt", Count{"", ""}, 0, 0},
+        "LTT": {"Litouwse talonas", "", "", Count{"", ""}, 0, 0},
+        "LUC": {"Luxemburgse convertibele franc", "", "", Count{"", ""}, 0, 0},
+        "LUF": {"Luxemburgse frank", "", "", Count{"", ""}, 0, 0},
+        "LUL": {"Luxemburgse financiële franc", "", "", Count{"", ""}, 0, 0},
+        "LVL": {"Letse lats", "", "Ls", Count{"", ""}, 0, 0},
+        "LVR": {"Letse roebel", "", "", Count{"", ""}, 0, 0},
+        "LYD": {"Libische dinar", "", "", Count{"", ""}, 0, 0},
+        "MAD": {"Marokkaanse dirham", "", "", Count{"", ""}, 0, 0},
+        "MAF": {"Marokkaanse franc", "", "", Count{"", ""}, 0, 0},
+        "MCF": {"Monegaskische frank", "", "", Count{"", ""}, 0, 0},
+        "MDC": {"Moldavische cupon", "", "", Count{"", ""}, 0, 0},
+        "MDL": {"Moldavische leu", "", "", Count{"", ""}, 0, 0},
+        "MGA": {"Malagassische ariary", "", "Ar", Count{"", ""}, 0, 0},
+        "MGF": {"Malagassische franc", "", "", Count{"", ""}, 0, 0},
+        "MKD": {"Macedonische denar", "", "", Count{"", ""}, 0, 0},
+        "MKN": {"Macedonische denar (1992–1993)", "", "", Count{"", ""}, 0, 0},
+        "MLF": {"Malinese franc", "", "", Count{"", ""}, 0, 0},
+        "MMK": {"Myanmarese kyat", "", "K", Count{"", ""}, 0, 0},
+        "MNT": {"Mongoolse tugrik", "", "₮", Count{"", ""}, 0, 0},
+        "MOP": {"Macause pataca", "", "", Count{"", ""}, 0, 0},
+        "MRO": {"Mauritaanse ouguiya (1973–2017)", "", "", Count{"", ""}, 0, 0},
+        "MRU": {"Mauritaanse ouguiya", "", "", Count{"", ""}, 0, 0},
+        "MTL": {"Maltese lire", "", "", Count{"", ""}, 0, 0},
+        "MTP": {"Maltees pond", "", "", Count{"", ""}, 0, 0},
+        "MUR": {"Mauritiaanse roepie", "", "Rs", Count{"", ""}, 0, 0},
+        "MVP": {"Maldivische roepie", "", "", Count{"", ""}, 0, 0},
+        "MVR": {"Maldivische rufiyaa", "", "", Count{"", ""}, 0, 0},
+        "MWK": {"Malawische kwacha", "", "", Count{"", ""}, 0, 0},
+        "MXN": {"Mexicaanse peso", "MX$", "$", Count{"", ""}, 0, 0},
+        "MXP": {"Mexicaanse zilveren peso (1861–1992)", "", "", Count{"", ""}, 0, 0},
+        "MXV": {"Mexicaanse unidad de inversion (UDI)", "", "", Count{"", ""}, 0, 0},
+        "MYR": {"Maleisische ringgit", "", "RM", Count{"", ""}, 0, 0},
+        "MZE": {"Mozambikaanse escudo", "", "", Count{"", ""}, 0, 0},
+        "MZM": {"Oude Mozambikaanse metical", "", "", Count{"", ""}, 0, 0},
+        "MZN": {"Mozambikaanse metical", "", "", Count{"", ""}, 0, 0},
+        "NAD": {"Namibische dollar", "", "$", Count{"", ""}, 0, 0},
+        "NGN": {"Nigeriaanse naira", "", "₦", Count{"", ""}, 0, 0},
+        "NIC": {"Nicaraguaanse córdoba (1988–1991)", "", "", Count{"", ""}, 0, 0},
+        "NIO": {"Nicaraguaanse córdoba", "", "C$", Count{"", ""}, 0, 0},
+        "NLG": {"Nederlandse gulden", "", "", Count{"Nederlandse gulden", "Nederlandse gulden"}, 0, 0},
+        "NOK": {"Noorse kroon", "", "kr", Count{"", ""}, 0, 0},
+        "NPR": {"Nepalese roepie", "", "Rs", Count{"", ""}, 0, 0},
+        "NZD": {"Nieuw-Zeelandse dollar", "NZ$", "$", Count{"", ""}, 0, 0},
+        "OMR": {"Omaanse rial", "", "", Count{"", ""}, 0, 0},
+        "PAB": {"Panamese balboa", "", "", Count{"", ""}, 0, 0},
+        "PEI": {"Peruaanse inti", "", "", Count{"", ""}, 0, 0},
+        "PEN": {"Peruaanse sol", "", "", Count{"", ""}, 0, 0},
+        "PES": {"Peruaanse sol (1863–1965)", "", "", Count{"", ""}, 0, 0},
+        "PGK": {"Papoea-Nieuw-Guinese kina", "", "", Count{"", ""}, 0, 0},
+        "PHP": {"Filipijnse peso", "PHP", "₱", Count{"", ""}, 0, 0},
+        "PKR": {"Pakistaanse roepie", "", "Rs", Count{"", ""}, 0, 0},
+        "PLN": {"Poolse zloty", "", "zł", Count{"", ""}, 0, 0},
+        "PLZ": {"Poolse zloty (1950–1995)", "", "", Count{"", ""}, 0, 0},
+        "PTE": {"Portugese escudo", "", "", Count{"Portugese escudo", "Portugese escudo"}, 0, 0},
+        "PYG": {"Paraguayaanse guarani", "", "₲", Count{"", ""}, 0, 0},
+        "QAR": {"Qatarese rial", "", "", Count{"", ""}, 0, 0},
+        "RHD": {"Rhodesische dollar", "", "", Count{"", ""}, 0, 0},
+        "ROL": {"Oude Roemeense leu", "", "", Count{"", ""}, 0, 0},
+        "RON": {"Roemeense leu", "", "lei", Count{"", ""}, 0, 0},
+        "RSD": {"Servische dinar", "", "", Count{"", ""}, 0, 0},
+        "RUB": {"Russische roebel", "", "₽", Count{"", ""}, 0, 0},
+        "RUR": {"Russische roebel (1991–1998)", "", "р.", Count{"", ""}, 0, 0},
+        "RWF": {"Rwandese frank", "", "RF", Count{"", ""}, 0, 0},
+        "SAR": {"Saoedi-Arabische riyal", "⃁", "", Count{"", ""}, 0, 0},
+        "SBD": {"Salomon-dollar", "SI$", "$", Count{"", ""}, 0, 0},
+        "SCR": {"Seychelse roepie", "", "", Count{"", ""}, 0, 0},
+        "SDD": {"Soedanese dinar", "", "", Count{"", ""}, 0, 0},
+        "SDG": {"Soedanees pond", "", "", Count{"", ""}, 0, 0},
+        "SDP": {"Soedanees pond (1957–1998)", "", "", Count{"", ""}, 0, 0},
+        "SEK": {"Zweedse kroon", "", "kr", Count{"", ""}, 0, 0},
+        "SGD": {"Singaporese dollar", "", "$", Count{"", ""}, 0, 0},
+        "SHP": {"Sint-Heleens pond", "", "£", Count{"", ""}, 0, 0},
+        "SIT": {"Sloveense tolar", "", "", Count{"", ""}, 0, 0},
+        "SKK": {"Slowaakse koruna", "", "", Count{"", ""}, 0, 0},
+        "SLE": {"Sierra Leoonse leone", "", "", Count{"", ""}, 0, 0},
+        "SLL": {"Sierra Leoonse leone (1964–2022)", "", "", Count{"", ""}, 0, 0},
+        "SOS": {"Somalische shilling", "", "", Count{"", ""}, 0, 0},
+        "SRD": {"Surinaamse dollar", "", "$", Count{"", ""}, 0, 0},
+        "SRG": {"Surinaamse gulden", "", "", Count{"", ""}, 0, 0},
+        "SSP": {"Zuid-Soedanees pond", "", "£", Count{"", ""}, 0, 0},
+        "STD": {"Santomese dobra (1977–2017)", "", "", Count{"", ""}, 0, 0},
+        "STN": {"Santomese dobra", "", "Db", Count{"", ""}, 0, 0},
+        "SUR": {"Sovjet-roebel", "", "", Count{"", ""}, 0, 0},
+        "SVC": {"Salvadoraanse colón", "", "", Count{"", ""}, 0, 0},
+        "SYP": {"Syrisch pond", "", "£", Count{"", ""}, 0, 0},
+        "SZL": {"Swazische lilangeni", "", "", Count{"", ""}, 0, 0},
+        "THB": {"Thaise baht", "฿", "฿", Count{"", ""}, 0, 0},
+        "TJR": {"Tadzjikistaanse roebel", "", "", Count{"", ""}, 0, 0},
+        "TJS": {"Tadzjiekse somoni", "", "", Count{"", ""}, 0, 0},
+        "TMM": {"Turkmeense manat (1993–2009)", "", "", Count{"", ""}, 0, 0},
+        "TMT": {"Turkmeense manat", "", "", Count{"", ""}, 0, 0},
+        "TND": {"Tunesische dinar", "", "", Count{"", ""}, 0, 0},
+        "TOP": {"Tongaanse paʻanga", "", "T$", Count{"", ""}, 0, 0},
+        "TPE": {"Timorese escudo", "", "", Count{"", ""}, 0, 0},
+        "TRL": {"Turkse lire", "", "", Count{"", ""}, 0, 0},
+        "TRY": {"Turkse lira", "TL", "₺", Count{"", ""}, 0, 0},
+        "TTD": {"Trinidad en Tobago-dollar", "", "$", Count{"", ""}, 0, 0},
+        "TWD": {"Nieuwe Taiwanese dollar", "NT$", "NT$", Count{"", ""}, 0, 0},
+        "TZS": {"Tanzaniaanse shilling", "", "", Count{"", ""}, 0, 0},
+        "UAH": {"Oekraïense hryvnia", "", "₴", Count{"", ""}, 0, 0},
+        "UAK": {"Oekraïense karbovanetz", "", "", Count{"", ""}, 0, 0},
+        "UGS": {"Oegandese shilling (1966–1987)", "", "", Count{"", ""}, 0, 0},
+        "UGX": {"Oegandese shilling", "", "", Count{"", ""}, 0, 0},
+        "USD": {"Amerikaanse dollar", "US$", "$", Count{"Amerikaanse dollar", "Amerikaanse dollar"}, 0, 0},
+        "USN": {"Amerikaanse dollar (volgende dag)", "", "", Count{"", ""}, 0, 0},
+        "USS": {"Amerikaanse dollar (zelfde dag)", "", "", Count{"", ""}, 0, 0},
+        "UYI": {"Uruguayaanse peso en geïndexeerde eenheden", "", "", Count{"", ""}, 0, 0},
+        "UYP": {"Uruguayaanse peso (1975–1993)", "", "", Count{"", ""}, 0, 0},
+        "UYU": {"Uruguayaanse peso", "", "$", Count{"", ""}, 0, 0},
+        "UYW": {"Uruguayaanse nominale salarisindexeenheid", "", "", Count{"", ""}, 0, 0},
+        "UZS": {"Oezbeekse sum", "", "", Count{"", ""}, 0, 0},
+        "VEB": {"Venezolaanse bolivar (1871–2008)", "", "", Count{"", ""}, 0, 0},
+        "VED": {"Bolívar Soberano", "", "", Count{"", ""}, 0, 0},
+        "VEF": {"Venezolaanse bolivar (2008–2018)", "", "Bs", Count{"", ""}, 0, 0},
+        "VES": {"Venezolaanse bolivar", "", "", Count{"", ""}, 0, 0},
+        "VND": {"Vietnamese dong", "₫", "₫", Count{"", ""}, 0, 0},
+        "VNN": {"Vietnamese dong (1978–1985)", "", "", Count{"", ""}, 0, 0},
+        "VUV": {"Vanuatuaanse vatu", "", "", Count{"", ""}, 0, 0},
+        "WST": {"Samoaanse tala", "", "", Count{"", ""}, 0, 0},
+        "XAF": {"CFA-frank", "FCFA", "", Count{"", ""}, 0, 0},
+        "XAG": {"Zilver", "", "", Count{"", ""}, 0, 0},
+        "XAU": {"Goud", "", "", Count{"", ""}, 0, 0},
+        "XBA": {"Europese samengestelde eenheid", "", "", Count{"", ""}, 0, 0},
+        "XBB": {"Europese monetaire eenheid", "", "", Count{"", ""}, 0, 0},
+        "XBC": {"Europese rekeneenheid (XBC)", "", "", Count{"", ""}, 0, 0},
+        "XBD": {"Europese rekeneenheid (XBD)", "", "", Count{"", ""}, 0, 0},
+        "XCD": {"Oost-Caribische dollar", "EC$", "$", Count{"", ""}, 0, 0},
+        "XCG": {"Caribische gulden", "Cg.", "Cg", Count{"", ""}, 0, 0},
+        "XDR": {"Special Drawing Rights", "", "", Count{"", ""}, 0, 0},
+        "XEU": {"European Currency Unit", "", "", Count{"", ""}, 0, 0},
+        "XFO": {"Franse gouden franc", "", "", Count{"", ""}, 0, 0},
+        "XFU": {"Franse UIC-franc", "", "", Count{"", ""}, 0, 0},
+        "XOF": {"CFA-franc BCEAO", "F CFA", "", Count{"", ""}, 0, 0},
+        "XPD": {"Palladium", "", "", Count{"", ""}, 0, 0},
+        "XPF": {"CFP-frank", "XPF", "", Count{"", ""}, 0, 0},
+        "XPT": {"Platina", "", "", Count{"", ""}, 0, 0},
+        "XRE": {"RINET-fondsen", "", "", Count{"", ""}, 0, 0},
+        "XSU": {"Sucre", "", "", Count{"", ""}, 0, 0},
+        "XTS": {"Valutacode voor testdoeleinden", "", "", Count{"", ""}, 0, 0},
+        "XUA": {"ADB-rekeneenheid", "", "", Count{"", ""}, 0, 0},
+        "XXX": {"onbekende munteenheid", "XXX", "", Count{"", ""}, 0, 0},
+        "YDD": {"Jemenitische dinar", "", "", Count{"", ""}, 0, 0},
+        "YER": {"Jemenitische rial", "", "", Count{"", ""}, 0, 0},
+        "YUD": {"Joegoslavische harde dinar", "", "", Count{"", ""}, 0, 0},
+        "YUM": {"Joegoslavische noviy-dinar", "", "", Count{"", ""}, 0, 0},
+        "YUN": {"Joegoslavische convertibele dinar", "", "", Count{"", ""}, 0, 0},
+        "YUR": {"Joegoslavische hervormde dinar (1992–1993)", "", "", Count{"", ""}, 0, 0},
+        "ZAL": {"Zuid-Afrikaanse rand (financieel)", "", "", Count{"", ""}, 0, 0},
+        "ZAR": {"Zuid-Afrikaanse rand", "", "R", Count{"", ""}, 0, 0},
+        "ZMK": {"Zambiaanse kwacha (1968–2012)", "", "", Count{"", ""}, 0, 0},
+        "ZMW": {"Zambiaanse kwacha", "", "ZK", Count{"", ""}, 0, 0},
+        "ZRN": {"Zaïrese nieuwe zaïre", "", "", Count{"", ""}, 0, 0},
+        "ZRZ": {"Zaïrese zaïre", "", "", Count{"", ""}, 0, 0},
+        "ZWD": {"Zimbabwaanse dollar", "", "", Count{"", ""}, 0, 0},
+        "ZWG": {"Zimbabwe Gold", "ZiG", "", Count{"", ""}, 0, 0},
+        "ZWL": {"Zimbabwaanse dollar (2009)", "", "", Count{"", ""}, 0, 0},
+        "ZWR": {"Zimbabwaanse dollar (2008)", "", "", Count{"", ""}, 0, 0},
     }, map[string]Unit{
         "duration-century": {Count{"", ""}, Count{"{0} eeuw", "{0} eeuwen"}, Count{"", ""}},
         "duration-day": {Count{"", ""}, Count{"{0} dag", "{0} dagen"}, Count{"{0} d", "{0} d"}},
@@ -5733,8 +6862,265 @@ var locales = map[string]Locale{
         "ZM": "Zambia",
         "ZW": "Zimbabwe",
         "ZZ": "onbekend gebied",
-    }},
-    "nl_NL": {"#,##0.###", CurrencyFormat{"¤ #,##0.00;¤ -#,##0.00", "#,##0.00", "¤ #,##0.00;¤ -#,##0.00"}, CalendarFormat{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd-MM-y"}, CalendarFormat{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
+    }, ListPattern{"{0}, {1}", "{0}, {1}", "{0} en {1}", "{0} en {1}"}, CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}}, "#,##0%", 37, map[string]RelativeTime{
+        "day": {map[string]string{
+            "-1": "gisteren",
+            "-2": "eergisteren",
+            "0": "vandaag",
+            "1": "morgen",
+            "2": "overmorgen",
+        }, Count{"over {0} dag", "over {0} dagen"}, Count{"{0} dag geleden", "{0} dagen geleden"}},
+        "day-narrow": {map[string]string{
+            "-1": "gisteren",
+            "-2": "eergisteren",
+            "0": "vandaag",
+            "1": "morgen",
+            "2": "overmorgen",
+        }, Count{"over {0} dag", "over {0} dgn"}, Count{"{0} dag geleden", "{0} dgn geleden"}},
+        "day-short": {map[string]string{
+            "-1": "gisteren",
+            "-2": "eergisteren",
+            "0": "vandaag",
+            "1": "morgen",
+            "2": "overmorgen",
+        }, Count{"over {0} dag", "over {0} dgn"}, Count{"{0} dag geleden", "{0} dgn geleden"}},
+        "hour": {map[string]string{
+            "0": "binnen een uur",
+        }, Count{"over {0} uur", "over {0} uur"}, Count{"{0} uur geleden", "{0} uur geleden"}},
+        "hour-narrow": {map[string]string{
+            "0": "binnen een uur",
+        }, Count{"over {0} uur", "over {0} uur"}, Count{"{0} uur geleden", "{0} uur geleden"}},
+        "hour-short": {map[string]string{
+            "0": "binnen een uur",
+        }, Count{"over {0} uur", "over {0} uur"}, Count{"{0} uur geleden", "{0} uur geleden"}},
+        "minute": {map[string]string{
+            "0": "binnen een minuut",
+        }, Count{"over {0} minuut", "over {0} minuten"}, Count{"{0} minuut geleden", "{0} minuten geleden"}},
+        "minute-narrow": {map[string]string{
+            "0": "binnen een minuut",
+        }, Count{"over {0} min.", "over {0} min."}, Count{"{0} min. geleden", "{0} min. geleden"}},
+        "minute-short": {map[string]string{
+            "0": "binnen een minuut",
+        }, Count{"over {0} min.", "over {0} min."}, Count{"{0} min. geleden", "{0} min. geleden"}},
+        "month": {map[string]string{
+            "-1": "vorige maand",
+            "0": "deze maand",
+            "1": "volgende maand",
+        }, Count{"over {0} maand", "over {0} maanden"}, Count{"{0} maand geleden", "{0} maanden geleden"}},
+        "month-narrow": {map[string]string{
+            "-1": "vorige maand",
+            "0": "deze maand",
+            "1": "volgende maand",
+        }, Count{"over {0} mnd", "over {0} mnd"}, Count{"{0} mnd geleden", "{0} mnd geleden"}},
+        "month-short": {map[string]string{
+            "-1": "vorige maand",
+            "0": "deze maand",
+            "1": "volgende maand",
+        }, Count{"over {0} mnd", "over {0} mnd"}, Count{"{0} mnd geleden", "{0} mnd geleden"}},
+        "quarter": {map[string]string{
+            "-1": "vorig kwartaal",
+            "0": "dit kwartaal",
+            "1": "volgend kwartaal",
+        }, Count{"over {0} kwartaal", "over {0} kwartalen"}, Count{"{0} kwartaal geleden", "{0} kwartalen geleden"}},
+        "quarter-narrow": {map[string]string{
+            "-1": "vorig kwartaal",
+            "0": "dit kwartaal",
+            "1": "volgend kwartaal",
+        }, Count{"over {0} kw.", "over {0} kw."}, Count{"{0} kw. geleden", "{0} kw. geleden"}},
+        "quarter-short": {map[string]string{
+            "-1": "vorig kwartaal",
+            "0": "dit kwartaal",
+            "1": "volgend kwartaal",
+        }, Count{"over {0} kw.", "over {0} kw."}, Count{"{0} kw. geleden", "{0} kw. geleden"}},
+        "second": {map[string]string{
+            "0": "nu",
+        }, Count{"over {0} seconde", "over {0} seconden"}, Count{"{0} seconde geleden", "{0} seconden geleden"}},
+        "second-narrow": {map[string]string{
+            "0": "nu",
+        }, Count{"over {0} sec.", "over {0} sec."}, Count{"{0} sec. geleden", "{0} sec. geleden"}},
+        "second-short": {map[string]string{
+            "0": "nu",
+        }, Count{"over {0} sec.", "over {0} sec."}, Count{"{0} sec. geleden", "{0} sec. geleden"}},
+        "week": {map[string]string{
+            "-1": "vorige week",
+            "0": "deze week",
+            "1": "volgende week",
+        }, Count{"over {0} week", "over {0} weken"}, Count{"{0} week geleden", "{0} weken geleden"}},
+        "week-narrow": {map[string]string{
+            "-1": "vorige week",
+            "0": "deze week",
+            "1": "volgende week",
+        }, Count{"over {0} wk", "over {0} wk"}, Count{"{0} wk geleden", "{0} wk geleden"}},
+        "week-short": {map[string]string{
+            "-1": "vorige week",
+            "0": "deze week",
+            "1": "volgende week",
+        }, Count{"over {0} wk", "over {0} wk"}, Count{"{0} wk geleden", "{0} wk geleden"}},
+        "year": {map[string]string{
+            "-1": "vorig jaar",
+            "0": "dit jaar",
+            "1": "volgend jaar",
+        }, Count{"over {0} jaar", "over {0} jaar"}, Count{"{0} jaar geleden", "{0} jaar geleden"}},
+        "year-narrow": {map[string]string{
+            "-1": "vorig jaar",
+            "0": "dit jaar",
+            "1": "volgend jaar",
+        }, Count{"over {0} jaar", "over {0} jaar"}, Count{"{0} jaar geleden", "{0} jaar geleden"}},
+        "year-short": {map[string]string{
+            "-1": "vorig jaar",
+            "0": "dit jaar",
+            "1": "volgend jaar",
+        }, Count{"over {0} jaar", "over {0} jaar"}, Count{"{0} jaar geleden", "{0} jaar geleden"}},
+    }, map[string]string{
+        "Bh": "h B",
+        "Bhm": "h:mm B",
+        "Bhms": "h:mm:ss B",
+        "E": "ccc",
+        "EBhm": "E h:mm B",
+        "EBhms": "E h:mm:ss B",
+        "EHm": "E HH:mm",
+        "EHms": "E HH:mm:ss",
+        "Ed": "E d",
+        "Ehm": "E h:mm a",
+        "Ehms": "E h:mm:ss a",
+        "Gy": "y G",
+        "GyMMM": "MMM y G",
+        "GyMMMEd": "E d MMM y G",
+        "GyMMMd": "d MMM y G",
+        "GyMd": "d-M-y GGGGG",
+        "H": "HH",
+        "Hm": "HH:mm",
+        "Hms": "HH:mm:ss",
+        "Hmsv": "HH:mm:ss v",
+        "Hmv": "HH:mm v",
+        "M": "L",
+        "MEd": "E d-M",
+        "MMM": "LLL",
+        "MMMEd": "E d MMM",
+        "MMMMEd": "E d MMMM",
+        "MMMMd": "d MMMM",
+        "MMMd": "d MMM",
+        "Md": "d-M",
+        "d": "d",
+        "h": "h a",
+        "hm": "h:mm a",
+        "hms": "h:mm:ss a",
+        "hmsv": "h:mm:ss a v",
+        "hmv": "h:mm a v",
+        "ms": "mm:ss",
+        "y": "y",
+        "yM": "M-y",
+        "yMEd": "E d-M-y",
+        "yMM": "MM-y",
+        "yMMM": "MMM y",
+        "yMMMEd": "E d MMM y",
+        "yMMMM": "MMMM y",
+        "yMMMMEd": "E d MMMM y",
+        "yMMMMd": "d MMMM y",
+        "yMMMd": "d MMM y",
+        "yMd": "d-M-y",
+        "yQQQ": "QQQ y",
+        "yQQQQ": "QQQQ y",
+    }, [2]CalendarSymbol{
+        {"voor Christus", "v.Chr.", "v.C.", ""},
+        {"na Christus", "n.Chr.", "n.C.", ""},
+    }, [4]CalendarSymbol{
+        {"1e kwartaal", "K1", "1", ""},
+        {"2e kwartaal", "K2", "2", ""},
+        {"3e kwartaal", "K3", "3", ""},
+        {"4e kwartaal", "K4", "4", ""},
+    }, [12]CalendarSymbol{
+        {"januari", "jan", "J", ""},
+        {"februari", "feb", "F", ""},
+        {"maart", "mrt", "M", ""},
+        {"april", "apr", "A", ""},
+        {"mei", "mei", "M", ""},
+        {"juni", "jun", "J", ""},
+        {"juli", "jul", "J", ""},
+        {"augustus", "aug", "A", ""},
+        {"september", "sep", "S", ""},
+        {"oktober", "okt", "O", ""},
+        {"november", "nov", "N", ""},
+        {"december", "dec", "D", ""},
+    }, [7]CalendarSymbol{
+        {"zondag", "zo", "Z", "zo"},
+        {"maandag", "ma", "M", "ma"},
+        {"dinsdag", "di", "D", "di"},
+        {"woensdag", "wo", "W", "wo"},
+        {"donderdag", "do", "D", "do"},
+        {"vrijdag", "vr", "V", "vr"},
+        {"zaterdag", "za", "Z", "za"},
+    }, [4]CalendarSymbol{
+        {"1e kwartaal", "K1", "1", ""},
+        {"2e kwartaal", "K2", "2", ""},
+        {"3e kwartaal", "K3", "3", ""},
+        {"4e kwartaal", "K4", "4", ""},
+    }, "gregorian", map[string]Calendar{
+        "buddhist": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Boeddhistische jaartelling", "B.E.", "B.E.", ""},
+        }},
+        "hebrew": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{
+            {"Tisjrie", "Tisjrie", "1", ""},
+            {"Chesjwan", "Chesjwan", "2", ""},
+            {"Kislev", "Kislev", "3", ""},
+            {"Tevet", "Tevet", "4", ""},
+            {"Sjevat", "Sjevat", "5", ""},
+            {"Adar A", "Adar A", "6", ""},
+            {"Adar", "Adar", "7", ""},
+            {"Nisan", "Nisan", "8", ""},
+            {"Ijar", "Ijar", "9", ""},
+            {"Sivan", "Sivan", "10", ""},
+            {"Tammoez", "Tammoez", "11", ""},
+            {"Av", "Av", "12", ""},
+            {"Elloel", "Elloel", "13", ""},
+            {"Adar B", "Adar B", "7", ""},
+        }, []CalendarSymbol{
+            {"Anno Mundi", "A.M.", "A.M.", ""},
+        }},
+        "islamic": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{
+            {"Moeharram", "Moeh.", "1", ""},
+            {"Safar", "Saf.", "2", ""},
+            {"Rabiʻa al awal", "Rab. I", "3", ""},
+            {"Rabiʻa al thani", "Rab. II", "4", ""},
+            {"Joemadʻal awal", "Joem. I", "5", ""},
+            {"Joemadʻal thani", "Joem. II", "6", ""},
+            {"Rajab", "Raj.", "7", ""},
+            {"Sjaʻaban", "Sja.", "8", ""},
+            {"Ramadan", "Ram.", "9", ""},
+            {"Sjawal", "Sjaw.", "10", ""},
+            {"Doe al kaʻaba", "Doe al k.", "11", ""},
+            {"Doe al hizja", "Doe al h.", "12", ""},
+        }, []CalendarSymbol{
+            {"Saʻna Hizjria", "AH", "AH", ""},
+        }},
+        "japanese": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Meiji", "Meiji", "M", ""},
+            {"Taishō", "Taishō", "T", ""},
+            {"Shōwa", "Shōwa", "S", ""},
+            {"Heisei", "Heisei", "H", ""},
+            {"Reiwa", "Reiwa", "R", ""},
+        }},
+        "persian": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{
+            {"Farvardin", "Farvardin", "1", ""},
+            {"Ordibehesht", "Ordibehesht", "2", ""},
+            {"Khordad", "Khordad", "3", ""},
+            {"Tir", "Tir", "4", ""},
+            {"Mordad", "Mordad", "5", ""},
+            {"Shahrivar", "Shahrivar", "6", ""},
+            {"Mehr", "Mehr", "7", ""},
+            {"Aban", "Aban", "8", ""},
+            {"Azar", "Azar", "9", ""},
+            {"Dey", "Dey", "10", ""},
+            {"Bahman", "Bahman", "11", ""},
+            {"Esfand", "Esfand", "12", ""},
+        }, []CalendarSymbol{
+            {"Anno Persico", "AP", "AP", ""},
+        }},
+    }, 1, 4, "H", []string{
+        "H",
+        "hB",
+    }, "latn", "", "metric", "", "GMT{0}", "GMT", "+HH:mm;-HH:mm", "{0}-tijd", "zomertijd {0}", "standaardtijd {0}", "{1} ({0})"},
+    "nl_NL": {"#,##0.###", CurrencyFormat{"¤ #,##0.00;¤ -#,##0.00", "#,##0.00", "¤ #,##0.00;¤ -#,##0.00", Count{"{0} {1}", "{0} {1}"}}, CalendarFormat{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd-MM-y"}, CalendarFormat{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"}, CalendarFormat{"{1}, {0}", "{1}, {0}", "{1}, {0}", "{1}, {0}"}, map[string]map[string]string{
         "": {
             "": "{0} – {1}",
         },
@@ -5881,39 +7267,39 @@ var locales = map[string]Locale{
             "y": "dd-MM-y – dd-MM-y",
         },
     }, "{0} {1}", 44, 46, 44, 46, 43, 45, 58, [12]CalendarSymbol{
-        {"januari", "jan", "J"},
-        {"februari", "feb", "F"},
-        {"maart", "mrt", "M"},
-        {"april", "apr", "A"},
-        {"mei", "mei", "M"},
-        {"juni", "jun", "J"},
-        {"juli", "jul", "J"},
-        {"augustus", "aug", "A"},
-        {"september", "sep", "S"},
-        {"oktober", "okt", "O"},
-        {"november", "nov", "N"},
-        {"december", "dec", "D"},
+        {"januari", "jan", "J", ""},
+        {"februari", "feb", "F", ""},
+        {"maart", "mrt", "M", ""},
+        {"april", "apr", "A", ""},
+        {"mei", "mei", "M", ""},
+        {"juni", "jun", "J", ""},
+        {"juli", "jul", "J", ""},
+        {"augustus", "aug", "A", ""},
+        {"september", "sep", "S", ""},
+        {"oktober", "okt", "O", ""},
+        {"november", "nov", "N", ""},
+        {"december", "dec", "D", ""},
     }, [7]CalendarSymbol{
-        {"zondag", "zo", "Z"},
-        {"maandag", "ma", "M"},
-        {"dinsdag", "di", "D"},
-        {"woensdag", "wo", "W"},
-        {"donderdag", "do", "D"},
-        {"vrijdag", "vr", "V"},
-        {"zaterdag", "za", "Z"},
+        {"zondag", "zo", "Z", "zo"},
+        {"maandag", "ma", "M", "ma"},
+        {"dinsdag", "di", "D", "di"},
+        {"woensdag", "wo", "W", "wo"},
+        {"donderdag", "do", "D", "do"},
+        {"vrijdag", "vr", "V", "vr"},
+        {"zaterdag", "za", "Z", "za"},
     }, map[string]DayPeriodRule{
         "afternoon1": {720, 1080},
         "evening1": {1080, 1440},
         "morning1": {360, 720},
         "night1": {0, 360},
     }, map[string]CalendarSymbol{
-        "afternoon1": {"", "’s middags", ""},
-        "am": {"AM", "a.m.", "AM"},
-        "evening1": {"", "’s avonds", ""},
-        "midnight": {"", "middernacht", ""},
-        "morning1": {"", "’s ochtends", ""},
-        "night1": {"", "’s nachts", ""},
-        "pm": {"PM", "p.m.", "PM"},
+        "afternoon1": {"", "’s middags", "", ""},
+        "am": {"AM", "a.m.", "AM", ""},
+        "evening1": {"", "’s avonds", "", ""},
+        "midnight": {"", "middernacht", "", ""},
+        "morning1": {"", "’s ochtends", "", ""},
+        "night1": {"", "’s nachts", "", ""},
+        "pm": {"PM", "p.m.", "PM", ""},
     }, map[string]string{
         "Africa/Addis_Ababa": "Addis Abeba",
         "Africa/Asmera": "Asmara",
@@ -6209,313 +7595,313 @@ var locales = map[string]Locale{
         "Yekaterinburg": {MetazoneSymbol{"Jekaterinenburg-tijd", ""}, MetazoneSymbol{"Jekaterinenburg-standaardtijd", ""}, MetazoneSymbol{"Jekaterinenburg-zomertijd", ""}},
         "Yukon": {MetazoneSymbol{"", ""}, MetazoneSymbol{"Yukon-tijd", ""}, MetazoneSymbol{"", ""}},
     }, map[string]Currency{
-        "ADP": {"Andorrese peseta", "", ""},
-        "AED": {"Verenigde Arabische Emiraten-dirham", "", ""},
-        "AFA": {"Afghani (1927–2002)", "", ""},
-        "AFN": {"Afghaanse afghani", "", "؋"},
-        "ALK": {"Albanese lek (1946–1965)", "", ""},
-        "ALL": {"Albanese lek", "", ""},
-        "AMD": {"Armeense dram", "", "֏"},
-        "ANG": {"Nederlands-Antilliaanse gulden", "", ""},
-        "AOA": {"Angolese kwanza", "", "Kz"},
-        "AOK": {"Angolese kwanza (1977–1990)", "", ""},
-        "AON": {"Angolese nieuwe kwanza (1990–2000)", "", ""},
-        "AOR": {"Angolese kwanza reajustado (1995–1999)", "", ""},
-        "ARA": {"Argentijnse austral", "", ""},
-        "ARL": {"Argentijnse peso ley (1970–1983)", "", ""},
-        "ARM": {"Argentijnse peso (1881–1970)", "", ""},
-        "ARP": {"Argentijnse peso (1983–1985)", "", ""},
-        "ARS": {"Argentijnse peso", "", "$"},
-        "ATS": {"Oostenrijkse schilling", "", ""},
-        "AUD": {"Australische dollar", "AU$", "$"},
-        "AWG": {"Arubaanse gulden", "", ""},
-        "AZM": {"Azerbeidzjaanse manat (1993–2006)", "", ""},
-        "AZN": {"Azerbeidzjaanse manat", "", "₼"},
-        "BAD": {"Bosnische dinar", "", ""},
-        "BAM": {"Bosnische convertibele mark", "", "KM"},
-        "BAN": {"Nieuwe Bosnische dinar (1994–1997)", "", ""},
-        "BBD": {"Barbadaanse dollar", "", "$"},
-        "BDT": {"Bengalese taka", "", "৳"},
-        "BEC": {"Belgische frank (convertibel)", "", ""},
-        "BEF": {"Belgische frank", "", ""},
-        "BEL": {"Belgische frank (financieel)", "", ""},
-        "BGL": {"Bulgaarse harde lev", "", ""},
-        "BGM": {"Bulgaarse socialistische lev", "", ""},
-        "BGN": {"Bulgaarse lev", "", ""},
-        "BGO": {"Bulgaarse lev (1879–1952)", "", ""},
-        "BHD": {"Bahreinse dinar", "", ""},
-        "BIF": {"Burundese frank", "", ""},
-        "BMD": {"Bermuda-dollar", "", "$"},
-        "BND": {"Bruneise dollar", "", "$"},
-        "BOB": {"Boliviaanse boliviano", "", "Bs"},
-        "BOL": {"Boliviaanse boliviano (1863–1963)", "", ""},
-        "BOP": {"Boliviaanse peso", "", ""},
-        "BOV": {"Boliviaanse mvdol", "", ""},
-        "BRB": {"Braziliaanse cruzeiro novo (1967–1986)", "", ""},
-        "BRC": {"Braziliaanse cruzado", "", ""},
-        "BRE": {"Braziliaanse cruzeiro (1990–1993)", "", ""},
-        "BRL": {"Braziliaanse real", "R$", "R$"},
-        "BRN": {"Braziliaanse nieuwe cruzado (1989–1990)", "", ""},
-        "BRR": {"Braziliaanse cruzeiro", "", ""},
-        "BRZ": {"Braziliaanse cruzeiro (1942–1967)", "", ""},
-        "BSD": {"Bahamaanse dollar", "", "$"},
-        "BTN": {"Bhutaanse ngultrum", "", ""},
-        "BUK": {"Birmese kyat", "", ""},
-        "BWP": {"Botswaanse pula", "", "P"},
-        "BYB": {"Wit-Russische nieuwe roebel (1994–1999)", "", ""},
-        "BYN": {"Belarussische roebel", "", "р."},
-        "BYR": {"Wit-Russische roebel (2000–2016)", "", ""},
-        "BZD": {"Belizaanse dollar", "", "$"},
-        "CAD": {"Canadese dollar", "C$", "$"},
-        "CDF": {"Congolese frank", "", ""},
-        "CHE": {"WIR euro", "", ""},
-        "CHF": {"Zwitserse frank", "", ""},
-        "CHW": {"WIR franc", "", ""},
-        "CLE": {"Chileense escudo", "", ""},
-        "CLF": {"Chileense unidades de fomento", "", ""},
-        "CLP": {"Chileense peso", "", "$"},
-        "CNH": {"Chinese yuan (offshore)", "", ""},
-        "CNX": {"dollar van de Chinese Volksbank", "", ""},
-        "CNY": {"Chinese yuan", "CN¥", "¥"},
-        "COP": {"Colombiaanse peso", "", "$"},
-        "COU": {"Unidad de Valor Real", "", ""},
-        "CRC": {"Costa Ricaanse colon", "", "₡"},
-        "CSD": {"Oude Servische dinar", "", ""},
-        "CSK": {"Tsjechoslowaakse harde koruna", "", ""},
-        "CUC": {"Cubaanse convertibele peso", "", "$"},
-        "CUP": {"Cubaanse peso", "", "$"},
-        "CVE": {"Kaapverdische escudo", "", ""},
-        "CYP": {"Cyprisch pond", "", ""},
-        "CZK": {"Tsjechische kroon", "", "Kč"},
-        "DDM": {"Oost-Duitse ostmark", "", ""},
-        "DEM": {"Duitse mark", "", ""},
-        "DJF": {"Djiboutiaanse frank", "", ""},
-        "DKK": {"Deense kroon", "", "kr"},
-        "DOP": {"Dominicaanse peso", "", "$"},
-        "DZD": {"Algerijnse dinar", "", ""},
-        "ECS": {"Ecuadoraanse sucre", "", ""},
-        "ECV": {"Ecuadoraanse unidad de valor constante (UVC)", "", ""},
-        "EEK": {"Estlandse kroon", "", ""},
-        "EGP": {"Egyptisch pond", "", "E£"},
-        "ERN": {"Eritrese nakfa", "", ""},
-        "ESA": {"Spaanse peseta (account A)", "", ""},
-        "ESB": {"Spaanse peseta (convertibele account)", "", ""},
-        "ESP": {"Spaanse peseta", "", "₧"},
-        "ETB": {"Ethiopische birr", "", ""},
-        "EUR": {"Euro", "€", "€"},
-        "FIM": {"Finse markka", "", ""},
-        "FJD": {"Fiji-dollar", "FJ$", "$"},
-        "FKP": {"Falklandeilands pond", "", "£"},
-        "FRF": {"Franse franc", "", ""},
-        "GBP": {"Britse pond", "£", "£"},
-        "GEK": {"Georgische kupon larit", "", ""},
-        "GEL": {"Georgische lari", "ლ", "₾"},
-        "GHC": {"Ghanese cedi (1979–2007)", "", ""},
-        "GHS": {"Ghanese cedi", "", "GH₵"},
-        "GIP": {"Gibraltarees pond", "", "£"},
-        "GMD": {"Gambiaanse dalasi", "", ""},
-        "GNF": {"Guinese frank", "", "FG"},
-        "GNS": {"Guinese syli", "", ""},
-        "GQE": {"Equatoriaal-Guinese ekwele guineana", "", ""},
-        "GRD": {"Griekse drachme", "", ""},
-        "GTQ": {"Guatemalteekse quetzal", "", "Q"},
-        "GWE": {"Portugees-Guinese escudo", "", ""},
-        "GWP": {"Guinee-Bissause peso", "", ""},
-        "GYD": {"Guyaanse dollar", "", "$"},
-        "HKD": {"Hongkongse dollar", "HK$", "$"},
-        "HNL": {"Hondurese lempira", "", "L"},
-        "HRD": {"Kroatische dinar", "", ""},
-        "HRK": {"Kroatische kuna", "", "kn"},
-        "HTG": {"Haïtiaanse gourde", "", ""},
-        "HUF": {"Hongaarse forint", "", "Ft"},
-        "IDR": {"Indonesische roepia", "", "Rp"},
-        "IEP": {"Iers pond", "", ""},
-        "ILP": {"Israëlisch pond", "", ""},
-        "ILR": {"Israëlische sjekel (1980–1985)", "", ""},
-        "ILS": {"Israëlische nieuwe shekel", "₪", "₪"},
-        "INR": {"Indiase roepie", "₹", "₹"},
-        "IQD": {"Iraakse dinar", "", ""},
-        "IRR": {"Iraanse rial", "", ""},
-        "ISJ": {"IJslandse kroon (1918–1981)", "", ""},
-        "ISK": {"IJslandse kroon", "", "kr"},
-        "ITL": {"Italiaanse lire", "", ""},
-        "JMD": {"Jamaicaanse dollar", "", "$"},
-        "JOD": {"Jordaanse dinar", "", ""},
-        "JPY": {"Japanse yen", "JP¥", "¥"},
-        "KES": {"Keniaanse shilling", "", ""},
-        "KGS": {"Kirgizische som", "", "⃀"},
-        "KHR": {"Cambodjaanse riel", "", "៛"},
-        "KMF": {"Comorese frank", "", "CF"},
-        "KPW": {"Noord-Koreaanse won", "", "₩"},
-        "KRH": {"Zuid-Koreaanse hwan (1953–1962)", "", ""},
-        "KRO": {"Oude Zuid-Koreaanse won (1945–1953)", "", ""},
-        "KRW": {"Zuid-Koreaanse won", "₩", "₩"},
-        "KWD": {"Koeweitse dinar", "", ""},
-        "KYD": {"Kaaimaneilandse dollar", "", "$"},
-        "KZT": {"Kazachse tenge", "", "₸"},
-        "LAK": {"Laotiaanse kip", "", "₭"},
-        "LBP": {"Libanees pond", "", "L£"},
-        "LKR": {"Sri Lankaanse roepie", "", "Rs"},
-        "LRD": {"Liberiaanse dollar", "", "$"},
-        "LSL": {"Lesothaanse loti", "", ""},
-        "LTL": {"Litouwse litas", "", "Lt"},
-        "LTT": {"Litouwse talonas", "", ""},
-        "LUC": {"Luxemburgse convertibele franc", "", ""},
-        "LUF": {"Luxemburgse frank", "", ""},
-        "LUL": {"Luxemburgse financiële franc", "", ""},
-        "LVL": {"Letse lats", "", "Ls"},
-        "LVR": {"Letse roebel", "", ""},
-        "LYD": {"Libische dinar", "", ""},
-        "MAD": {"Marokkaanse dirham", "", ""},
-        "MAF": {"Marokkaanse franc", "", ""},
-        "MCF": {"Monegaskische frank", "", ""},
-        "MDC": {"Moldavische cupon", "", ""},
-        "MDL": {"Moldavische leu", "", ""},
-        "MGA": {"Malagassische ariary", "", "Ar"},
-        "MGF": {"Malagassische franc", "", ""},
-        "MKD": {"Macedonische denar", "", ""},
-        "MKN": {"Macedonische denar (1992–1993)", "", ""},
-        "MLF": {"Malinese franc", "", ""},
-        "MMK": {"Myanmarese kyat", "", "K"},
-        "MNT": {"Mongoolse tugrik", "", "₮"},
-        "MOP": {"Macause pataca", "", ""},
-        "MRO": {"Mauritaanse ouguiya (1973–2017)", "", ""},
-        "MRU": {"Mauritaanse ouguiya", "", ""},
-        "MTL": {"Maltese lire", "", ""},
-        "MTP": {"Maltees pond", "", ""},
-        "MUR": {"Mauritiaanse roepie", "", "Rs"},
-        "MVP": {"Maldivische roepie", "", ""},
-        "MVR": {"Maldivische rufiyaa", "", ""},
-        "MWK": {"Malawische kwacha", "", ""},
-        "MXN": {"Mexicaanse peso", "MX$", "$"},
-        "MXP": {"Mexicaanse zilveren peso (1861–1992)", "", ""},
-        "MXV": {"Mexicaanse unidad de inversion (UDI)", "", ""},
-        "MYR": {"Maleisische ringgit", "", "RM"},
-        "MZE": {"Mozambikaanse escudo", "", ""},
-        "MZM": {"Oude Mozambikaanse metical", "", ""},
-        "MZN": {"Mozambikaanse metical", "", ""},
-        "NAD": {"Namibische dollar", "", "$"},
-        "NGN": {"Nigeriaanse naira", "", "₦"},
-        "NIC": {"Nicaraguaanse córdoba (1988–1991)", "", ""},
-        "NIO": {"Nicaraguaanse córdoba", "", "C$"},
-        "NLG": {"Nederlandse gulden", "", ""},
-        "NOK": {"Noorse kroon", "", "kr"},
-        "NPR": {"Nepalese roepie", "", "Rs"},
-        "NZD": {"Nieuw-Zeelandse dollar", "NZ$", "$"},
-        "OMR": {"Omaanse rial", "", ""},
-        "PAB": {"Panamese balboa", "", ""},
-        "PEI": {"Peruaanse inti", "", ""},
-        "PEN": {"Peruaanse sol", "", ""},
-        "PES": {"Peruaanse sol (1863–1965)", "", ""},
-        "PGK": {"Papoea-Nieuw-Guinese kina", "", ""},
-        "PHP": {"Filipijnse peso", "PHP", "₱"},
-        "PKR": {"Pakistaanse roepie", "", "Rs"},
-        "PLN": {"Poolse zloty", "", "zł"},
-        "PLZ": {"Poolse zloty (1950–1995)", "", ""},
-        "PTE": {"Portugese escudo", "", ""},
-        "PYG": {"Paraguayaanse guarani", "", "₲"},
-        "QAR": {"Qatarese rial", "", ""},
-        "RHD": {"Rhodesische dollar", "", ""},
-        "ROL": {"Oude Roemeense leu", "", ""},
-        "RON": {"Roemeense leu", "", "lei"},
-        "RSD": {"Servische dinar", "", ""},
-        "RUB": {"Russische roebel", "", "₽"},
-        "RUR": {"Russische roebel (1991–1998)", "", "р."},
-        "RWF": {"Rwandese frank", "", "RF"},
-        "SAR": {"Saoedi-Arabische riyal", "⃁", ""},
-        "SBD": {"Salomon-dollar", "SI$", "$"},
-        "SCR": {"Seychelse roepie", "", ""},
-        "SDD": {"Soedanese dinar", "", ""},
-        "SDG": {"Soedanees pond", "", ""},
-        "SDP": {"Soedanees pond (1957–1998)", "", ""},
-        "SEK": {"Zweedse kroon", "", "kr"},
-        "SGD": {"Singaporese dollar", "", "$"},
-        "SHP": {"Sint-Heleens pond", "", "£"},
-        "SIT": {"Sloveense tolar", "", ""},
-        "SKK": {"Slowaakse koruna", "", ""},
-        "SLE": {"Sierra Leoonse leone", "", ""},
-        "SLL": {"Sierra Leoonse leone (1964–2022)", "", ""},
-        "SOS": {"Somalische shilling", "", ""},
-        "SRD": {"Surinaamse dollar", "", "$"},
-        "SRG": {"Surinaamse gulden", "", ""},
-        "SSP": {"Zuid-Soedanees pond", "", "£"},
-        "STD": {"Santomese dobra (1977–2017)", "", ""},
-        "STN": {"Santomese dobra", "", "Db"},
-        "SUR": {"Sovjet-roebel", "", ""},
-        "SVC": {"Salvadoraanse colón", "", ""},
-        "SYP": {"Syrisch pond", "", "£"},
-        "SZL": {"Swazische lilangeni", "", ""},
-        "THB": {"Thaise baht", "฿", "฿"},
-        "TJR": {"Tadzjikistaanse roebel", "", ""},
-        "TJS": {"Tadzjiekse somoni", "", ""},
-        "TMM": {"Turkmeense manat (1993–2009)", "", ""},
-        "TMT": {"Turkmeense manat", "", ""},
-        "TND": {"Tunesische dinar", "", ""},
-        "TOP": {"Tongaanse paʻanga", "", "T$"},
-        "TPE": {"Timorese escudo", "", ""},
-        "TRL": {"Turkse lire", "", ""},
-        "TRY": {"Turkse lira", "TL", "₺"},
-        "TTD": {"Trinidad en Tobago-dollar", "", "$"},
-        "TWD": {"Nieuwe Taiwanese dollar", "NT$", "NT$"},
-        "TZS": {"Tanzaniaanse shilling", "", ""},
-        "UAH": {"Oekraïense hryvnia", "", "₴"},
-        "UAK": {"Oekraïense karbovanetz", "", ""},
-        "UGS": {"Oegandese shilling (1966–1987)", "", ""},
-        "UGX": {"Oegandese shilling", "", ""},
-        "USD": {"Amerikaanse dollar", "US$", "$"},
-        "USN": {"Amerikaanse dollar (volgende dag)", "", ""},
-        "USS": {"Amerikaanse dollar (zelfde dag)", "", ""},
-        "UYI": {"Uruguayaanse peso en geïndexeerde eenheden", "", ""},
-        "UYP": {"Uruguayaanse peso (1975–1993)", "", ""},
-        "UYU": {"Uruguayaanse peso", "", "$"},
-        "UYW": {"Uruguayaanse nominale salarisindexeenheid", "", ""},
-        "UZS": {"Oezbeekse sum", "", ""},
-        "VEB": {"Venezolaanse bolivar (1871–2008)", "", ""},
-        "VED": {"Bolívar Soberano", "", ""},
-        "VEF": {"Venezolaanse bolivar (2008–2018)", "", "Bs"},
-        "VES": {"Venezolaanse bolivar", "", ""},
-        "VND": {"Vietnamese dong", "₫", "₫"},
-        "VNN": {"Vietnamese dong (1978–1985)", "", ""},
-        "VUV": {"Vanuatuaanse vatu", "", ""},
-        "WST": {"Samoaanse tala", "", ""},
-        "XAF": {"CFA-frank", "FCFA", ""},
-        "XAG": {"Zilver", "", ""},
-        "XAU": {"Goud", "", ""},
-        "XBA": {"Europese samengestelde eenheid", "", ""},
-        "XBB": {"Europese monetaire eenheid", "", ""},
-        "XBC": {"Europese rekeneenheid (XBC)", "", ""},
-        "XBD": {"Europese rekeneenheid (XBD)", "", ""},
-        "XCD": {"Oost-Caribische dollar", "EC$", "$"},
-        "XCG": {"Caribische gulden", "Cg.", "Cg"},
-        "XDR": {"Special Drawing Rights", "", ""},
-        "XEU": {"European Currency Unit", "", ""},
-        "XFO": {"Franse gouden franc", "", ""},
-        "XFU": {"Franse UIC-franc", "", ""},
-        "XOF": {"CFA-franc BCEAO", "F CFA", ""},
-        "XPD": {"Palladium", "", ""},
-        "XPF": {"CFP-frank", "XPF", ""},
-        "XPT": {"Platina", "", ""},
-        "XRE": {"RINET-fondsen", "", ""},
-        "XSU": {"Sucre", "", ""},
-        "XTS": {"Valutacode voor testdoeleinden", "", ""},
-        "XUA": {"ADB-rekeneenheid", "", ""},
-        "XXX": {"onbekende munteenheid", "XXX", ""},
-        "YDD": {"Jemenitische dinar", "", ""},
-        "YER": {"Jemenitische rial", "", ""},
-        "YUD": {"Joegoslavische harde dinar", "", ""},
-        "YUM": {"Joegoslavische noviy-dinar", "", ""},
-        "YUN": {"Joegoslavische convertibele dinar", "", ""},
-        "YUR": {"Joegoslavische hervormde dinar (1992–1993)", "", ""},
-        "ZAL": {"Zuid-Afrikaanse rand (financieel)", "", ""},
-        "ZAR": {"Zuid-Afrikaanse rand", "", "R"},
-        "ZMK": {"Zambiaanse kwacha (1968–2012)", "", ""},
-        "ZMW": {"Zambiaanse kwacha", "", "ZK"},
-        "ZRN": {"Zaïrese nieuwe zaïre", "", ""},
-        "ZRZ": {"Zaïrese zaïre", "", ""},
-        "ZWD": {"Zimbabwaanse dollar", "", ""},
-        "ZWG": {"Zimbabwe Gold", "ZiG", ""},
-        "ZWL": {"Zimbabwaanse dollar (2009)", "", ""},
-        "ZWR": {"Zimbabwaanse dollar (2008)", "", ""},
+        "ADP": {"Andorrese peseta", "", "", Count{"", ""}, 0, 0},
+        "AED": {"Verenigde Arabische Emiraten-dirham", "", "", Count{"", ""}, 0, 0},
+        "AFA": {"Afghani (1927–2002)", "", "", Count{"", ""}, 0, 0},
+        "AFN": {"Afghaanse afghani", "", "؋", Count{"", ""}, 0, 0},
+        "ALK": {"Albanese lek (1946–1965)", "", "", Count{"", ""}, 0, 0},
+        "ALL": {"Albanese lek", "", "", Count{"", ""}, 0, 0},
+        "AMD": {"Armeense dram", "", "֏", Count{"", ""}, 0, 0},
+        "ANG": {"Nederlands-Antilliaanse gulden", "", "", Count{"", ""}, 0, 0},
+        "AOA": {"Angolese kwanza", "", "Kz", Count{"", ""}, 0, 0},
+        "AOK": {"Angolese kwanza (1977–1990)", "", "", Count{"", ""}, 0, 0},
+        "AON": {"Angolese nieuwe kwanza (1990–2000)", "", "", Count{"", ""}, 0, 0},
+        "AOR": {"Angolese kwanza reajustado (1995–1999)", "", "", Count{"", ""}, 0, 0},
+        "ARA": {"Argentijnse austral", "", "", Count{"", ""}, 0, 0},
+        "ARL": {"Argentijnse peso ley (1970–1983)", "", "", Count{"", ""}, 0, 0},
+        "ARM": {"Argentijnse peso (1881–1970)", "", "", Count{"", ""}, 0, 0},
+        "ARP": {"Argentijnse peso (1983–1985)", "", "", Count{"", ""}, 0, 0},
+        "ARS": {"Argentijnse peso", "", "$", Count{"", ""}, 0, 0},
+        "ATS": {"Oostenrijkse schilling", "", "", Count{"", ""}, 0, 0},
+        "AUD": {"Australische dollar", "AU$", "$", Count{"Australische dollar", "Australische dollar"}, 0, 0},
+        "AWG": {"Arubaanse gulden", "", "", Count{"", ""}, 0, 0},
+        "AZM": {"Azerbeidzjaanse manat (1993–2006)", "", "", Count{"", ""}, 0, 0},
+        "AZN": {"Azerbeidzjaanse manat", "", "₼", Count{"", ""}, 0, 0},
+        "BAD": {"Bosnische dinar", "", "", Count{"", ""}, 0, 0},
+        "BAM": {"Bosnische convertibele mark", "", "KM", Count{"", ""}, 0, 0},
+        "BAN": {"Nieuwe Bosnische dinar (1994–1997)", "", "", Count{"", ""}, 0, 0},
+        "BBD": {"Barbadaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "BDT": {"Bengalese taka", "", "৳", Count{"", ""}, 0, 0},
+        "BEC": {"Belgische frank (convertibel)", "", "", Count{"", ""}, 0, 0},
+        "BEF": {"Belgische frank", "", "", Count{"", ""}, 0, 0},
+        "BEL": {"Belgische frank (financieel)", "", "", Count{"", ""}, 0, 0},
+        "BGL": {"Bulgaarse harde lev", "", "", Count{"", ""}, 0, 0},
+        "BGM": {"Bulgaarse socialistische lev", "", "", Count{"", ""}, 0, 0},
+        "BGN": {"Bulgaarse lev", "", "", Count{"", ""}, 0, 0},
+        "BGO": {"Bulgaarse lev (1879–1952)", "", "", Count{"", ""}, 0, 0},
+        "BHD": {"Bahreinse dinar", "", "", Count{"", ""}, 0, 0},
+        "BIF": {"Burundese frank", "", "", Count{"", ""}, 0, 0},
+        "BMD": {"Bermuda-dollar", "", "$", Count{"", ""}, 0, 0},
+        "BND": {"Bruneise dollar", "", "$", Count{"", ""}, 0, 0},
+        "BOB": {"Boliviaanse boliviano", "", "Bs", Count{"", ""}, 0, 0},
+        "BOL": {"Boliviaanse boliviano (1863–1963)", "", "", Count{"", ""}, 0, 0},
+        "BOP": {"Boliviaanse peso", "", "", Count{"", ""}, 0, 0},
+        "BOV": {"Boliviaanse mvdol", "", "", Count{"", ""}, 0, 0},
+        "BRB": {"Braziliaanse cruzeiro novo (1967–1986)", "", "", Count{"", ""}, 0, 0},
+        "BRC": {"Braziliaanse cruzado", "", "", Count{"", ""}, 0, 0},
+        "BRE": {"Braziliaanse cruzeiro (1990–1993)", "", "", Count{"", ""}, 0, 0},
+        "BRL": {"Braziliaanse real", "R$", "R$", Count{"", ""}, 0, 0},
+        "BRN": {"Braziliaanse nieuwe cruzado (1989–1990)", "", "", Count{"", ""}, 0, 0},
+        "BRR": {"Braziliaanse cruzeiro", "", "", Count{"", ""}, 0, 0},
+        "BRZ": {"Braziliaanse cruzeiro (1942–1967)", "", "", Count{"", ""}, 0, 0},
+        "BSD": {"Bahamaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "BTN": {"Bhutaanse ngultrum", "", "", Count{"", ""}, 0, 0},
+        "BUK": {"Birmese kyat", "", "", Count{"", ""}, 0, 0},
+        "BWP": {"Botswaanse pula", "", "P", Count{"", ""}, 0, 0},
+        "BYB": {"Wit-Russische nieuwe roebel (1994–1999)", "", "", Count{"", ""}, 0, 0},
+        "BYN": {"Belarussische roebel", "", "р.", Count{"", ""}, 0, 0},
+        "BYR": {"Wit-Russische roebel (2000–2016)", "", "", Count{"", ""}, 0, 0},
+        "BZD": {"Belizaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "CAD": {"Canadese dollar", "C$", "$", Count{"Canadese dollar", "Canadese dollar"}, 0, 0},
+        "CDF": {"Congolese frank", "", "", Count{"", ""}, 0, 0},
+        "CHE": {"WIR euro", "", "", Count{"", ""}, 0, 0},
+        "CHF": {"Zwitserse frank", "", "", Count{"Zwitserse frank", "Zwitserse frank"}, 0, 0},
+        "CHW": {"WIR franc", "", "", Count{"", ""}, 0, 0},
+        "CLE": {"Chileense escudo", "", "", Count{"", ""}, 0, 0},
+        "CLF": {"Chileense unidades de fomento", "", "", Count{"", ""}, 0, 0},
+        "CLP": {"Chileense peso", "", "$", Count{"", ""}, 0, 0},
+        "CNH": {"Chinese yuan (offshore)", "", "", Count{"", ""}, 0, 0},
+        "CNX": {"dollar van de Chinese Volksbank", "", "", Count{"", ""}, 0, 0},
+        "CNY": {"Chinese yuan", "CN¥", "¥", Count{"", ""}, 0, 0},
+        "COP": {"Colombiaanse peso", "", "$", Count{"", ""}, 0, 0},
+        "COU": {"Unidad de Valor Real", "", "", Count{"", ""}, 0, 0},
+        "CRC": {"Costa Ricaanse colon", "", "₡", Count{"", ""}, 0, 0},
+        "CSD": {"Oude Servische dinar", "", "", Count{"", ""}, 0, 0},
+        "CSK": {"Tsjechoslowaakse harde koruna", "", "", Count{"", ""}, 0, 0},
+        "CUC": {"Cubaanse convertibele peso", "", "$", Count{"", ""}, 0, 0},
+        "CUP": {"Cubaanse peso", "", "$", Count{"", ""}, 0, 0},
+        "CVE": {"Kaapverdische escudo", "", "", Count{"Kaapverdische escudo", "Kaapverdische escudo"}, 0, 0},
+        "CYP": {"Cyprisch pond", "", "", Count{"", ""}, 0, 0},
+        "CZK": {"Tsjechische kroon", "", "Kč", Count{"", ""}, 0, 0},
+        "DDM": {"Oost-Duitse ostmark", "", "", Count{"", ""}, 0, 0},
+        "DEM": {"Duitse mark", "", "", Count{"", ""}, 0, 0},
+        "DJF": {"Djiboutiaanse frank", "", "", Count{"", ""}, 0, 0},
+        "DKK": {"Deense kroon", "", "kr", Count{"", ""}, 0, 0},
+        "DOP": {"Dominicaanse peso", "", "$", Count{"", ""}, 0, 0},
+        "DZD": {"Algerijnse dinar", "", "", Count{"", ""}, 0, 0},
+        "ECS": {"Ecuadoraanse sucre", "", "", Count{"", ""}, 0, 0},
+        "ECV": {"Ecuadoraanse unidad de valor constante (UVC)", "", "", Count{"", ""}, 0, 0},
+        "EEK": {"Estlandse kroon", "", "", Count{"", ""}, 0, 0},
+        "EGP": {"Egyptisch pond", "", "E£", Count{"", ""}, 0, 0},
+        "ERN": {"Eritrese nakfa", "", "", Count{"", ""}, 0, 0},
+        "ESA": {"Spaanse peseta (account A)", "", "", Count{"", ""}, 0, 0},
+        "ESB": {"Spaanse peseta (convertibele account)", "", "", Count{"", ""}, 0, 0},
+        "ESP": {"Spaanse peseta", "", "₧", Count{"", ""}, 0, 0},
+        "ETB": {"Ethiopische birr", "", "", Count{"", ""}, 0, 0},
+        "EUR": {"Euro", "€", "€", Count{"euro", "euro"}, 0, 0},
+        "FIM": {"Finse markka", "", "", Count{"", ""}, 0, 0},
+        "FJD": {"Fiji-dollar", "FJ$", "$", Count{"", ""}, 0, 0},
+        "FKP": {"Falklandeilands pond", "", "£", Count{"", ""}, 0, 0},
+        "FRF": {"Franse franc", "", "", Count{"", ""}, 0, 0},
+        "GBP": {"Britse pond", "£", "£", Count{"Brits pond", "Brits pond"}, 0, 0},
+        "GEK": {"Georgische kupon larit", "", "", Count{"", ""}, 0, 0},
+        "GEL": {"Georgische lari", "ლ", "₾", Count{"", ""}, 0, 0},
+        "GHC": {"Ghanese cedi (1979–2007)", "", "", Count{"", ""}, 0, 0},
+        "GHS": {"Ghanese cedi", "", "GH₵", Count{"", ""}, 0, 0},
+        "GIP": {"Gibraltarees pond", "", "£", Count{"", ""}, 0, 0},
+        "GMD": {"Gambiaanse dalasi", "", "", Count{"", ""}, 0, 0},
+        "GNF": {"Guinese frank", "", "FG", Count{"", ""}, 0, 0},
+        "GNS": {"Guinese syli", "", "", Count{"", ""}, 0, 0},
+        "GQE": {"Equatoriaal-Guinese ekwele guineana", "", "", Count{"", ""}, 0, 0},
+        "GRD": {"Griekse drachme", "", "", Count{"", ""}, 0, 0},
+        "GTQ": {"Guatemalteekse quetzal", "", "Q", Count{"", ""}, 0, 0},
+        "GWE": {"Portugees-Guinese escudo", "", "", Count{"", ""}, 0, 0},
+        "GWP": {"Guinee-Bissause peso", "", "", Count{"", ""}, 0, 0},
+        "GYD": {"Guyaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "HKD": {"Hongkongse dollar", "HK$", "$", Count{"", ""}, 0, 0},
+        "HNL": {"Hondurese lempira", "", "L", Count{"", ""}, 0, 0},
+        "HRD": {"Kroatische dinar", "", "", Count{"", ""}, 0, 0},
+        "HRK": {"Kroatische kuna", "", "kn", Count{"", ""}, 0, 0},
+        "HTG": {"Haïtiaanse gourde", "", "", Count{"", ""}, 0, 0},
+        "HUF": {"Hongaarse forint", "", "Ft", Count{"", ""}, 0, 0},
+        "IDR": {"Indonesische roepia", "", "Rp", Count{"", ""}, 0, 0},
+        "IEP": {"Iers pond", "", "", Count{"", ""}, 0, 0},
+        "ILP": {"Israëlisch pond", "", "", Count{"", ""}, 0, 0},
+        "ILR": {"Israëlische sjekel (1980–1985)", "", "", Count{"", ""}, 0, 0},
+        "ILS": {"Israëlische nieuwe shekel", "₪", "₪", Count{"", ""}, 0, 0},
+        "INR": {"Indiase roepie", "₹", "₹", Count{"", ""}, 0, 0},
+        "IQD": {"Iraakse dinar", "", "", Count{"", ""}, 0, 0},
+        "IRR": {"Iraanse rial", "", "", Count{"", ""}, 0, 0},
+        "ISJ": {"IJslandse kroon (1918–1981)", "", "", Count{"", ""}, 0, 0},
+        "ISK": {"IJslandse kroon", "", "kr", Count{"", ""}, 0, 0},
+        "ITL": {"Italiaanse lire", "", "", Count{"", ""}, 0, 0},
+        "JMD": {"Jamaicaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "JOD": {"Jordaanse dinar", "", "", Count{"", ""}, 0, 0},
+        "JPY": {"Japanse yen", "JP¥", "¥", Count{"Japanse yen", "Japanse yen"}, 0, 0},
+        "KES": {"Keniaanse shilling", "", "", Count{"", ""}, 0, 0},
+        "KGS": {"Kirgizische som", "", "⃀", Count{"", ""}, 0, 0},
+        "KHR": {"Cambodjaanse riel", "", "៛", Count{"", ""}, 0, 0},
+        "KMF": {"Comorese frank", "", "CF", Count{"", ""}, 0, 0},
+        "KPW": {"Noord-Koreaanse won", "", "₩", Count{"", ""}, 0, 0},
+        "KRH": {"Zuid-Koreaanse hwan (1953–1962)", "", "", Count{"", ""}, 0, 0},
+        "KRO": {"Oude Zuid-Koreaanse won (1945–1953)", "", "", Count{"", ""}, 0, 0},
+        "KRW": {"Zuid-Koreaanse won", "₩", "₩", Count{"", ""}, 0, 0},
+        "KWD": {"Koeweitse dinar", "", "", Count{"", ""}, 0, 0},
+        "KYD": {"Kaaimaneilandse dollar", "", "$", Count{"", ""}, 0, 0},
+        "KZT": {"Kazachse tenge", "", "₸", Count{"", ""}, 0, 0},
+        "LAK": {"Laotiaanse kip", "", "₭", Count{"", ""}, 0, 0},
+        "LBP": {"Libanees pond", "", "L£", Count{"", ""}, 0, 0},
+        "LKR": {"Sri Lankaanse roepie", "", "Rs", Count{"", ""}, 0, 0},
+        "LRD": {"Liberiaanse dollar", "", "$", Count{"", ""}, 0, 0},
+        "LSL": {"Lesothaanse loti", "", "", Count{"", ""}, 0, 0},
+        "LTL": {"Litouwse litas", "", "Lt", Count{"", ""}, 0, 0},
+        "LTT": {"Litouwse talonas", "", "", Count{"", ""}, 0, 0},
+        "LUC": {"Luxemburgse convertibele franc", "", "", Count{"", ""}, 0, 0},
+        "LUF": {"Luxemburgse frank", "", "", Count{"", ""}, 0, 0},
+        "LUL": {"Luxemburgse financiële franc", "", "", Count{"", ""}, 0, 0},
+        "LVL": {"Letse lats", "", "Ls", Count{"", ""}, 0, 0},
+        "LVR": {"Letse roebel", "", "", Count{"", ""}, 0, 0},
+        "LYD": {"Libische dinar", "", "", Count{"", ""}, 0, 0},
+        "MAD": {"Marokkaanse dirham", "", "", Count{"", ""}, 0, 0},
+        "MAF": {"Marokkaanse franc", "", "", Count{"", ""}, 0, 0},
+        "MCF": {"Monegaskische frank", "", "", Count{"", ""}, 0, 0},
+        "MDC": {"Moldavische cupon", "", "", Count{"", ""}, 0, 0},
+        "MDL": {"Moldavische leu", "", "", Count{"", ""}, 0, 0},
+        "MGA": {"Malagassische ariary", "", "Ar", Count{"", ""}, 0, 0},
+        "MGF": {"Malagassische franc", "", "", Count{"", ""}, 0, 0},
+        "MKD": {"Macedonische denar", "", "", Count{"", ""}, 0, 0},
+        "MKN": {"Macedonische denar (1992–1993)", "", "", Count{"", ""}, 0, 0},
+        "MLF": {"Malinese franc", "", "", Count{"", ""}, 0, 0},
+        "MMK": {"Myanmarese kyat", "", "K", Count{"", ""}, 0, 0},
+        "MNT": {"Mongoolse tugrik", "", "₮", Count{"", ""}, 0, 0},
+        "MOP": {"Macause pataca", "", "", Count{"", ""}, 0, 0},
+        "MRO": {"Mauritaanse ouguiya (1973–2017)", "", "", Count{"", ""}, 0, 0},
+        "MRU": {"Mauritaanse ouguiya", "", "", Count{"", ""}, 0, 0},
+        "MTL": {"Maltese lire", "", "", Count{"", ""}, 0, 0},
+        "MTP": {"Maltees pond", "", "", Count{"", ""}, 0, 0},
+        "MUR": {"Mauritiaanse roepie", "", "Rs", Count{"", ""}, 0, 0},
+        "MVP": {"Maldivische roepie", "", "", Count{"", ""}, 0, 0},
+        "MVR": {"Maldivische rufiyaa", "", "", Count{"", ""}, 0, 0},
+        "MWK": {"Malawische kwacha", "", "", Count{"", ""}, 0, 0},
+        "MXN": {"Mexicaanse peso", "MX$", "$", Count{"", ""}, 0, 0},
+        "MXP": {"Mexicaanse zilveren peso (1861–1992)", "", "", Count{"", ""}, 0, 0},
+        "MXV": {"Mexicaanse unidad de inversion (UDI)", "", "", Count{"", ""}, 0, 0},
+        "MYR": {"Maleisische ringgit", "", "RM", Count{"", ""}, 0, 0},
+        "MZE": {"Mozambikaanse escudo", "", "", Count{"", ""}, 0, 0},
+        "MZM": {"Oude Mozambikaanse metical", "", "", Count{"", ""}, 0, 0},
+        "MZN": {"Mozambikaanse metical", "", "", Count{"", ""}, 0, 0},
+        "NAD": {"Namibische dollar", "", "$", Count{"", ""}, 0, 0},
+        "NGN": {"Nigeriaanse naira", "", "₦", Count{"", ""}, 0, 0},
+        "NIC": {"Nicaraguaanse córdoba (1988–1991)", "", "", Count{"", ""}, 0, 0},
+        "NIO": {"Nicaraguaanse córdoba", "", "C$", Count{"", ""}, 0, 0},
+        "NLG": {"Nederlandse gulden", "", "", Count{"Nederlandse gulden", "Nederlandse gulden"}, 0, 0},
+        "NOK": {"Noorse kroon", "", "kr", Count{"", ""}, 0, 0},
+        "NPR": {"Nepalese roepie", "", "Rs", Count{"", ""}, 0, 0},
+        "NZD": {"Nieuw-Zeelandse dollar", "NZ$", "$", Count{"", ""}, 0, 0},
+        "OMR": {"Omaanse rial", "", "", Count{"", ""}, 0, 0},
+        "PAB": {"Panamese balboa", "", "", Count{"", ""}, 0, 0},
+        "PEI": {"Peruaanse inti", "", "", Count{"", ""}, 0, 0},
+        "PEN": {"Peruaanse sol", "", "", Count{"", ""}, 0, 0},
+        "PES": {"Peruaanse sol (1863–1965)", "", "", Count{"", ""}, 0, 0},
+        "PGK": {"Papoea-Nieuw-Guinese kina", "", "", Count{"", ""}, 0, 0},
+        "PHP": {"Filipijnse peso", "PHP", "₱", Count{"", ""}, 0, 0},
+        "PKR": {"Pakistaanse roepie", "", "Rs", Count{"", ""}, 0, 0},
+        "PLN": {"Poolse zloty", "", "zł", Count{"", ""}, 0, 0},
+        "PLZ": {"Poolse zloty (1950–1995)", "", "", Count{"", ""}, 0, 0},
+        "PTE": {"Portugese escudo", "", "", Count{"Portugese escudo", "Portugese escudo"}, 0, 0},
+        "PYG": {"Paraguayaanse guarani", "", "₲", Count{"", ""}, 0, 0},
+        "QAR": {"Qatarese rial", "", "", Count{"", ""}, 0, 0},
+        "RHD": {"Rhodesische dollar", "", "", Count{"", ""}, 0, 0},
+        "ROL": {"Oude Roemeense leu", "", "", Count{"", ""}, 0, 0},
+        "RON": {"Roemeense leu", "", "lei", Count{"", ""}, 0, 0},
+        "RSD": {"Servische dinar", "", "", Count{"", ""}, 0, 0},
+        "RUB": {"Russische roebel", "", "₽", Count{"", ""}, 0, 0},
+        "RUR": {"Russische roebel (1991–1998)", "", "р.", Count{"", ""}, 0, 0},
+        "RWF": {"Rwandese frank", "", "RF", Count{"", ""}, 0, 0},
+        "SAR": {"Saoedi-Arabische riyal", "⃁", "", Count{"", ""}, 0, 0},
+        "SBD": {"Salomon-dollar", "SI$", "$", Count{"", ""}, 0, 0},
+        "SCR": {"Seychelse roepie", "", "", Count{"", ""}, 0, 0},
+        "SDD": {"Soedanese dinar", "", "", Count{"", ""}, 0, 0},
+        "SDG": {"Soedanees pond", "", "", Count{"", ""}, 0, 0},
+        "SDP": {"Soedanees pond (1957–1998)", "", "", Count{"", ""}, 0, 0},
+        "SEK": {"Zweedse kroon", "", "kr", Count{"", ""}, 0, 0},
+        "SGD": {"Singaporese dollar", "", "$", Count{"", ""}, 0, 0},
+        "SHP": {"Sint-Heleens pond", "", "£", Count{"", ""}, 0, 0},
+        "SIT": {"Sloveense tolar", "", "", Count{"", ""}, 0, 0},
+        "SKK": {"Slowaakse koruna", "", "", Count{"", ""}, 0, 0},
+        "SLE": {"Sierra Leoonse leone", "", "", Count{"", ""}, 0, 0},
+        "SLL": {"Sierra Leoonse leone (1964–2022)", "", "", Count{"", ""}, 0, 0},
+        "SOS": {"Somalische shilling", "", "", Count{"", ""}, 0, 0},
+        "SRD": {"Surinaamse dollar", "", "$", Count{"", ""}, 0, 0},
+        "SRG": {"Surinaamse gulden", "", "", Count{"", ""}, 0, 0},
+        "SSP": {"Zuid-Soedanees pond", "", "£", Count{"", ""}, 0, 0},
+        "STD": {"Santomese dobra (1977–2017)", "", "", Count{"", ""}, 0, 0},
+        "STN": {"Santomese dobra", "", "Db", Count{"", ""}, 0, 0},
+        "SUR": {"Sovjet-roebel", "", "", Count{"", ""}, 0, 0},
+        "SVC": {"Salvadoraanse colón", "", "", Count{"", ""}, 0, 0},
+        "SYP": {"Syrisch pond", "", "£", Count{"", ""}, 0, 0},
+        "SZL": {"Swazische lilangeni", "", "", Count{"", ""}, 0, 0},
+        "THB": {"Thaise baht", "฿", "฿", Count{"", ""}, 0, 0},
+        "TJR": {"Tadzjikistaanse roebel", "", "", Count{"", ""}, 0, 0},
+        "TJS": {"Tadzjiekse somoni", "", "", Count{"", ""}, 0, 0},
+        "TMM": {"Turkmeense manat (1993–2009)", "", "", Count{"", ""}, 0, 0},
+        "TMT": {"Turkmeense manat", "", "", Count{"", ""}, 0, 0},
+        "TND": {"Tunesische dinar", "", "", Count{"", ""}, 0, 0},
+        "TOP": {"Tongaanse paʻanga", "", "T$", Count{"", ""}, 0, 0},
+        "TPE": {"Timorese escudo", "", "", Count{"", ""}, 0, 0},
+        "TRL": {"Turkse lire", "", "", Count{"", ""}, 0, 0},
+        "TRY": {"Turkse lira", "TL", "₺", Count{"", ""}, 0, 0},
+        "TTD": {"Trinidad en Tobago-dollar", "", "$", Count{"", ""}, 0, 0},
+        "TWD": {"Nieuwe Taiwanese dollar", "NT$", "NT$", Count{"", ""}, 0, 0},
+        "TZS": {"Tanzaniaanse shilling", "", "", Count{"", ""}, 0, 0},
+        "UAH": {"Oekraïense hryvnia", "", "₴", Count{"", ""}, 0, 0},
+        "UAK": {"Oekraïense karbovanetz", "", "", Count{"", ""}, 0, 0},
+        "UGS": {"Oegandese shilling (1966–1987)", "", "", Count{"", ""}, 0, 0},
+        "UGX": {"Oegandese shilling", "", "", Count{"", ""}, 0, 0},
+        "USD": {"Amerikaanse dollar", "US$", "$", Count{"Amerikaanse dollar", "Amerikaanse dollar"}, 0, 0},
+        "USN": {"Amerikaanse dollar (volgende dag)", "", "", Count{"", ""}, 0, 0},
+        "USS": {"Amerikaanse dollar (zelfde dag)", "", "", Count{"", ""}, 0, 0},
+        "UYI": {"Uruguayaanse peso en geïndexeerde eenheden", "", "", Count{"", ""}, 0, 0},
+        "UYP": {"Uruguayaanse peso (1975–1993)", "", "", Count{"", ""}, 0, 0},
+        "UYU": {"Uruguayaanse peso", "", "$", Count{"", ""}, 0, 0},
+        "UYW": {"Uruguayaanse nominale salarisindexeenheid", "", "", Count{"", ""}, 0, 0},
+        "UZS": {"Oezbeekse sum", "", "", Count{"", ""}, 0, 0},
+        "VEB": {"Venezolaanse bolivar (1871–2008)", "", "", Count{"", ""}, 0, 0},
+        "VED": {"Bolívar Soberano", "", "", Count{"", ""}, 0, 0},
+        "VEF": {"Venezolaanse bolivar (2008–2018)", "", "Bs", Count{"", ""}, 0, 0},
+        "VES": {"Venezolaanse bolivar", "", "", Count{"", ""}, 0, 0},
+        "VND": {"Vietnamese dong", "₫", "₫", Count{"", ""}, 0, 0},
+        "VNN": {"Vietnamese dong (1978–1985)", "", "", Count{"", ""}, 0, 0},
+        "VUV": {"Vanuatuaanse vatu", "", "", Count{"", ""}, 0, 0},
+        "WST": {"Samoaanse tala", "", "", Count{"", ""}, 0, 0},
+        "XAF": {"CFA-frank", "FCFA", "", Count{"", ""}, 0, 0},
+        "XAG": {"Zilver", "", "", Count{"", ""}, 0, 0},
+        "XAU": {"Goud", "", "", Count{"", ""}, 0, 0},
+        "XBA": {"Europese samengestelde eenheid", "", "", Count{"", ""}, 0, 0},
+        "XBB": {"Europese monetaire eenheid", "", "", Count{"", ""}, 0, 0},
+        "XBC": {"Europese rekeneenheid (XBC)", "", "", Count{"", ""}, 0, 0},
+        "XBD": {"Europese rekeneenheid (XBD)", "", "", Count{"", ""}, 0, 0},
+        "XCD": {"Oost-Caribische dollar", "EC$", "$", Count{"", ""}, 0, 0},
+        "XCG": {"Caribische gulden", "Cg.", "Cg", Count{"", ""}, 0, 0},
+        "XDR": {"Special Drawing Rights", "", "", Count{"", ""}, 0, 0},
+        "XEU": {"European Currency Unit", "", "", Count{"", ""}, 0, 0},
+        "XFO": {"Franse gouden franc", "", "", Count{"", ""}, 0, 0},
+        "XFU": {"Franse UIC-franc", "", "", Count{"", ""}, 0, 0},
+        "XOF": {"CFA-franc BCEAO", "F CFA", "", Count{"", ""}, 0, 0},
+        "XPD": {"Palladium", "", "", Count{"", ""}, 0, 0},
+        "XPF": {"CFP-frank", "XPF", "", Count{"", ""}, 0, 0},
+        "XPT": {"Platina", "", "", Count{"", ""}, 0, 0},
+        "XRE": {"RINET-fondsen", "", "", Count{"", ""}, 0, 0},
+        "XSU": {"Sucre", "", "", Count{"", ""}, 0, 0},
+        "XTS": {"Valutacode voor testdoeleinden", "", "", Count{"", ""}, 0, 0},
+        "XUA": {"ADB-rekeneenheid", "", "", Count{"", ""}, 0, 0},
+        "XXX": {"onbekende munteenheid", "XXX", "", Count{"", ""}, 0, 0},
+        "YDD": {"Jemenitische dinar", "", "", Count{"", ""}, 0, 0},
+        "YER": {"Jemenitische rial", "", "", Count{"", ""}, 0, 0},
+        "YUD": {"Joegoslavische harde dinar", "", "", Count{"", ""}, 0, 0},
+        "YUM": {"Joegoslavische noviy-dinar", "", "", Count{"", ""}, 0, 0},
+        "YUN": {"Joegoslavische convertibele dinar", "", "", Count{"", ""}, 0, 0},
+        "YUR": {"Joegoslavische hervormde dinar (1992–1993)", "", "", Count{"", ""}, 0, 0},
+        "ZAL": {"Zuid-Afrikaanse rand (financieel)", "", "", Count{"", ""}, 0, 0},
+        "ZAR": {"Zuid-Afrikaanse rand", "", "R", Count{"", ""}, 0, 0},
+        "ZMK": {"Zambiaanse kwacha (1968–2012)", "", "", Count{"", ""}, 0, 0},
+        "ZMW": {"Zambiaanse kwacha", "", "ZK", Count{"", ""}, 0, 0},
+        "ZRN": {"Zaïrese nieuwe zaïre", "", "", Count{"", ""}, 0, 0},
+        "ZRZ": {"Zaïrese zaïre", "", "", Count{"", ""}, 0, 0},
+        "ZWD": {"Zimbabwaanse dollar", "", "", Count{"", ""}, 0, 0},
+        "ZWG": {"Zimbabwe Gold", "ZiG", "", Count{"", ""}, 0, 0},
+        "ZWL": {"Zimbabwaanse dollar (2009)", "", "", Count{"", ""}, 0, 0},
+        "ZWR": {"Zimbabwaanse dollar (2008)", "", "", Count{"", ""}, 0, 0},
     }, map[string]Unit{
         "duration-century": {Count{"", ""}, Count{"{0} eeuw", "{0} eeuwen"}, Count{"", ""}},
         "duration-day": {Count{"", ""}, Count{"{0} dag", "{0} dagen"}, Count{"{0} d", "{0} d"}},
@@ -6832,8 +8218,265 @@ var locales = map[string]Locale{
         "ZM": "Zambia",
         "ZW": "Zimbabwe",
         "ZZ": "onbekend gebied",
-    }},
-    "root": {"#,##0.###", CurrencyFormat{"¤ #,##0.00", "#,##0.00", "¤ #,##0.00"}, CalendarFormat{"y MMMM d, EEEE", "y MMMM d", "y MMM d", "y-MM-dd"}, CalendarFormat{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"}, CalendarFormat{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"}, map[string]map[string]string{
+    }, ListPattern{"{0}, {1}", "{0}, {1}", "{0} en {1}", "{0} en {1}"}, CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}}, "#,##0%", 37, map[string]RelativeTime{
+        "day": {map[string]string{
+            "-1": "gisteren",
+            "-2": "eergisteren",
+            "0": "vandaag",
+            "1": "morgen",
+            "2": "overmorgen",
+        }, Count{"over {0} dag", "over {0} dagen"}, Count{"{0} dag geleden", "{0} dagen geleden"}},
+        "day-narrow": {map[string]string{
+            "-1": "gisteren",
+            "-2": "eergisteren",
+            "0": "vandaag",
+            "1": "morgen",
+            "2": "overmorgen",
+        }, Count{"over {0} dag", "over {0} dgn"}, Count{"{0} dag geleden", "{0} dgn geleden"}},
+        "day-short": {map[string]string{
+            "-1": "gisteren",
+            "-2": "eergisteren",
+            "0": "vandaag",
+            "1": "morgen",
+            "2": "overmorgen",
+        }, Count{"over {0} dag", "over {0} dgn"}, Count{"{0} dag geleden", "{0} dgn geleden"}},
+        "hour": {map[string]string{
+            "0": "binnen een uur",
+        }, Count{"over {0} uur", "over {0} uur"}, Count{"{0} uur geleden", "{0} uur geleden"}},
+        "hour-narrow": {map[string]string{
+            "0": "binnen een uur",
+        }, Count{"over {0} uur", "over {0} uur"}, Count{"{0} uur geleden", "{0} uur geleden"}},
+        "hour-short": {map[string]string{
+            "0": "binnen een uur",
+        }, Count{"over {0} uur", "over {0} uur"}, Count{"{0} uur geleden", "{0} uur geleden"}},
+        "minute": {map[string]string{
+            "0": "binnen een minuut",
+        }, Count{"over {0} minuut", "over {0} minuten"}, Count{"{0} minuut geleden", "{0} minuten geleden"}},
+        "minute-narrow": {map[string]string{
+            "0": "binnen een minuut",
+        }, Count{"over {0} min.", "over {0} min."}, Count{"{0} min. geleden", "{0} min. geleden"}},
+        "minute-short": {map[string]string{
+            "0": "binnen een minuut",
+        }, Count{"over {0} min.", "over {0} min."}, Count{"{0} min. geleden", "{0} min. geleden"}},
+        "month": {map[string]string{
+            "-1": "vorige maand",
+            "0": "deze maand",
+            "1": "volgende maand",
+        }, Count{"over {0} maand", "over {0} maanden"}, Count{"{0} maand geleden", "{0} maanden geleden"}},
+        "month-narrow": {map[string]string{
+            "-1": "vorige maand",
+            "0": "deze maand",
+            "1": "volgende maand",
+        }, Count{"over {0} mnd", "over {0} mnd"}, Count{"{0} mnd geleden", "{0} mnd geleden"}},
+        "month-short": {map[string]string{
+            "-1": "vorige maand",
+            "0": "deze maand",
+            "1": "volgende maand",
+        }, Count{"over {0} mnd", "over {0} mnd"}, Count{"{0} mnd geleden", "{0} mnd geleden"}},
+        "quarter": {map[string]string{
+            "-1": "vorig kwartaal",
+            "0": "dit kwartaal",
+            "1": "volgend kwartaal",
+        }, Count{"over {0} kwartaal", "over {0} kwartalen"}, Count{"{0} kwartaal geleden", "{0} kwartalen geleden"}},
+        "quarter-narrow": {map[string]string{
+            "-1": "vorig kwartaal",
+            "0": "dit kwartaal",
+            "1": "volgend kwartaal",
+        }, Count{"over {0} kw.", "over {0} kw."}, Count{"{0} kw. geleden", "{0} kw. geleden"}},
+        "quarter-short": {map[string]string{
+            "-1": "vorig kwartaal",
+            "0": "dit kwartaal",
+            "1": "volgend kwartaal",
+        }, Count{"over {0} kw.", "over {0} kw."}, Count{"{0} kw. geleden", "{0} kw. geleden"}},
+        "second": {map[string]string{
+            "0": "nu",
+        }, Count{"over {0} seconde", "over {0} seconden"}, Count{"{0} seconde geleden", "{0} seconden geleden"}},
+        "second-narrow": {map[string]string{
+            "0": "nu",
+        }, Count{"over {0} sec.", "over {0} sec."}, Count{"{0} sec. geleden", "{0} sec. geleden"}},
+        "second-short": {map[string]string{
+            "0": "nu",
+        }, Count{"over {0} sec.", "over {0} sec."}, Count{"{0} sec. geleden", "{0} sec. geleden"}},
+        "week": {map[string]string{
+            "-1": "vorige week",
+            "0": "deze week",
+            "1": "volgende week",
+        }, Count{"over {0} week", "over {0} weken"}, Count{"{0} week geleden", "{0} weken geleden"}},
+        "week-narrow": {map[string]string{
+            "-1": "vorige week",
+            "0": "deze week",
+            "1": "volgende week",
+        }, Count{"over {0} wk", "over {0} wk"}, Count{"{0} wk geleden", "{0} wk geleden"}},
+        "week-short": {map[string]string{
+            "-1": "vorige week",
+            "0": "deze week",
+            "1": "volgende week",
+        }, Count{"over {0} wk", "over {0} wk"}, Count{"{0} wk geleden", "{0} wk geleden"}},
+        "year": {map[string]string{
+            "-1": "vorig jaar",
+            "0": "dit jaar",
+            "1": "volgend jaar",
+        }, Count{"over {0} jaar", "over {0} jaar"}, Count{"{0} jaar geleden", "{0} jaar geleden"}},
+        "year-narrow": {map[string]string{
+            "-1": "vorig jaar",
+            "0": "dit jaar",
+            "1": "volgend jaar",
+        }, Count{"over {0} jaar", "over {0} jaar"}, Count{"{0} jaar geleden", "{0} jaar geleden"}},
+        "year-short": {map[string]string{
+            "-1": "vorig jaar",
+            "0": "dit jaar",
+            "1": "volgend jaar",
+        }, Count{"over {0} jaar", "over {0} jaar"}, Count{"{0} jaar geleden", "{0} jaar geleden"}},
+    }, map[string]string{
+        "Bh": "h B",
+        "Bhm": "h:mm B",
+        "Bhms": "h:mm:ss B",
+        "E": "ccc",
+        "EBhm": "E h:mm B",
+        "EBhms": "E h:mm:ss B",
+        "EHm": "E HH:mm",
+        "EHms": "E HH:mm:ss",
+        "Ed": "E d",
+        "Ehm": "E h:mm a",
+        "Ehms": "E h:mm:ss a",
+        "Gy": "y G",
+        "GyMMM": "MMM y G",
+        "GyMMMEd": "E d MMM y G",
+        "GyMMMd": "d MMM y G",
+        "GyMd": "d-M-y GGGGG",
+        "H": "HH",
+        "Hm": "HH:mm",
+        "Hms": "HH:mm:ss",
+        "Hmsv": "HH:mm:ss v",
+        "Hmv": "HH:mm v",
+        "M": "L",
+        "MEd": "E d-M",
+        "MMM": "LLL",
+        "MMMEd": "E d MMM",
+        "MMMMEd": "E d MMMM",
+        "MMMMd": "d MMMM",
+        "MMMd": "d MMM",
+        "Md": "d-M",
+        "d": "d",
+        "h": "h a",
+        "hm": "h:mm a",
+        "hms": "h:mm:ss a",
+        "hmsv": "h:mm:ss a v",
+        "hmv": "h:mm a v",
+        "ms": "mm:ss",
+        "y": "y",
+        "yM": "M-y",
+        "yMEd": "E d-M-y",
+        "yMM": "MM-y",
+        "yMMM": "MMM y",
+        "yMMMEd": "E d MMM y",
+        "yMMMM": "MMMM y",
+        "yMMMMEd": "E d MMMM y",
+        "yMMMMd": "d MMMM y",
+        "yMMMd": "d MMM y",
+        "yMd": "d-M-y",
+        "yQQQ": "QQQ y",
+        "yQQQQ": "QQQQ y",
+    }, [2]CalendarSymbol{
+        {"voor Christus", "v.Chr.", "v.C.", ""},
+        {"na Christus", "n.Chr.", "n.C.", ""},
+    }, [4]CalendarSymbol{
+        {"1e kwartaal", "K1", "1", ""},
+        {"2e kwartaal", "K2", "2", ""},
+        {"3e kwartaal", "K3", "3", ""},
+        {"4e kwartaal", "K4", "4", ""},
+    }, [12]CalendarSymbol{
+        {"januari", "jan", "J", ""},
+        {"februari", "feb", "F", ""},
+        {"maart", "mrt", "M", ""},
+        {"april", "apr", "A", ""},
+        {"mei", "mei", "M", ""},
+        {"juni", "jun", "J", ""},
+        {"juli", "jul", "J", ""},
+        {"augustus", "aug", "A", ""},
+        {"september", "sep", "S", ""},
+        {"oktober", "okt", "O", ""},
+        {"november", "nov", "N", ""},
+        {"december", "dec", "D", ""},
+    }, [7]CalendarSymbol{
+        {"zondag", "zo", "Z", "zo"},
+        {"maandag", "ma", "M", "ma"},
+        {"dinsdag", "di", "D", "di"},
+        {"woensdag", "wo", "W", "wo"},
+        {"donderdag", "do", "D", "do"},
+        {"vrijdag", "vr", "V", "vr"},
+        {"zaterdag", "za", "Z", "za"},
+    }, [4]CalendarSymbol{
+        {"1e kwartaal", "K1", "1", ""},
+        {"2e kwartaal", "K2", "2", ""},
+        {"3e kwartaal", "K3", "3", ""},
+        {"4e kwartaal", "K4", "4", ""},
+    }, "gregorian", map[string]Calendar{
+        "buddhist": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Boeddhistische jaartelling", "B.E.", "B.E.", ""},
+        }},
+        "hebrew": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{
+            {"Tisjrie", "Tisjrie", "1", ""},
+            {"Chesjwan", "Chesjwan", "2", ""},
+            {"Kislev", "Kislev", "3", ""},
+            {"Tevet", "Tevet", "4", ""},
+            {"Sjevat", "Sjevat", "5", ""},
+            {"Adar A", "Adar A", "6", ""},
+            {"Adar", "Adar", "7", ""},
+            {"Nisan", "Nisan", "8", ""},
+            {"Ijar", "Ijar", "9", ""},
+            {"Sivan", "Sivan", "10", ""},
+            {"Tammoez", "Tammoez", "11", ""},
+            {"Av", "Av", "12", ""},
+            {"Elloel", "Elloel", "13", ""},
+            {"Adar B", "Adar B", "7", ""},
+        }, []CalendarSymbol{
+            {"Anno Mundi", "A.M.", "A.M.", ""},
+        }},
+        "islamic": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{
+            {"Moeharram", "Moeh.", "1", ""},
+            {"Safar", "Saf.", "2", ""},
+            {"Rabiʻa al awal", "Rab. I", "3", ""},
+            {"Rabiʻa al thani", "Rab. II", "4", ""},
+            {"Joemadʻal awal", "Joem. I", "5", ""},
+            {"Joemadʻal thani", "Joem. II", "6", ""},
+            {"Rajab", "Raj.", "7", ""},
+            {"Sjaʻaban", "Sja.", "8", ""},
+            {"Ramadan", "Ram.", "9", ""},
+            {"Sjawal", "Sjaw.", "10", ""},
+            {"Doe al kaʻaba", "Doe al k.", "11", ""},
+            {"Doe al hizja", "Doe al h.", "12", ""},
+        }, []CalendarSymbol{
+            {"Saʻna Hizjria", "AH", "AH", ""},
+        }},
+        "japanese": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Meiji", "Meiji", "M", ""},
+            {"Taishō", "Taishō", "T", ""},
+            {"Shōwa", "Shōwa", "S", ""},
+            {"Heisei", "Heisei", "H", ""},
+            {"Reiwa", "Reiwa", "R", ""},
+        }},
+        "persian": {CalendarFormat{"EEEE d MMMM y G", "d MMMM y G", "d MMM y G", "dd-MM-y GGGGG"}, []CalendarSymbol{
+            {"Farvardin", "Farvardin", "1", ""},
+            {"Ordibehesht", "Ordibehesht", "2", ""},
+            {"Khordad", "Khordad", "3", ""},
+            {"Tir", "Tir", "4", ""},
+            {"Mordad", "Mordad", "5", ""},
+            {"Shahrivar", "Shahrivar", "6", ""},
+            {"Mehr", "Mehr", "7", ""},
+            {"Aban", "Aban", "8", ""},
+            {"Azar", "Azar", "9", ""},
+            {"Dey", "Dey", "10", ""},
+            {"Bahman", "Bahman", "11", ""},
+            {"Esfand", "Esfand", "12", ""},
+        }, []CalendarSymbol{
+            {"Anno Persico", "AP", "AP", ""},
+        }},
+    }, 1, 4, "H", []string{
+        "H",
+        "hB",
+    }, "latn", "", "metric", "", "GMT{0}", "GMT", "+HH:mm;-HH:mm", "{0}-tijd", "zomertijd {0}", "standaardtijd {0}", "{1} ({0})"},
+    "root": {"#,##0.###", CurrencyFormat{"¤ #,##0.00", "#,##0.00", "¤ #,##0.00", Count{"", "{0} {1}"}}, CalendarFormat{"y MMMM d, EEEE", "y MMMM d", "y MMM d", "y-MM-dd"}, CalendarFormat{"HH:mm:ss zzzz", "HH:mm:ss z", "HH:mm:ss", "HH:mm"}, CalendarFormat{"{1} {0}", "{1} {0}", "{1} {0}", "{1} {0}"}, map[string]map[string]string{
         "": {
             "": "{0} – {1}",
         },
@@ -6977,29 +8620,29 @@ var locales = map[string]Locale{
             "y": "y-MM-dd – y-MM-dd",
         },
     }, "{0} {1}", 46, 44, 46, 44, 43, 45, 58, [12]CalendarSymbol{
-        {"M01", "M01", "1"},
-        {"M02", "M02", "2"},
-        {"M03", "M03", "3"},
-        {"M04", "M04", "4"},
-        {"M05", "M05", "5"},
-        {"M06", "M06", "6"},
-        {"M07", "M07", "7"},
-        {"M08", "M08", "8"},
-        {"M09", "M09", "9"},
-        {"M10", "M10", "10"},
-        {"M11", "M11", "11"},
-        {"M12", "M12", "12"},
+        {"M01", "M01", "1", ""},
+        {"M02", "M02", "2", ""},
+        {"M03", "M03", "3", ""},
+        {"M04", "M04", "4", ""},
+        {"M05", "M05", "5", ""},
+        {"M06", "M06", "6", ""},
+        {"M07", "M07", "7", ""},
+        {"M08", "M08", "8", ""},
+        {"M09", "M09", "9", ""},
+        {"M10", "M10", "10", ""},
+        {"M11", "M11", "11", ""},
+        {"M12", "M12", "12", ""},
     }, [7]CalendarSymbol{
-        {"Sun", "Sun", "S"},
-        {"Mon", "Mon", "M"},
-        {"Tue", "Tue", "T"},
-        {"Wed", "Wed", "W"},
-        {"Thu", "Thu", "T"},
-        {"Fri", "Fri", "F"},
-        {"Sat", "Sat", "S"},
+        {"Sun", "Sun", "S", "Sun"},
+        {"Mon", "Mon", "M", "Mon"},
+        {"Tue", "Tue", "T", "Tue"},
+        {"Wed", "Wed", "W", "Wed"},
+        {"Thu", "Thu", "T", "Thu"},
+        {"Fri", "Fri", "F", "Fri"},
+        {"Sat", "Sat", "S", "Sat"},
     }, map[string]DayPeriodRule{}, map[string]CalendarSymbol{
-        "am": {"AM", "AM", "AM"},
-        "pm": {"PM", "PM", "PM"},
+        "am": {"AM", "AM", "AM", ""},
+        "pm": {"PM", "PM", "PM", ""},
     }, map[string]string{
         "Africa/Asmera": "Asmara",
         "Africa/El_Aaiun": "El Aaiún",
@@ -7074,113 +8717,113 @@ var locales = map[string]Locale{
         "Pacific/Truk": "Chuuk",
         "Pacific/Wallis": "Wallis & Futuna",
     }, map[string]Metazone{}, map[string]Currency{
-        "AFN": {"", "", "؋"},
-        "AMD": {"", "", "֏"},
-        "AOA": {"", "", "Kz"},
-        "ARS": {"", "", "$"},
-        "AUD": {"", "A$", "$"},
-        "AZN": {"", "", "₼"},
-        "BAM": {"", "", "KM"},
-        "BBD": {"", "", "$"},
-        "BDT": {"", "", "৳"},
-        "BMD": {"", "", "$"},
-        "BND": {"", "", "$"},
-        "BOB": {"", "", "Bs"},
-        "BRL": {"", "R$", "R$"},
-        "BSD": {"", "", "$"},
-        "BWP": {"", "", "P"},
-        "BZD": {"", "", "$"},
-        "CAD": {"", "CA$", "$"},
-        "CLP": {"", "", "$"},
-        "CNY": {"", "CN¥", "¥"},
-        "COP": {"", "", "$"},
-        "CRC": {"", "", "₡"},
-        "CUC": {"", "", "$"},
-        "CUP": {"", "", "$"},
-        "CZK": {"", "", "Kč"},
-        "DKK": {"", "", "kr"},
-        "DOP": {"", "", "$"},
-        "EGP": {"", "", "E£"},
-        "ESP": {"", "", "₧"},
-        "EUR": {"", "€", "€"},
-        "FJD": {"", "", "$"},
-        "FKP": {"", "", "£"},
-        "GBP": {"", "£", "£"},
-        "GEL": {"", "", "₾"},
-        "GHS": {"", "", "GH₵"},
-        "GIP": {"", "", "£"},
-        "GNF": {"", "", "FG"},
-        "GTQ": {"", "", "Q"},
-        "GYD": {"", "", "$"},
-        "HKD": {"", "HK$", "$"},
-        "HNL": {"", "", "L"},
-        "HRK": {"", "", "kn"},
-        "HUF": {"", "", "Ft"},
-        "IDR": {"", "", "Rp"},
-        "ILS": {"", "₪", "₪"},
-        "INR": {"", "₹", "₹"},
-        "ISK": {"", "", "kr"},
-        "JMD": {"", "", "$"},
-        "JPY": {"", "JP¥", "¥"},
-        "KGS": {"", "", "⃀"},
-        "KHR": {"", "", "៛"},
-        "KMF": {"", "", "CF"},
-        "KPW": {"", "", "₩"},
-        "KRW": {"", "₩", "₩"},
-        "KYD": {"", "", "$"},
-        "KZT": {"", "", "₸"},
-        "LAK": {"", "", "₭"},
-        "LBP": {"", "", "L£"},
-        "LKR": {"", "", "Rs"},
-        "LRD": {"", "", "$"},
-        "LTL": {"", "", "Lt"},
-        "LVL": {"", "", "Ls"},
-        "MGA": {"", "", "Ar"},
-        "MMK": {"", "", "K"},
-        "MNT": {"", "", "₮"},
-        "MUR": {"", "", "Rs"},
-        "MXN": {"", "MX$", "$"},
-        "MYR": {"", "", "RM"},
-        "NAD": {"", "", "$"},
-        "NGN": {"", "", "₦"},
-        "NIO": {"", "", "C$"},
-        "NOK": {"", "", "kr"},
-        "NPR": {"", "", "Rs"},
-        "NZD": {"", "NZ$", "$"},
-        "PHP": {"", "₱", "₱"},
-        "PKR": {"", "", "Rs"},
-        "PLN": {"", "", "zł"},
-        "PYG": {"", "", "₲"},
-        "RON": {"", "", "lei"},
-        "RUB": {"", "", "₽"},
-        "RWF": {"", "", "RF"},
-        "SAR": {"", "⃁", ""},
-        "SBD": {"", "", "$"},
-        "SEK": {"", "", "kr"},
-        "SGD": {"", "", "$"},
-        "SHP": {"", "", "£"},
-        "SRD": {"", "", "$"},
-        "SSP": {"", "", "£"},
-        "STN": {"", "", "Db"},
-        "SYP": {"", "", "£"},
-        "THB": {"", "", "฿"},
-        "TOP": {"", "", "T$"},
-        "TRY": {"", "TL", "₺"},
-        "TTD": {"", "", "$"},
-        "TWD": {"", "NT$", "$"},
-        "UAH": {"", "", "₴"},
-        "USD": {"", "US$", "$"},
-        "UYU": {"", "", "$"},
-        "VEF": {"", "", "Bs"},
-        "VND": {"", "₫", "₫"},
-        "XAF": {"", "FCFA", ""},
-        "XCD": {"", "EC$", "$"},
-        "XCG": {"", "Cg.", ""},
-        "XOF": {"", "F CFA", ""},
-        "XPF": {"", "CFPF", ""},
-        "XXX": {"", "¤", ""},
-        "ZAR": {"", "", "R"},
-        "ZMW": {"", "", "ZK"},
+        "AFN": {"", "", "؋", Count{"", ""}, 0, 0},
+        "AMD": {"", "", "֏", Count{"", ""}, 0, 0},
+        "AOA": {"", "", "Kz", Count{"", ""}, 0, 0},
+        "ARS": {"", "", "$", Count{"", ""}, 0, 0},
+        "AUD": {"", "A$", "$", Count{"", ""}, 0, 0},
+        "AZN": {"", "", "₼", Count{"", ""}, 0, 0},
+        "BAM": {"", "", "KM", Count{"", ""}, 0, 0},
+        "BBD": {"", "", "$", Count{"", ""}, 0, 0},
+        "BDT": {"", "", "৳", Count{"", ""}, 0, 0},
+        "BMD": {"", "", "$", Count{"", ""}, 0, 0},
+        "BND": {"", "", "$", Count{"", ""}, 0, 0},
+        "BOB": {"", "", "Bs", Count{"", ""}, 0, 0},
+        "BRL": {"", "R$", "R$", Count{"", ""}, 0, 0},
+        "BSD": {"", "", "$", Count{"", ""}, 0, 0},
+        "BWP": {"", "", "P", Count{"", ""}, 0, 0},
+        "BZD": {"", "", "$", Count{"", ""}, 0, 0},
+        "CAD": {"", "CA$", "$", Count{"", ""}, 0, 0},
+        "CLP": {"", "", "$", Count{"", ""}, 0, 0},
+        "CNY": {"", "CN¥", "¥", Count{"", ""}, 0, 0},
+        "COP": {"", "", "$", Count{"", ""}, 0, 0},
+        "CRC": {"", "", "₡", Count{"", ""}, 0, 0},
+        "CUC": {"", "", "$", Count{"", ""}, 0, 0},
+        "CUP": {"", "", "$", Count{"", ""}, 0, 0},
+        "CZK": {"", "", "Kč", Count{"", ""}, 0, 0},
+        "DKK": {"", "", "kr", Count{"", ""}, 0, 0},
+        "DOP": {"", "", "$", Count{"", ""}, 0, 0},
+        "EGP": {"", "", "E£", Count{"", ""}, 0, 0},
+        "ESP": {"", "", "₧", Count{"", ""}, 0, 0},
+        "EUR": {"", "€", "€", Count{"", ""}, 0, 0},
+        "FJD": {"", "", "$", Count{"", ""}, 0, 0},
+        "FKP": {"", "", "£", Count{"", ""}, 0, 0},
+        "GBP": {"", "£", "£", Count{"", ""}, 0, 0},
+        "GEL": {"", "", "₾", Count{"", ""}, 0, 0},
+        "GHS": {"", "", "GH₵", Count{"", ""}, 0, 0},
+        "GIP": {"", "", "£", Count{"", ""}, 0, 0},
+        "GNF": {"", "", "FG", Count{"", ""}, 0, 0},
+        "GTQ": {"", "", "Q", Count{"", ""}, 0, 0},
+        "GYD": {"", "", "$", Count{"", ""}, 0, 0},
+        "HKD": {"", "HK$", "$", Count{"", ""}, 0, 0},
+        "HNL": {"", "", "L", Count{"", ""}, 0, 0},
+        "HRK": {"", "", "kn", Count{"", ""}, 0, 0},
+        "HUF": {"", "", "Ft", Count{"", ""}, 0, 0},
+        "IDR": {"", "", "Rp", Count{"", ""}, 0, 0},
+        "ILS": {"", "₪", "₪", Count{"", ""}, 0, 0},
+        "INR": {"", "₹", "₹", Count{"", ""}, 0, 0},
+        "ISK": {"", "", "kr", Count{"", ""}, 0, 0},
+        "JMD": {"", "", "$", Count{"", ""}, 0, 0},
+        "JPY": {"", "JP¥", "¥", Count{"", ""}, 0, 0},
+        "KGS": {"", "", "⃀", Count{"", ""}, 0, 0},
+        "KHR": {"", "", "៛", Count{"", ""}, 0, 0},
+        "KMF": {"", "", "CF", Count{"", ""}, 0, 0},
+        "KPW": {"", "", "₩", Count{"", ""}, 0, 0},
+        "KRW": {"", "₩", "₩", Count{"", ""}, 0, 0},
+        "KYD": {"", "", "$", Count{"", ""}, 0, 0},
+        "KZT": {"", "", "₸", Count{"", ""}, 0, 0},
+        "LAK": {"", "", "₭", Count{"", ""}, 0, 0},
+        "LBP": {"", "", "L£", Count{"", ""}, 0, 0},
+        "LKR": {"", "", "Rs", Count{"", ""}, 0, 0},
+        "LRD": {"", "", "$", Count{"", ""}, 0, 0},
+        "LTL": {"", "", "Lt", Count{"", ""}, 0, 0},
+        "LVL": {"", "", "Ls", Count{"", ""}, 0, 0},
+        "MGA": {"", "", "Ar", Count{"", ""}, 0, 0},
+        "MMK": {"", "", "K", Count{"", ""}, 0, 0},
+        "MNT": {"", "", "₮", Count{"", ""}, 0, 0},
+        "MUR": {"", "", "Rs", Count{"", ""}, 0, 0},
+        "MXN": {"", "MX$", "$", Count{"", ""}, 0, 0},
+        "MYR": {"", "", "RM", Count{"", ""}, 0, 0},
+        "NAD": {"", "", "$", Count{"", ""}, 0, 0},
+        "NGN": {"", "", "₦", Count{"", ""}, 0, 0},
+        "NIO": {"", "", "C$", Count{"", ""}, 0, 0},
+        "NOK": {"", "", "kr", Count{"", ""}, 0, 0},
+        "NPR": {"", "", "Rs", Count{"", ""}, 0, 0},
+        "NZD": {"", "NZ$", "$", Count{"", ""}, 0, 0},
+        "PHP": {"", "₱", "₱", Count{"", ""}, 0, 0},
+        "PKR": {"", "", "Rs", Count{"", ""}, 0, 0},
+        "PLN": {"", "", "zł", Count{"", ""}, 0, 0},
+        "PYG": {"", "", "₲", Count{"", ""}, 0, 0},
+        "RON": {"", "", "lei", Count{"", ""}, 0, 0},
+        "RUB": {"", "", "₽", Count{"", ""}, 0, 0},
+        "RWF": {"", "", "RF", Count{"", ""}, 0, 0},
+        "SAR": {"", "⃁", "", Count{"", ""}, 0, 0},
+        "SBD": {"", "", "$", Count{"", ""}, 0, 0},
+        "SEK": {"", "", "kr", Count{"", ""}, 0, 0},
+        "SGD": {"", "", "$", Count{"", ""}, 0, 0},
+        "SHP": {"", "", "£", Count{"", ""}, 0, 0},
+        "SRD": {"", "", "$", Count{"", ""}, 0, 0},
+        "SSP": {"", "", "£", Count{"", ""}, 0, 0},
+        "STN": {"", "", "Db", Count{"", ""}, 0, 0},
+        "SYP": {"", "", "£", Count{"", ""}, 0, 0},
+        "THB": {"", "", "฿", Count{"", ""}, 0, 0},
+        "TOP": {"", "", "T$", Count{"", ""}, 0, 0},
+        "TRY": {"", "TL", "₺", Count{"", ""}, 0, 0},
+        "TTD": {"", "", "$", Count{"", ""}, 0, 0},
+        "TWD": {"", "NT$", "$", Count{"", ""}, 0, 0},
+        "UAH": {"", "", "₴", Count{"", ""}, 0, 0},
+        "USD": {"", "US$", "$", Count{"", ""}, 0, 0},
+        "UYU": {"", "", "$", Count{"", ""}, 0, 0},
+        "VEF": {"", "", "Bs", Count{"", ""}, 0, 0},
+        "VND": {"", "₫", "₫", Count{"", ""}, 0, 0},
+        "XAF": {"", "FCFA", "", Count{"", ""}, 0, 0},
+        "XCD": {"", "EC$", "$", Count{"", ""}, 0, 0},
+        "XCG": {"", "Cg.", "", Count{"", ""}, 0, 0},
+        "XOF": {"", "F CFA", "", Count{"", ""}, 0, 0},
+        "XPF": {"", "CFPF", "", Count{"", ""}, 0, 0},
+        "XXX": {"", "¤", "", Count{"", ""}, 0, 0},
+        "ZAR": {"", "", "R", Count{"", ""}, 0, 0},
+        "ZMW": {"", "", "ZK", Count{"", ""}, 0, 0},
     }, map[string]Unit{
         "duration-century": {Count{"", ""}, Count{"", "{0} c"}, Count{"", ""}},
         "duration-day": {Count{"", ""}, Count{"", "{0} d"}, Count{"", ""}},
@@ -7201,7 +8844,254 @@ var locales = map[string]Locale{
         "duration-week-person": {Count{"", "{0} w"}, Count{"", "{0} w"}, Count{"", "{0} w"}},
         "duration-year": {Count{"", ""}, Count{"", "{0} y"}, Count{"", ""}},
         "duration-year-person": {Count{"", "{0} y"}, Count{"", "{0} y"}, Count{"", "{0} y"}},
-    }, map[string]string{}},
+    }, map[string]string{}, ListPattern{"{0}, {1}", "{0}, {1}", "{0}, {1}", "{0}, {1}"}, CurrencySpacing{CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}, CurrencySpacingRule{"[[:^S:]&[:^Z:]]", "[:digit:]", " "}}, "#,##0%", 37, map[string]RelativeTime{
+        "day": {map[string]string{
+            "-1": "yesterday",
+            "0": "today",
+            "1": "tomorrow",
+        }, Count{"", "+{0} d"}, Count{"", "-{0} d"}},
+        "day-narrow": {map[string]string{
+            "-1": "yesterday",
+            "0": "today",
+            "1": "tomorrow",
+        }, Count{"", "+{0} d"}, Count{"", "-{0} d"}},
+        "day-short": {map[string]string{
+            "-1": "yesterday",
+            "0": "today",
+            "1": "tomorrow",
+        }, Count{"", "+{0} d"}, Count{"", "-{0} d"}},
+        "hour": {map[string]string{
+            "0": "this hour",
+        }, Count{"", "+{0} h"}, Count{"", "-{0} h"}},
+        "hour-narrow": {map[string]string{
+            "0": "this hour",
+        }, Count{"", "+{0} h"}, Count{"", "-{0} h"}},
+        "hour-short": {map[string]string{
+            "0": "this hour",
+        }, Count{"", "+{0} h"}, Count{"", "-{0} h"}},
+        "minute": {map[string]string{
+            "0": "this minute",
+        }, Count{"", "+{0} min"}, Count{"", "-{0} min"}},
+        "minute-narrow": {map[string]string{
+            "0": "this minute",
+        }, Count{"", "+{0} min"}, Count{"", "-{0} min"}},
+        "minute-short": {map[string]string{
+            "0": "this minute",
+        }, Count{"", "+{0} min"}, Count{"", "-{0} min"}},
+        "month": {map[string]string{
+            "-1": "last month",
+            "0": "this month",
+            "1": "next month",
+        }, Count{"", "+{0} m"}, Count{"", "-{0} m"}},
+        "month-narrow": {map[string]string{
+            "-1": "last month",
+            "0": "this month",
+            "1": "next month",
+        }, Count{"", "+{0} m"}, Count{"", "-{0} m"}},
+        "month-short": {map[string]string{
+            "-1": "last month",
+            "0": "this month",
+            "1": "next month",
+        }, Count{"", "+{0} m"}, Count{"", "-{0} m"}},
+        "quarter": {map[string]string{
+            "-1": "last quarter",
+            "0": "this quarter",
+            "1": "next quarter",
+        }, Count{"", "+{0} Q"}, Count{"", "-{0} Q"}},
+        "quarter-narrow": {map[string]string{
+            "-1": "last quarter",
+            "0": "this quarter",
+            "1": "next quarter",
+        }, Count{"", "+{0} Q"}, Count{"", "-{0} Q"}},
+        "quarter-short": {map[string]string{
+            "-1": "last quarter",
+            "0": "this quarter",
+            "1": "next quarter",
+        }, Count{"", "+{0} Q"}, Count{"", "-{0} Q"}},
+        "second": {map[string]string{
+            "0": "now",
+        }, Count{"", "+{0} s"}, Count{"", "-{0} s"}},
+        "second-narrow": {map[string]string{
+            "0": "now",
+        }, Count{"", "+{0} s"}, Count{"", "-{0} s"}},
+        "second-short": {map[string]string{
+            "0": "now",
+        }, Count{"", "+{0} s"}, Count{"", "-{0} s"}},
+        "week": {map[string]string{
+            "-1": "last week",
+            "0": "this week",
+            "1": "next week",
+        }, Count{"", "+{0} w"}, Count{"", "-{0} w"}},
+        "week-narrow": {map[string]string{
+            "-1": "last week",
+            "0": "this week",
+            "1": "next week",
+        }, Count{"", "+{0} w"}, Count{"", "-{0} w"}},
+        "week-short": {map[string]string{
+            "-1": "last week",
+            "0": "this week",
+            "1": "next week",
+        }, Count{"", "+{0} w"}, Count{"", "-{0} w"}},
+        "year": {map[string]string{
+            "-1": "last year",
+            "0": "this year",
+            "1": "next year",
+        }, Count{"", "+{0} y"}, Count{"", "-{0} y"}},
+        "year-narrow": {map[string]string{
+            "-1": "last year",
+            "0": "this year",
+            "1": "next year",
+        }, Count{"", "+{0} y"}, Count{"", "-{0} y"}},
+        "year-short": {map[string]string{
+            "-1": "last year",
+            "0": "this year",
+            "1": "next year",
+        }, Count{"", "+{0} y"}, Count{"", "-{0} y"}},
+    }, map[string]string{
+        "Bh": "h B",
+        "Bhm": "h:mm B",
+        "Bhms": "h:mm:ss B",
+        "E": "ccc",
+        "EBhm": "E h:mm B",
+        "EBhms": "E h:mm:ss B",
+        "EHm": "E HH:mm",
+        "EHms": "E HH:mm:ss",
+        "Ed": "d, E",
+        "Ehm": "E h:mm a",
+        "Ehms": "E h:mm:ss a",
+        "Gy": "G y",
+        "GyMMM": "G y MMM",
+        "GyMMMEd": "G y MMM d, E",
+        "GyMMMd": "G y MMM d",
+        "GyMd": "GGGGG y-MM-dd",
+        "H": "HH",
+        "Hm": "HH:mm",
+        "Hms": "HH:mm:ss",
+        "Hmsv": "HH:mm:ss v",
+        "Hmv": "HH:mm v",
+        "M": "L",
+        "MEd": "MM-dd, E",
+        "MMM": "LLL",
+        "MMMEd": "MMM d, E",
+        "MMMMd": "MMMM d",
+        "MMMd": "MMM d",
+        "Md": "MM-dd",
+        "d": "d",
+        "h": "h a",
+        "hm": "h:mm a",
+        "hms": "h:mm:ss a",
+        "hmsv": "h:mm:ss a v",
+        "hmv": "h:mm a v",
+        "ms": "mm:ss",
+        "y": "y",
+        "yM": "y-MM",
+        "yMEd": "y-MM-dd, E",
+        "yMMM": "y MMM",
+        "yMMMEd": "y MMM d, E",
+        "yMMMM": "y MMMM",
+        "yMMMd": "y MMM d",
+        "yMd": "y-MM-dd",
+        "yQQQ": "y QQQ",
+        "yQQQQ": "y QQQQ",
+    }, [2]CalendarSymbol{
+        {"BCE", "BCE", "BCE", ""},
+        {"CE", "CE", "CE", ""},
+    }, [4]CalendarSymbol{
+        {"Q1", "Q1", "1", ""},
+        {"Q2", "Q2", "2", ""},
+        {"Q3", "Q3", "3", ""},
+        {"Q4", "Q4", "4", ""},
+    }, [12]CalendarSymbol{
+        {"M01", "M01", "1", ""},
+        {"M02", "M02", "2", ""},
+        {"M03", "M03", "3", ""},
+        {"M04", "M04", "4", ""},
+        {"M05", "M05", "5", ""},
+        {"M06", "M06", "6", ""},
+        {"M07", "M07", "7", ""},
+        {"M08", "M08", "8", ""},
+        {"M09", "M09", "9", ""},
+        {"M10", "M10", "10", ""},
+        {"M11", "M11", "11", ""},
+        {"M12", "M12", "12", ""},
+    }, [7]CalendarSymbol{
+        {"Sun", "Sun", "S", "Sun"},
+        {"Mon", "Mon", "M", "Mon"},
+        {"Tue", "Tue", "T", "Tue"},
+        {"Wed", "Wed", "W", "Wed"},
+        {"Thu", "Thu", "T", "Thu"},
+        {"Fri", "Fri", "F", "Fri"},
+        {"Sat", "Sat", "S", "Sat"},
+    }, [4]CalendarSymbol{
+        {"Q1", "Q1", "1", ""},
+        {"Q2", "Q2", "2", ""},
+        {"Q3", "Q3", "3", ""},
+        {"Q4", "Q4", "4", ""},
+    }, "gregorian", map[string]Calendar{
+        "buddhist": {CalendarFormat{"G y MMMM d, EEEE", "G y MMMM d", "G y MMM d", "GGGGG y-MM-dd"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"BE", "BE", "BE", ""},
+        }},
+        "hebrew": {CalendarFormat{"G y MMMM d, EEEE", "G y MMMM d", "G y MMM d", "GGGGG y-MM-dd"}, []CalendarSymbol{
+            {"Tishri", "Tishri", "1", ""},
+            {"Heshvan", "Heshvan", "2", ""},
+            {"Kislev", "Kislev", "3", ""},
+            {"Tevet", "Tevet", "4", ""},
+            {"Shevat", "Shevat", "5", ""},
+            {"Adar I", "Adar I", "6", ""},
+            {"Adar", "Adar", "7", ""},
+            {"Nisan", "Nisan", "8", ""},
+            {"Iyar", "Iyar", "9", ""},
+            {"Sivan", "Sivan", "10", ""},
+            {"Tamuz", "Tamuz", "11", ""},
+            {"Av", "Av", "12", ""},
+            {"Elul", "Elul", "13", ""},
+            {"Adar II", "Adar II", "7", ""},
+        }, []CalendarSymbol{
+            {"AM", "AM", "AM", ""},
+        }},
+        "islamic": {CalendarFormat{"G y MMMM d, EEEE", "G y MMMM d", "G y MMM d", "GGGGG y-MM-dd"}, []CalendarSymbol{
+            {"Muharram", "Muh.", "1", ""},
+            {"Safar", "Saf.", "2", ""},
+            {"Rabiʻ I", "Rab. I", "3", ""},
+            {"Rabiʻ II", "Rab. II", "4", ""},
+            {"Jumada I", "Jum. I", "5", ""},
+            {"Jumada II", "Jum. II", "6", ""},
+            {"Rajab", "Raj.", "7", ""},
+            {"Shaʻban", "Sha.", "8", ""},
+            {"Ramadan", "Ram.", "9", ""},
+            {"Shawwal", "Shaw.", "10", ""},
+            {"Dhuʻl-Qiʻdah", "Dhuʻl-Q.", "11", ""},
+            {"Dhuʻl-Hijjah", "Dhuʻl-H.", "12", ""},
+        }, []CalendarSymbol{
+            {"AH", "AH", "AH", ""},
+        }},
+        "japanese": {CalendarFormat{"G y MMMM d, EEEE", "G y MMMM d", "G y MMM d", "GGGGG y-MM-dd"}, []CalendarSymbol{}, []CalendarSymbol{
+            {"Meiji", "Meiji", "M", ""},
+            {"Taishō", "Taishō", "T", ""},
+            {"Shōwa", "Shōwa", "S", ""},
+            {"Heisei", "Heisei", "H", ""},
+            {"Reiwa", "Reiwa", "R", ""},
+        }},
+        "persian": {CalendarFormat{"G y MMMM d, EEEE", "G y MMMM d", "G y MMM d", "GGGGG y-MM-dd"}, []CalendarSymbol{
+            {"Farvardin", "Farvardin", "1", ""},
+            {"Ordibehesht", "Ordibehesht", "2", ""},
+            {"Khordad", "Khordad", "3", ""},
+            {"Tir", "Tir", "4", ""},
+            {"Mordad", "Mordad", "5", ""},
+            {"Shahrivar", "Shahrivar", "6", ""},
+            {"Mehr", "Mehr", "7", ""},
+            {"Aban", "Aban", "8", ""},
+            {"Azar", "Azar", "9", ""},
+            {"Dey", "Dey", "10", ""},
+            {"Bahman", "Bahman", "11", ""},
+            {"Esfand", "Esfand", "12", ""},
+        }, []CalendarSymbol{
+            {"AP", "AP", "AP", ""},
+        }},
+    }, 1, 1, "H", []string{
+        "H",
+        "h",
+    }, "latn", "", "metric", "", "GMT{0}", "GMT", "+HH:mm;-HH:mm", "{0}", "{0} (+1)", "{0} (+0)", "{1} ({0})"},
 }
 
 var currencies = map[string]CurrencyInfo{
@@ -7282,11 +9172,1049 @@ var currencies = map[string]CurrencyInfo{
     "ZWD": {0, 0, 0, 0},
 }
 
+var regionCurrencies = map[string][]RegionCurrency{
+    "AC": {
+        {"SHP", "1976-01-01", "", true},
+    },
+    "AD": {
+        {"EUR", "1999-01-01", "", true},
+        {"ESP", "1873-01-01", "2002-02-28", true},
+        {"FRF", "1960-01-01", "2002-02-17", true},
+        {"ADP", "1936-01-01", "2001-12-31", true},
+    },
+    "AE": {
+        {"AED", "1973-05-19", "", true},
+    },
+    "AF": {
+        {"AFN", "2002-10-07", "", true},
+        {"AFA", "1927-03-14", "2002-12-31", true},
+    },
+    "AG": {
+        {"XCD", "1965-10-06", "", true},
+    },
+    "AI": {
+        {"XCD", "1965-10-06", "", true},
+    },
+    "AL": {
+        {"ALL", "1965-08-16", "", true},
+        {"ALK", "1946-11-01", "1965-08-16", true},
+    },
+    "AM": {
+        {"AMD", "1993-11-22", "", true},
+        {"RUR", "1991-12-25", "1993-11-22", true},
+        {"SUR", "1961-01-01", "1991-12-25", true},
+    },
+    "AO": {
+        {"AOA", "1999-12-13", "", true},
+        {"AOR", "1995-07-01", "2000-02-01", true},
+        {"AON", "1990-09-25", "2000-02-01", true},
+        {"AOK", "1977-01-08", "1991-03-01", true},
+    },
+    "AQ": {
+        {"XXX", "", "", false},
+    },
+    "AR": {
+        {"ARS", "1992-01-01", "", true},
+        {"ARA", "1985-06-14", "1992-01-01", true},
+        {"ARP", "1983-06-01", "1985-06-14", true},
+        {"ARL", "1970-01-01", "1983-06-01", true},
+        {"ARM", "1881-11-05", "1970-01-01", true},
+    },
+    "AS": {
+        {"USD", "1904-07-16", "", true},
+    },
+    "AT": {
+        {"EUR", "1999-01-01", "", true},
+        {"ATS", "1947-12-04", "2002-02-28", true},
+    },
+    "AU": {
+        {"AUD", "1966-02-14", "", true},
+    },
+    "AW": {
+        {"AWG", "1986-01-01", "", true},
+        {"ANG", "1940-05-10", "1986-01-01", true},
+    },
+    "AX": {
+        {"EUR", "1999-01-01", "", true},
+    },
+    "AZ": {
+        {"AZN", "2006-01-01", "", true},
+        {"AZM", "1993-11-22", "2006-12-31", true},
+        {"RUR", "1991-12-25", "1994-01-01", true},
+        {"SUR", "1961-01-01", "1991-12-25", true},
+    },
+    "BA": {
+        {"BAM", "1995-01-01", "", true},
+        {"BAN", "1994-08-15", "1997-07-01", true},
+        {"BAD", "1992-07-01", "1994-08-15", true},
+        {"YUR", "1992-07-01", "1993-10-01", true},
+        {"YUN", "1990-01-01", "1992-07-01", true},
+        {"YUD", "1966-01-01", "1990-01-01", true},
+    },
+    "BB": {
+        {"BBD", "1973-12-03", "", true},
+        {"XCD", "1965-10-06", "1973-12-03", true},
+    },
+    "BD": {
+        {"BDT", "1972-01-01", "", true},
+        {"PKR", "1948-04-01", "1972-01-01", true},
+        {"INR", "1835-08-17", "1948-04-01", true},
+    },
+    "BE": {
+        {"EUR", "1999-01-01", "", true},
+        {"BEF", "1831-02-07", "2002-02-28", true},
+        {"NLG", "1816-12-15", "1831-02-07", true},
+        {"BEL", "1970-01-01", "1990-03-05", false},
+        {"BEC", "1970-01-01", "1990-03-05", false},
+    },
+    "BF": {
+        {"XOF", "1984-08-04", "", true},
+    },
+    "BG": {
+        {"BGN", "1999-07-05", "", true},
+        {"BGL", "1962-01-01", "1999-07-05", true},
+        {"BGM", "1952-05-12", "1962-01-01", true},
+        {"BGO", "1879-07-08", "1952-05-12", true},
+    },
+    "BH": {
+        {"BHD", "1965-10-16", "", true},
+    },
+    "BI": {
+        {"BIF", "1964-05-19", "", true},
+    },
+    "BJ": {
+        {"XOF", "1975-11-30", "", true},
+    },
+    "BL": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1960-01-01", "2002-02-17", true},
+    },
+    "BM": {
+        {"BMD", "1970-02-06", "", true},
+    },
+    "BN": {
+        {"BND", "1967-06-12", "", true},
+        {"MYR", "1963-09-16", "1967-06-12", true},
+    },
+    "BO": {
+        {"BOB", "1987-01-01", "", true},
+        {"BOP", "1963-01-01", "1986-12-31", true},
+        {"BOL", "1863-06-23", "1963-01-01", true},
+        {"BOV", "", "", false},
+    },
+    "BQ": {
+        {"USD", "2011-01-01", "", true},
+        {"ANG", "2010-10-10", "2011-01-01", true},
+    },
+    "BR": {
+        {"BRL", "1994-07-01", "", true},
+        {"BRR", "1993-08-01", "1994-07-01", true},
+        {"BRE", "1990-03-16", "1993-08-01", true},
+        {"BRN", "1989-01-15", "1990-03-16", true},
+        {"BRC", "1986-02-28", "1989-01-15", true},
+        {"BRB", "1967-02-13", "1986-02-28", true},
+        {"BRZ", "1942-11-01", "1967-02-13", true},
+    },
+    "BS": {
+        {"BSD", "1966-05-25", "", true},
+    },
+    "BT": {
+        {"BTN", "1974-04-16", "", true},
+        {"INR", "1907-01-01", "", true},
+    },
+    "BU": {
+        {"BUK", "1952-07-01", "1989-06-18", true},
+    },
+    "BV": {
+        {"NOK", "1905-06-07", "", true},
+    },
+    "BW": {
+        {"BWP", "1976-08-23", "", true},
+        {"ZAR", "1961-02-14", "1976-08-23", true},
+    },
+    "BY": {
+        {"BYN", "2016-07-01", "", true},
+        {"BYR", "2000-01-01", "2017-01-01", true},
+        {"BYB", "1994-08-01", "2000-12-31", true},
+        {"RUR", "1991-12-25", "1994-11-08", true},
+        {"SUR", "1961-01-01", "1991-12-25", true},
+    },
+    "BZ": {
+        {"BZD", "1974-01-01", "", true},
+    },
+    "CA": {
+        {"CAD", "1858-01-01", "", true},
+    },
+    "CC": {
+        {"AUD", "1966-02-14", "", true},
+    },
+    "CD": {
+        {"CDF", "1998-07-01", "", true},
+        {"ZRN", "1993-11-01", "1998-07-01", true},
+        {"ZRZ", "1971-10-27", "1993-11-01", true},
+    },
+    "CF": {
+        {"XAF", "1993-01-01", "", true},
+    },
+    "CG": {
+        {"XAF", "1993-01-01", "", true},
+    },
+    "CH": {
+        {"CHF", "1799-03-17", "", true},
+        {"CHE", "", "", false},
+        {"CHW", "", "", false},
+    },
+    "CI": {
+        {"XOF", "1958-12-04", "", true},
+    },
+    "CK": {
+        {"NZD", "1967-07-10", "", true},
+    },
+    "CL": {
+        {"CLP", "1975-09-29", "", true},
+        {"CLE", "1960-01-01", "1975-09-29", true},
+        {"CLF", "", "", false},
+    },
+    "CM": {
+        {"XAF", "1973-04-01", "", true},
+    },
+    "CN": {
+        {"CNY", "1953-03-01", "", true},
+        {"CNX", "1979-01-01", "1998-12-31", false},
+        {"CNH", "2010-07-19", "", false},
+    },
+    "CO": {
+        {"COP", "1905-01-01", "", true},
+        {"COU", "", "", false},
+    },
+    "CP": {
+        {"XXX", "", "", false},
+    },
+    "CR": {
+        {"CRC", "1896-10-26", "", true},
+    },
+    "CS": {
+        {"CSD", "2002-05-15", "2006-06-03", true},
+        {"EUR", "2003-02-04", "2006-06-03", true},
+        {"YUM", "1994-01-24", "2002-05-15", true},
+    },
+    "CU": {
+        {"CUP", "1859-01-01", "", true},
+        {"CUC", "1994-01-01", "", true},
+        {"USD", "1899-01-01", "1959-01-01", true},
+    },
+    "CV": {
+        {"CVE", "1914-01-01", "", true},
+        {"PTE", "1911-05-22", "1975-07-05", true},
+    },
+    "CW": {
+        {"ANG", "2010-10-10", "", true},
+    },
+    "CX": {
+        {"AUD", "1966-02-14", "", true},
+    },
+    "CY": {
+        {"EUR", "2008-01-01", "", true},
+        {"CYP", "1914-09-10", "2008-01-31", true},
+    },
+    "CZ": {
+        {"CZK", "1993-01-01", "", true},
+        {"CSK", "1953-06-01", "1993-03-01", true},
+    },
+    "DD": {
+        {"DDM", "1948-07-20", "1990-10-02", true},
+    },
+    "DE": {
+        {"EUR", "1999-01-01", "", true},
+        {"DEM", "1948-06-20", "2002-02-28", true},
+    },
+    "DG": {
+        {"USD", "1965-11-08", "", true},
+    },
+    "DJ": {
+        {"DJF", "1977-06-27", "", true},
+    },
+    "DK": {
+        {"DKK", "1873-05-27", "", true},
+    },
+    "DM": {
+        {"XCD", "1965-10-06", "", true},
+    },
+    "DO": {
+        {"DOP", "1947-10-01", "", true},
+        {"USD", "1905-06-21", "1947-10-01", true},
+    },
+    "DZ": {
+        {"DZD", "1964-04-01", "", true},
+    },
+    "EA": {
+        {"EUR", "1999-01-01", "", true},
+    },
+    "EC": {
+        {"USD", "2000-10-02", "", true},
+        {"ECS", "1884-04-01", "2000-10-02", true},
+        {"ECV", "1993-05-23", "2000-01-09", false},
+    },
+    "EE": {
+        {"EUR", "2011-01-01", "", true},
+        {"EEK", "1992-06-21", "2010-12-31", true},
+        {"SUR", "1961-01-01", "1992-06-20", true},
+    },
+    "EG": {
+        {"EGP", "1885-11-14", "", true},
+    },
+    "EH": {
+        {"MAD", "1976-02-26", "", true},
+    },
+    "ER": {
+        {"ERN", "1997-11-08", "", true},
+        {"ETB", "1993-05-24", "1997-11-08", true},
+    },
+    "ES": {
+        {"EUR", "1999-01-01", "", true},
+        {"ESP", "1868-10-19", "2002-02-28", true},
+        {"ESA", "1978-01-01", "1981-12-31", false},
+        {"ESB", "1975-01-01", "1994-12-31", false},
+    },
+    "ET": {
+        {"ETB", "1976-09-15", "", true},
+    },
+    "EU": {
+        {"EUR", "1999-01-01", "", true},
+        {"XEU", "1979-01-01", "1998-12-31", false},
+    },
+    "FI": {
+        {"EUR", "1999-01-01", "", true},
+        {"FIM", "1963-01-01", "2002-02-28", true},
+    },
+    "FJ": {
+        {"FJD", "1969-01-13", "", true},
+    },
+    "FK": {
+        {"FKP", "1901-01-01", "", true},
+    },
+    "FM": {
+        {"USD", "1944-01-01", "", true},
+        {"JPY", "1914-10-03", "1944-01-01", true},
+    },
+    "FO": {
+        {"DKK", "1948-01-01", "", true},
+    },
+    "FR": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1960-01-01", "2002-02-17", true},
+    },
+    "GA": {
+        {"XAF", "1993-01-01", "", true},
+    },
+    "GB": {
+        {"GBP", "1694-07-27", "", true},
+    },
+    "GD": {
+        {"XCD", "1967-02-27", "", true},
+    },
+    "GE": {
+        {"GEL", "1995-09-23", "", true},
+        {"GEK", "1993-04-05", "1995-09-25", true},
+        {"RUR", "1991-12-25", "1993-06-11", true},
+        {"SUR", "1961-01-01", "1991-12-25", true},
+    },
+    "GF": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1960-01-01", "2002-02-17", true},
+    },
+    "GG": {
+        {"GBP", "1830-01-01", "", true},
+    },
+    "GH": {
+        {"GHS", "2007-07-03", "", true},
+        {"GHC", "1979-03-09", "2007-12-31", true},
+    },
+    "GI": {
+        {"GIP", "1713-01-01", "", true},
+    },
+    "GL": {
+        {"DKK", "1873-05-27", "", true},
+    },
+    "GM": {
+        {"GMD", "1971-07-01", "", true},
+    },
+    "GN": {
+        {"GNF", "1986-01-06", "", true},
+        {"GNS", "1972-10-02", "1986-01-06", true},
+    },
+    "GP": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1960-01-01", "2002-02-17", true},
+    },
+    "GQ": {
+        {"XAF", "1993-01-01", "", true},
+        {"GQE", "1975-07-07", "1986-06-01", true},
+    },
+    "GR": {
+        {"EUR", "2001-01-01", "", true},
+        {"GRD", "1954-05-01", "2002-02-28", true},
+    },
+    "GS": {
+        {"GBP", "1908-01-01", "", true},
+    },
+    "GT": {
+        {"GTQ", "1925-05-27", "", true},
+    },
+    "GU": {
+        {"USD", "1944-08-21", "", true},
+    },
+    "GW": {
+        {"XOF", "1997-03-31", "", true},
+        {"GWP", "1976-02-28", "1997-03-31", true},
+        {"GWE", "1914-01-01", "1976-02-28", true},
+    },
+    "GY": {
+        {"GYD", "1966-05-26", "", true},
+    },
+    "HK": {
+        {"HKD", "1895-02-02", "", true},
+    },
+    "HM": {
+        {"AUD", "1967-02-16", "", true},
+    },
+    "HN": {
+        {"HNL", "1926-04-03", "", true},
+    },
+    "HR": {
+        {"EUR", "2023-01-01", "", true},
+        {"HRK", "1994-05-30", "2023-01-14", true},
+        {"HRD", "1991-12-23", "1995-01-01", true},
+        {"YUN", "1990-01-01", "1991-12-23", true},
+        {"YUD", "1966-01-01", "1990-01-01", true},
+    },
+    "HT": {
+        {"HTG", "1872-08-26", "", true},
+        {"USD", "1915-01-01", "", true},
+    },
+    "HU": {
+        {"HUF", "1946-07-23", "", true},
+    },
+    "IC": {
+        {"EUR", "1999-01-01", "", true},
+    },
+    "ID": {
+        {"IDR", "1965-12-13", "", true},
+    },
+    "IE": {
+        {"EUR", "1999-01-01", "", true},
+        {"IEP", "1922-01-01", "2002-02-09", true},
+        {"GBP", "1800-01-01", "1922-01-01", true},
+    },
+    "IL": {
+        {"ILS", "1985-09-04", "", true},
+        {"ILR", "1980-02-22", "1985-09-04", true},
+        {"ILP", "1948-08-16", "1980-02-22", true},
+    },
+    "IM": {
+        {"GBP", "1840-01-03", "", true},
+    },
+    "IN": {
+        {"INR", "1835-08-17", "", true},
+    },
+    "IO": {
+        {"USD", "1965-11-08", "", true},
+    },
+    "IQ": {
+        {"IQD", "1931-04-19", "", true},
+        {"EGP", "1920-11-11", "1931-04-19", true},
+        {"INR", "1920-11-11", "1931-04-19", true},
+    },
+    "IR": {
+        {"IRR", "1932-05-13", "", true},
+    },
+    "IS": {
+        {"ISK", "1981-01-01", "", true},
+        {"ISJ", "1918-12-01", "1981-01-01", true},
+        {"DKK", "1873-05-27", "1918-12-01", true},
+    },
+    "IT": {
+        {"EUR", "1999-01-01", "", true},
+        {"ITL", "1862-08-24", "2002-02-28", true},
+    },
+    "JE": {
+        {"GBP", "1837-01-01", "", true},
+    },
+    "JM": {
+        {"JMD", "1969-09-08", "", true},
+    },
+    "JO": {
+        {"JOD", "1950-07-01", "", true},
+    },
+    "JP": {
+        {"JPY", "1871-06-01", "", true},
+    },
+    "KE": {
+        {"KES", "1966-09-14", "", true},
+    },
+    "KG": {
+        {"KGS", "1993-05-10", "", true},
+        {"RUR", "1991-12-25", "1993-05-10", true},
+        {"SUR", "1961-01-01", "1991-12-25", true},
+    },
+    "KH": {
+        {"KHR", "1980-03-20", "", true},
+    },
+    "KI": {
+        {"AUD", "1966-02-14", "", true},
+    },
+    "KM": {
+        {"KMF", "1975-07-06", "", true},
+    },
+    "KN": {
+        {"XCD", "1965-10-06", "", true},
+    },
+    "KP": {
+        {"KPW", "1959-04-17", "", true},
+    },
+    "KR": {
+        {"KRW", "1962-06-10", "", true},
+        {"KRH", "1953-02-15", "1962-06-10", true},
+        {"KRO", "1945-08-15", "1953-02-15", true},
+    },
+    "KW": {
+        {"KWD", "1961-04-01", "", true},
+    },
+    "KY": {
+        {"KYD", "1971-01-01", "", true},
+        {"JMD", "1969-09-08", "1971-01-01", true},
+    },
+    "KZ": {
+        {"KZT", "1993-11-05", "", true},
+    },
+    "LA": {
+        {"LAK", "1979-12-10", "", true},
+    },
+    "LB": {
+        {"LBP", "1948-02-02", "", true},
+    },
+    "LC": {
+        {"XCD", "1965-10-06", "", true},
+    },
+    "LI": {
+        {"CHF", "1921-02-01", "", true},
+    },
+    "LK": {
+        {"LKR", "1978-05-22", "", true},
+    },
+    "LR": {
+        {"LRD", "1944-01-01", "", true},
+    },
+    "LS": {
+        {"ZAR", "1961-02-14", "", true},
+        {"LSL", "1980-01-22", "", true},
+    },
+    "LT": {
+        {"EUR", "2015-01-01", "", true},
+        {"LTL", "1993-06-25", "2014-12-31", true},
+        {"LTT", "1992-10-01", "1993-06-25", true},
+        {"SUR", "1961-01-01", "1992-10-01", true},
+    },
+    "LU": {
+        {"EUR", "1999-01-01", "", true},
+        {"LUF", "1944-09-04", "2002-02-28", true},
+        {"LUC", "1970-01-01", "1990-03-05", false},
+        {"LUL", "1970-01-01", "1990-03-05", false},
+    },
+    "LV": {
+        {"EUR", "2014-01-01", "", true},
+        {"LVL", "1993-06-28", "2013-12-31", true},
+        {"LVR", "1992-05-07", "1993-10-17", true},
+        {"SUR", "1961-01-01", "1992-07-20", true},
+    },
+    "LY": {
+        {"LYD", "1971-09-01", "", true},
+    },
+    "MA": {
+        {"MAD", "1959-10-17", "", true},
+        {"MAF", "1881-01-01", "1959-10-17", true},
+    },
+    "MC": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1960-01-01", "2002-02-17", true},
+        {"MCF", "1960-01-01", "2002-02-17", true},
+    },
+    "MD": {
+        {"MDL", "1993-11-29", "", true},
+        {"MDC", "1992-06-01", "1993-11-29", true},
+    },
+    "ME": {
+        {"EUR", "2002-01-01", "", true},
+        {"DEM", "1999-10-02", "2002-05-15", true},
+        {"YUM", "1994-01-24", "2002-05-15", true},
+    },
+    "MF": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1960-01-01", "2002-02-17", true},
+    },
+    "MG": {
+        {"MGA", "1983-11-01", "", true},
+        {"MGF", "1963-07-01", "2004-12-31", true},
+    },
+    "MH": {
+        {"USD", "1944-01-01", "", true},
+    },
+    "MK": {
+        {"MKD", "1993-05-20", "", true},
+        {"MKN", "1992-04-26", "1993-05-20", true},
+    },
+    "ML": {
+        {"XOF", "1984-06-01", "", true},
+        {"MLF", "1962-07-02", "1984-08-31", true},
+        {"XOF", "1958-11-24", "1962-07-02", true},
+    },
+    "MM": {
+        {"MMK", "1989-06-18", "", true},
+        {"BUK", "1952-07-01", "1989-06-18", true},
+    },
+    "MN": {
+        {"MNT", "1915-03-01", "", true},
+    },
+    "MO": {
+        {"MOP", "1901-01-01", "", true},
+    },
+    "MP": {
+        {"USD", "1944-01-01", "", true},
+    },
+    "MQ": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1960-01-01", "2002-02-17", true},
+    },
+    "MR": {
+        {"MRU", "2018-01-01", "", true},
+        {"MRO", "1973-06-29", "2018-06-30", true},
+        {"XOF", "1958-11-28", "1973-06-29", true},
+    },
+    "MS": {
+        {"XCD", "1967-02-27", "", true},
+    },
+    "MT": {
+        {"EUR", "2008-01-01", "", true},
+        {"MTL", "1968-06-07", "2008-01-31", true},
+        {"MTP", "1914-08-13", "1968-06-07", true},
+    },
+    "MU": {
+        {"MUR", "1934-04-01", "", true},
+    },
+    "MV": {
+        {"MVR", "1981-07-01", "", true},
+        {"MVP", "1947-01-01", "1981-07-01", true},
+    },
+    "MW": {
+        {"MWK", "1971-02-15", "", true},
+    },
+    "MX": {
+        {"MXN", "1993-01-01", "", true},
+        {"MXP", "1822-01-01", "1992-12-31", true},
+        {"MXV", "", "", false},
+    },
+    "MY": {
+        {"MYR", "1963-09-16", "", true},
+    },
+    "MZ": {
+        {"MZN", "2006-07-01", "", true},
+        {"MZM", "1980-06-16", "2006-12-31", true},
+        {"MZE", "1975-06-25", "1980-06-16", true},
+    },
+    "NA": {
+        {"NAD", "1993-01-01", "", true},
+        {"ZAR", "1961-02-14", "", true},
+    },
+    "NC": {
+        {"XPF", "1985-01-01", "", true},
+    },
+    "NE": {
+        {"XOF", "1958-12-19", "", true},
+    },
+    "NF": {
+        {"AUD", "1966-02-14", "", true},
+    },
+    "NG": {
+        {"NGN", "1973-01-01", "", true},
+    },
+    "NI": {
+        {"NIO", "1991-04-30", "", true},
+        {"NIC", "1988-02-15", "1991-04-30", true},
+    },
+    "NL": {
+        {"EUR", "1999-01-01", "", true},
+        {"NLG", "1813-01-01", "2002-02-28", true},
+    },
+    "NO": {
+        {"NOK", "1905-06-07", "", true},
+        {"SEK", "1873-05-27", "1905-06-07", true},
+    },
+    "NP": {
+        {"NPR", "1933-01-01", "", true},
+        {"INR", "1870-01-01", "1966-10-17", true},
+    },
+    "NR": {
+        {"AUD", "1966-02-14", "", true},
+    },
+    "NU": {
+        {"NZD", "1967-07-10", "", true},
+    },
+    "NZ": {
+        {"NZD", "1967-07-10", "", true},
+    },
+    "OM": {
+        {"OMR", "1972-11-11", "", true},
+    },
+    "PA": {
+        {"PAB", "1903-11-04", "", true},
+        {"USD", "1903-11-18", "", true},
+    },
+    "PE": {
+        {"PEN", "1991-07-01", "", true},
+        {"PEI", "1985-02-01", "1991-07-01", true},
+        {"PES", "1863-02-14", "1985-02-01", true},
+    },
+    "PF": {
+        {"XPF", "1945-12-26", "", true},
+    },
+    "PG": {
+        {"PGK", "1975-09-16", "", true},
+        {"AUD", "1966-02-14", "1975-09-16", true},
+    },
+    "PH": {
+        {"PHP", "1946-07-04", "", true},
+    },
+    "PK": {
+        {"PKR", "1948-04-01", "", true},
+        {"INR", "1835-08-17", "1947-08-15", true},
+    },
+    "PL": {
+        {"PLN", "1995-01-01", "", true},
+        {"PLZ", "1950-10-28", "1994-12-31", true},
+    },
+    "PM": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1972-12-21", "2002-02-17", true},
+    },
+    "PN": {
+        {"NZD", "1969-01-13", "", true},
+    },
+    "PR": {
+        {"USD", "1898-12-10", "", true},
+        {"ESP", "1800-01-01", "1898-12-10", true},
+    },
+    "PS": {
+        {"ILS", "1985-09-04", "", true},
+        {"JOD", "1996-02-12", "", true},
+        {"ILP", "1967-06-01", "1980-02-22", true},
+        {"JOD", "1950-07-01", "1967-06-01", true},
+    },
+    "PT": {
+        {"EUR", "1999-01-01", "", true},
+        {"PTE", "1911-05-22", "2002-02-28", true},
+    },
+    "PW": {
+        {"USD", "1944-01-01", "", true},
+    },
+    "PY": {
+        {"PYG", "1943-11-01", "", true},
+    },
+    "QA": {
+        {"QAR", "1973-05-19", "", true},
+    },
+    "RE": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1975-01-01", "2002-02-17", true},
+    },
+    "RO": {
+        {"RON", "2005-07-01", "", true},
+        {"ROL", "1952-01-28", "2006-12-31", true},
+    },
+    "RS": {
+        {"RSD", "2006-10-25", "", true},
+        {"CSD", "2002-05-15", "2006-10-25", true},
+        {"YUM", "1994-01-24", "2002-05-15", true},
+    },
+    "RU": {
+        {"RUB", "1999-01-01", "", true},
+        {"RUR", "1991-12-25", "1998-12-31", true},
+    },
+    "RW": {
+        {"RWF", "1964-05-19", "", true},
+    },
+    "SA": {
+        {"SAR", "1952-10-22", "", true},
+    },
+    "SB": {
+        {"SBD", "1977-10-24", "", true},
+        {"AUD", "1966-02-14", "1978-06-30", true},
+    },
+    "SC": {
+        {"SCR", "1903-11-01", "", true},
+    },
+    "SD": {
+        {"SDG", "2007-01-10", "", true},
+        {"SDD", "1992-06-08", "2007-06-30", true},
+        {"SDP", "1957-04-08", "1998-06-01", true},
+        {"EGP", "1889-01-19", "1958-01-01", true},
+        {"GBP", "1889-01-19", "1958-01-01", true},
+    },
+    "SE": {
+        {"SEK", "1873-05-27", "", true},
+    },
+    "SG": {
+        {"SGD", "1967-06-12", "", true},
+        {"MYR", "1963-09-16", "1967-06-12", true},
+    },
+    "SH": {
+        {"SHP", "1917-02-15", "", true},
+    },
+    "SI": {
+        {"EUR", "2007-01-01", "", true},
+        {"SIT", "1992-10-07", "2007-01-14", true},
+    },
+    "SJ": {
+        {"NOK", "1905-06-07", "", true},
+    },
+    "SK": {
+        {"EUR", "2009-01-01", "", true},
+        {"SKK", "1992-12-31", "2009-01-01", true},
+        {"CSK", "1953-06-01", "1992-12-31", true},
+    },
+    "SL": {
+        {"SLE", "2022-07-01", "", true},
+        {"SLL", "1964-08-04", "2023-12-31", true},
+        {"GBP", "1808-11-30", "1966-02-04", true},
+    },
+    "SM": {
+        {"EUR", "1999-01-01", "", true},
+        {"ITL", "1865-12-23", "2001-02-28", true},
+    },
+    "SN": {
+        {"XOF", "1959-04-04", "", true},
+    },
+    "SO": {
+        {"SOS", "1960-07-01", "", true},
+    },
+    "SR": {
+        {"SRD", "2004-01-01", "", true},
+        {"SRG", "1940-05-10", "2003-12-31", true},
+        {"NLG", "1815-11-20", "1940-05-10", true},
+    },
+    "SS": {
+        {"SSP", "2011-07-18", "", true},
+        {"SDG", "2007-01-10", "2011-09-01", true},
+    },
+    "ST": {
+        {"STN", "2018-01-01", "", true},
+        {"STD", "1977-09-08", "2017-12-31", true},
+    },
+    "SU": {
+        {"SUR", "1961-01-01", "1991-12-25", true},
+    },
+    "SV": {
+        {"USD", "2001-01-01", "", true},
+        {"SVC", "1919-11-11", "2001-01-01", true},
+    },
+    "SX": {
+        {"ANG", "2010-10-10", "", true},
+    },
+    "SY": {
+        {"SYP", "1948-01-01", "", true},
+    },
+    "SZ": {
+        {"SZL", "1974-09-06", "", true},
+    },
+    "TA": {
+        {"GBP", "1938-01-12", "", true},
+    },
+    "TC": {
+        {"USD", "1969-09-08", "", true},
+    },
+    "TD": {
+        {"XAF", "1993-01-01", "", true},
+    },
+    "TF": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1959-01-01", "2002-02-17", true},
+    },
+    "TG": {
+        {"XOF", "1958-11-28", "", true},
+    },
+    "TH": {
+        {"THB", "1928-04-15", "", true},
+    },
+    "TJ": {
+        {"TJS", "2000-10-26", "", true},
+        {"TJR", "1995-05-10", "2000-10-25", true},
+        {"RUR", "1991-12-25", "1995-05-10", true},
+    },
+    "TK": {
+        {"NZD", "1967-07-10", "", true},
+    },
+    "TL": {
+        {"USD", "1999-10-20", "", true},
+        {"TPE", "1959-01-02", "2002-05-20", true},
+        {"IDR", "1975-12-07", "2002-05-20", true},
+    },
+    "TM": {
+        {"TMT", "2009-01-01", "", true},
+        {"TMM", "1993-11-01", "2009-01-01", true},
+        {"RUR", "1991-12-25", "1993-11-01", true},
+        {"SUR", "1961-01-01", "1991-12-25", true},
+    },
+    "TN": {
+        {"TND", "1958-11-01", "", true},
+    },
+    "TO": {
+        {"TOP", "1966-02-14", "", true},
+    },
+    "TP": {
+        {"TPE", "1959-01-02", "2002-05-20", true},
+        {"IDR", "1975-12-07", "2002-05-20", true},
+    },
+    "TR": {
+        {"TRY", "2005-01-01", "", true},
+        {"TRL", "1922-11-01", "2005-12-31", true},
+    },
+    "TT": {
+        {"TTD", "1964-01-01", "", true},
+    },
+    "TV": {
+        {"AUD", "1966-02-14", "", true},
+    },
+    "TW": {
+        {"TWD", "1949-06-15", "", true},
+    },
+    "TZ": {
+        {"TZS", "1966-06-14", "", true},
+    },
+    "UA": {
+        {"UAH", "1996-09-02", "", true},
+        {"UAK", "1992-11-13", "1993-10-17", true},
+        {"RUR", "1991-12-25", "1992-11-13", true},
+        {"SUR", "1961-01-01", "1991-12-25", true},
+    },
+    "UG": {
+        {"UGX", "1987-05-15", "", true},
+        {"UGS", "1966-08-15", "1987-05-15", true},
+    },
+    "UM": {
+        {"USD", "1944-01-01", "", true},
+    },
+    "US": {
+        {"USD", "1792-01-01", "", true},
+        {"USN", "", "", false},
+        {"USS", "", "2014-03-01", false},
+    },
+    "UY": {
+        {"UYU", "1993-03-01", "", true},
+        {"UYP", "1975-07-01", "1993-03-01", true},
+        {"UYI", "", "", false},
+        {"UYW", "", "", false},
+    },
+    "UZ": {
+        {"UZS", "1994-07-01", "", true},
+    },
+    "VA": {
+        {"EUR", "1999-01-01", "", true},
+        {"ITL", "1870-10-19", "2002-02-28", true},
+    },
+    "VC": {
+        {"XCD", "1965-10-06", "", true},
+    },
+    "VE": {
+        {"VES", "2018-08-20", "", true},
+        {"VEF", "2008-01-01", "2018-08-20", true},
+        {"VEB", "1871-05-11", "2008-06-30", true},
+        {"VED", "", "", false},
+    },
+    "VG": {
+        {"USD", "1833-01-01", "", true},
+        {"GBP", "1833-01-01", "1959-01-01", true},
+    },
+    "VI": {
+        {"USD", "1837-01-01", "", true},
+    },
+    "VN": {
+        {"VND", "1985-09-14", "", true},
+        {"VNN", "1978-05-03", "1985-09-14", true},
+    },
+    "VU": {
+        {"VUV", "1981-01-01", "", true},
+    },
+    "WF": {
+        {"XPF", "1961-07-30", "", true},
+    },
+    "WS": {
+        {"WST", "1967-07-10", "", true},
+    },
+    "XK": {
+        {"EUR", "2002-01-01", "", true},
+        {"DEM", "1999-09-01", "2002-03-09", true},
+        {"YUM", "1994-01-24", "1999-09-30", true},
+    },
+    "YD": {
+        {"YDD", "1965-04-01", "1996-01-01", true},
+    },
+    "YE": {
+        {"YER", "1990-05-22", "", true},
+    },
+    "YT": {
+        {"EUR", "1999-01-01", "", true},
+        {"FRF", "1976-02-23", "2002-02-17", true},
+        {"KMF", "1975-01-01", "1976-02-23", true},
+    },
+    "YU": {
+        {"YUM", "1994-01-24", "2002-05-15", true},
+        {"YUN", "1990-01-01", "1992-07-24", true},
+        {"YUD", "1966-01-01", "1990-01-01", true},
+    },
+    "ZA": {
+        {"ZAR", "1961-02-14", "", true},
+        {"ZAL", "1985-09-01", "1995-03-13", false},
+    },
+    "ZM": {
+        {"ZMW", "2013-01-01", "", true},
+        {"ZMK", "1968-01-16", "2013-01-01", true},
+    },
+    "ZR": {
+        {"ZRN", "1993-11-01", "1998-07-31", true},
+        {"ZRZ", "1971-10-27", "1993-11-01", true},
+    },
+    "ZW": {
+        {"USD", "2009-04-12", "", true},
+        {"ZWL", "2009-02-02", "2009-04-12", true},
+        {"ZWR", "2008-08-01", "2009-02-02", true},
+        {"ZWD", "1980-04-18", "2008-08-01", true},
+        {"RHD", "1970-02-17", "1980-04-18", true},
+    },
+    "ZZ": {
+        {"XAG", "", "", false},
+        {"XAU", "", "", false},
+        {"XBA", "", "", false},
+        {"XBB", "", "", false},
+        {"XBC", "", "", false},
+        {"XBD", "", "", false},
+        {"XDR", "", "", false},
+        {"XFO", "1930-01-01", "2003-04-01", false},
+        {"XFU", "", "2013-11-30", false},
+        {"XPD", "", "", false},
+        {"XPT", "", "", false},
+        {"XRE", "", "1999-11-30", false},
+        {"XSU", "", "", false},
+        {"XTS", "", "", false},
+        {"XUA", "", "", false},
+        {"XXX", "", "", false},
+    },
+}
+
 var metazones = map[string]string{
     "Africa/Abidjan": "GMT",
     "Africa/Accra": "GMT",
     "Africa/Addis_Ababa": "Africa_Eastern",
     "Africa/Algiers": "Europe_Central",
+    "Africa/Asmara": "Africa_Eastern",
     "Africa/Asmera": "Africa_Eastern",
     "Africa/Bamako": "GMT",
     "Africa/Bangui": "Africa_Western",
@@ -7330,6 +10258,7 @@ var metazones = map[string]string{
     "Africa/Ouagadougou": "GMT",
     "Africa/Porto-Novo": "Africa_Western",
     "Africa/Sao_Tome": "GMT",
+    "Africa/Timbuktu": "GMT",
     "Africa/Tripoli": "Europe_Eastern",
     "Africa/Tunis": "Europe_Central",
     "Africa/Windhoek": "Africa_Central",
@@ -7338,7 +10267,13 @@ var metazones = map[string]string{
     "America/Anguilla": "Atlantic",
     "America/Antigua": "Atlantic",
     "America/Araguaina": "Brasilia",
+    "America/Argentina/Buenos_Aires": "Argentina",
+    "America/Argentina/Catamarca": "Argentina",
+    "America/Argentina/ComodRivadavia": "Argentina",
+    "America/Argentina/Cordoba": "Argentina",
+    "America/Argentina/Jujuy": "Argentina",
     "America/Argentina/La_Rioja": "Argentina",
+    "America/Argentina/Mendoza": "Argentina",
     "America/Argentina/Rio_Gallegos": "Argentina",
     "America/Argentina/Salta": "Argentina",
     "America/Argentina/San_Juan": "Argentina",
@@ -7347,6 +10282,8 @@ var metazones = map[string]string{
     "America/Argentina/Ushuaia": "Argentina",
     "America/Aruba": "Atlantic",
     "America/Asuncion": "Paraguay",
+    "America/Atikokan": "America_Eastern",
+    "America/Atka": "Hawaii_Aleutian",
     "America/Bahia": "Brasilia",
     "America/Bahia_Banderas": "America_Central",
     "America/Barbados": "Atlantic",
@@ -7382,7 +10319,9 @@ var metazones = map[string]string{
     "America/Edmonton": "America_Mountain",
     "America/Eirunepe": "Acre",
     "America/El_Salvador": "America_Central",
+    "America/Ensenada": "America_Pacific",
     "America/Fort_Nelson": "America_Mountain",
+    "America/Fort_Wayne": "America_Eastern",
     "America/Fortaleza": "Brasilia",
     "America/Glace_Bay": "Atlantic",
     "America/Godthab": "Greenland",
@@ -7396,6 +10335,7 @@ var metazones = map[string]string{
     "America/Halifax": "Atlantic",
     "America/Havana": "Cuba",
     "America/Hermosillo": "Mexico_Pacific",
+    "America/Indiana/Indianapolis": "America_Eastern",
     "America/Indiana/Knox": "America_Central",
     "America/Indiana/Marengo": "America_Eastern",
     "America/Indiana/Petersburg": "America_Eastern",
@@ -7409,7 +10349,9 @@ var metazones = map[string]string{
     "America/Jamaica": "America_Eastern",
     "America/Jujuy": "Argentina",
     "America/Juneau": "Alaska",
+    "America/Kentucky/Louisville": "America_Eastern",
     "America/Kentucky/Monticello": "America_Eastern",
+    "America/Knox_IN": "America_Central",
     "America/Kralendijk": "Atlantic",
     "America/La_Paz": "Bolivia",
     "America/Lima": "Peru",
@@ -7432,6 +10374,7 @@ var metazones = map[string]string{
     "America/Moncton": "Atlantic",
     "America/Monterrey": "America_Central",
     "America/Montevideo": "Uruguay",
+    "America/Montreal": "America_Eastern",
     "America/Montserrat": "Atlantic",
     "America/Nassau": "America_Eastern",
     "America/New_York": "America_Eastern",
@@ -7440,12 +10383,14 @@ var metazones = map[string]string{
     "America/North_Dakota/Beulah": "America_Central",
     "America/North_Dakota/Center": "America_Central",
     "America/North_Dakota/New_Salem": "America_Central",
+    "America/Nuuk": "Greenland",
     "America/Ojinaga": "America_Central",
     "America/Panama": "America_Eastern",
     "America/Paramaribo": "Suriname",
     "America/Phoenix": "America_Mountain",
     "America/Port-au-Prince": "America_Eastern",
     "America/Port_of_Spain": "Atlantic",
+    "America/Porto_Acre": "Acre",
     "America/Porto_Velho": "Amazon",
     "America/Puerto_Rico": "Atlantic",
     "America/Rankin_Inlet": "America_Central",
@@ -7453,11 +10398,13 @@ var metazones = map[string]string{
     "America/Regina": "America_Central",
     "America/Resolute": "America_Central",
     "America/Rio_Branco": "Acre",
+    "America/Rosario": "Argentina",
     "America/Santarem": "Brasilia",
     "America/Santiago": "Chile",
     "America/Santo_Domingo": "Atlantic",
     "America/Sao_Paulo": "Brasilia",
     "America/Scoresbysund": "Greenland",
+    "America/Shiprock": "America_Mountain",
     "America/Sitka": "Alaska",
     "America/St_Barthelemy": "Atlantic",
     "America/St_Johns": "Newfoundland",
@@ -7472,6 +10419,7 @@ var metazones = map[string]string{
     "America/Toronto": "America_Eastern",
     "America/Tortola": "Atlantic",
     "America/Vancouver": "America_Pacific",
+    "America/Virgin": "Atlantic",
     "America/Whitehorse": "Yukon",
     "America/Winnipeg": "America_Central",
     "America/Yakutat": "Alaska",
@@ -7482,6 +10430,7 @@ var metazones = map[string]string{
     "Antarctica/Mawson": "Mawson",
     "Antarctica/McMurdo": "New_Zealand",
     "Antarctica/Rothera": "Rothera",
+    "Antarctica/South_Pole": "New_Zealand",
     "Antarctica/Syowa": "Syowa",
     "Antarctica/Troll": "GMT",
     "Antarctica/Vostok": "Vostok",
@@ -7492,6 +10441,7 @@ var metazones = map[string]string{
     "Asia/Aqtau": "Kazakhstan",
     "Asia/Aqtobe": "Kazakhstan",
     "Asia/Ashgabat": "Turkmenistan",
+    "Asia/Ashkhabad": "Turkmenistan",
     "Asia/Atyrau": "Kazakhstan",
     "Asia/Baghdad": "Arabian",
     "Asia/Bahrain": "Arabian",
@@ -7503,29 +10453,39 @@ var metazones = map[string]string{
     "Asia/Brunei": "Brunei",
     "Asia/Calcutta": "India",
     "Asia/Chita": "Yakutsk",
+    "Asia/Chongqing": "China",
+    "Asia/Chungking": "China",
     "Asia/Colombo": "India",
+    "Asia/Dacca": "Bangladesh",
     "Asia/Dhaka": "Bangladesh",
     "Asia/Dili": "East_Timor",
     "Asia/Dubai": "Gulf",
     "Asia/Dushanbe": "Tajikistan",
     "Asia/Famagusta": "Europe_Eastern",
     "Asia/Gaza": "Europe_Eastern",
+    "Asia/Harbin": "China",
     "Asia/Hebron": "Europe_Eastern",
+    "Asia/Ho_Chi_Minh": "Indochina",
     "Asia/Hong_Kong": "Hong_Kong",
     "Asia/Hovd": "Hovd",
     "Asia/Irkutsk": "Irkutsk",
+    "Asia/Istanbul": "Turkey",
     "Asia/Jakarta": "Indonesia_Western",
     "Asia/Jayapura": "Indonesia_Eastern",
     "Asia/Jerusalem": "Israel",
     "Asia/Kabul": "Afghanistan",
     "Asia/Kamchatka": "Kamchatka",
     "Asia/Karachi": "Pakistan",
+    "Asia/Kashgar": "Urumqi",
+    "Asia/Kathmandu": "Nepal",
     "Asia/Katmandu": "Nepal",
     "Asia/Khandyga": "Yakutsk",
+    "Asia/Kolkata": "India",
     "Asia/Krasnoyarsk": "Krasnoyarsk",
     "Asia/Kuala_Lumpur": "Malaysia",
     "Asia/Kuching": "Malaysia",
     "Asia/Kuwait": "Arabian",
+    "Asia/Macao": "China",
     "Asia/Macau": "China",
     "Asia/Magadan": "Magadan",
     "Asia/Makassar": "Indonesia_Central",
@@ -7555,15 +10515,20 @@ var metazones = map[string]string{
     "Asia/Tashkent": "Uzbekistan",
     "Asia/Tbilisi": "Georgia",
     "Asia/Tehran": "Iran",
+    "Asia/Tel_Aviv": "Israel",
+    "Asia/Thimbu": "Bhutan",
     "Asia/Thimphu": "Bhutan",
     "Asia/Tokyo": "Japan",
     "Asia/Tomsk": "Krasnoyarsk",
+    "Asia/Ujung_Pandang": "Indonesia_Central",
     "Asia/Ulaanbaatar": "Mongolia",
+    "Asia/Ulan_Bator": "Mongolia",
     "Asia/Urumqi": "Urumqi",
     "Asia/Ust-Nera": "Vladivostok",
     "Asia/Vientiane": "Indochina",
     "Asia/Vladivostok": "Vladivostok",
     "Asia/Yakutsk": "Yakutsk",
+    "Asia/Yangon": "Myanmar",
     "Asia/Yekaterinburg": "Yekaterinburg",
     "Asia/Yerevan": "Armenia",
     "Atlantic/Azores": "Azores",
@@ -7571,27 +10536,63 @@ var metazones = map[string]string{
     "Atlantic/Canary": "Europe_Western",
     "Atlantic/Cape_Verde": "Cape_Verde",
     "Atlantic/Faeroe": "Europe_Western",
+    "Atlantic/Faroe": "Europe_Western",
+    "Atlantic/Jan_Mayen": "Europe_Central",
     "Atlantic/Madeira": "Europe_Western",
     "Atlantic/Reykjavik": "GMT",
     "Atlantic/South_Georgia": "South_Georgia",
     "Atlantic/St_Helena": "GMT",
     "Atlantic/Stanley": "Falkland",
+    "Australia/ACT": "Australia_Eastern",
     "Australia/Adelaide": "Australia_Central",
     "Australia/Brisbane": "Australia_Eastern",
     "Australia/Broken_Hill": "Australia_Central",
+    "Australia/Canberra": "Australia_Eastern",
     "Australia/Darwin": "Australia_Central",
     "Australia/Eucla": "Australia_CentralWestern",
     "Australia/Hobart": "Australia_Eastern",
+    "Australia/LHI": "Lord_Howe",
     "Australia/Lindeman": "Australia_Eastern",
     "Australia/Lord_Howe": "Lord_Howe",
     "Australia/Melbourne": "Australia_Eastern",
+    "Australia/NSW": "Australia_Eastern",
+    "Australia/North": "Australia_Central",
     "Australia/Perth": "Australia_Western",
+    "Australia/Queensland": "Australia_Eastern",
+    "Australia/South": "Australia_Central",
     "Australia/Sydney": "Australia_Eastern",
+    "Australia/Tasmania": "Australia_Eastern",
+    "Australia/Victoria": "Australia_Eastern",
+    "Australia/West": "Australia_Western",
+    "Australia/Yancowinna": "Australia_Central",
+    "Brazil/Acre": "Acre",
+    "Brazil/DeNoronha": "Noronha",
+    "Brazil/East": "Brasilia",
+    "Brazil/West": "Amazon",
+    "Canada/Atlantic": "Atlantic",
+    "Canada/Central": "America_Central",
+    "Canada/East-Saskatchewan": "America_Central",
+    "Canada/Eastern": "America_Eastern",
+    "Canada/Mountain": "America_Mountain",
+    "Canada/Newfoundland": "Newfoundland",
+    "Canada/Pacific": "America_Pacific",
+    "Canada/Saskatchewan": "America_Central",
+    "Canada/Yukon": "Yukon",
+    "Chile/Continental": "Chile",
+    "Chile/EasterIsland": "Easter",
+    "Cuba": "Cuba",
+    "Egypt": "Europe_Eastern",
+    "Eire": "GMT",
     "Etc/GMT": "GMT",
+    "Etc/GMT+0": "GMT",
+    "Etc/GMT-0": "GMT",
+    "Etc/GMT0": "GMT",
+    "Etc/Greenwich": "GMT",
     "Europe/Amsterdam": "Europe_Central",
     "Europe/Andorra": "Europe_Central",
     "Europe/Astrakhan": "Samara",
     "Europe/Athens": "Europe_Eastern",
+    "Europe/Belfast": "GMT",
     "Europe/Belgrade": "Europe_Central",
     "Europe/Berlin": "Europe_Central",
     "Europe/Bratislava": "Europe_Central",
@@ -7611,6 +10612,7 @@ var metazones = map[string]string{
     "Europe/Kaliningrad": "Europe_Eastern",
     "Europe/Kiev": "Europe_Eastern",
     "Europe/Kirov": "Moscow",
+    "Europe/Kyiv": "Europe_Eastern",
     "Europe/Lisbon": "Europe_Western",
     "Europe/Ljubljana": "Europe_Central",
     "Europe/London": "GMT",
@@ -7621,6 +10623,7 @@ var metazones = map[string]string{
     "Europe/Minsk": "Moscow",
     "Europe/Monaco": "Europe_Central",
     "Europe/Moscow": "Moscow",
+    "Europe/Nicosia": "Europe_Eastern",
     "Europe/Oslo": "Europe_Central",
     "Europe/Paris": "Europe_Central",
     "Europe/Podgorica": "Europe_Central",
@@ -7637,6 +10640,7 @@ var metazones = map[string]string{
     "Europe/Stockholm": "Europe_Central",
     "Europe/Tallinn": "Europe_Eastern",
     "Europe/Tirane": "Europe_Central",
+    "Europe/Tiraspol": "Europe_Eastern",
     "Europe/Ulyanovsk": "Samara",
     "Europe/Vaduz": "Europe_Central",
     "Europe/Vatican": "Europe_Central",
@@ -7646,6 +10650,15 @@ var metazones = map[string]string{
     "Europe/Warsaw": "Europe_Central",
     "Europe/Zagreb": "Europe_Central",
     "Europe/Zurich": "Europe_Central",
+    "GB": "GMT",
+    "GB-Eire": "GMT",
+    "GMT": "GMT",
+    "GMT+0": "GMT",
+    "GMT-0": "GMT",
+    "GMT0": "GMT",
+    "Greenwich": "GMT",
+    "Hongkong": "Hong_Kong",
+    "Iceland": "GMT",
     "Indian/Antananarivo": "Africa_Eastern",
     "Indian/Chagos": "Indian_Ocean",
     "Indian/Christmas": "Christmas",
@@ -7657,9 +10670,23 @@ var metazones = map[string]string{
     "Indian/Mauritius": "Mauritius",
     "Indian/Mayotte": "Africa_Eastern",
     "Indian/Reunion": "Reunion",
+    "Iran": "Iran",
+    "Israel": "Israel",
+    "Jamaica": "America_Eastern",
+    "Japan": "Japan",
+    "Kwajalein": "Marshall_Islands",
+    "Libya": "Europe_Eastern",
+    "Mexico/BajaNorte": "America_Pacific",
+    "Mexico/BajaSur": "Mexico_Pacific",
+    "Mexico/General": "America_Central",
+    "NZ": "New_Zealand",
+    "NZ-CHAT": "Chatham",
+    "Navajo": "America_Mountain",
+    "PRC": "China",
     "Pacific/Apia": "Apia",
     "Pacific/Auckland": "New_Zealand",
     "Pacific/Chatham": "Chatham",
+    "Pacific/Chuuk": "Truk",
     "Pacific/Easter": "Easter",
     "Pacific/Efate": "Vanuatu",
     "Pacific/Enderbury": "Phoenix_Islands",
@@ -7671,6 +10698,7 @@ var metazones = map[string]string{
     "Pacific/Guadalcanal": "Solomon",
     "Pacific/Guam": "Chamorro",
     "Pacific/Honolulu": "Hawaii",
+    "Pacific/Kanton": "Phoenix_Islands",
     "Pacific/Kiritimati": "Line_Islands",
     "Pacific/Kosrae": "Kosrae",
     "Pacific/Kwajalein": "Marshall_Islands",
@@ -7684,14 +10712,2325 @@ var metazones = map[string]string{
     "Pacific/Pago_Pago": "Samoa",
     "Pacific/Palau": "Palau",
     "Pacific/Pitcairn": "Pitcairn",
+    "Pacific/Pohnpei": "Ponape",
     "Pacific/Ponape": "Ponape",
     "Pacific/Port_Moresby": "Papua_New_Guinea",
     "Pacific/Rarotonga": "Cook",
     "Pacific/Saipan": "Chamorro",
+    "Pacific/Samoa": "Samoa",
     "Pacific/Tahiti": "Tahiti",
     "Pacific/Tarawa": "Gilbert_Islands",
     "Pacific/Tongatapu": "Tonga",
     "Pacific/Truk": "Truk",
     "Pacific/Wake": "Wake",
     "Pacific/Wallis": "Wallis",
+    "Pacific/Yap": "Truk",
+    "Poland": "Europe_Central",
+    "Portugal": "Europe_Western",
+    "ROC": "Taipei",
+    "ROK": "Korea",
+    "Singapore": "Singapore",
+    "Turkey": "Turkey",
+    "US/Alaska": "Alaska",
+    "US/Aleutian": "Hawaii_Aleutian",
+    "US/Arizona": "America_Mountain",
+    "US/Central": "America_Central",
+    "US/East-Indiana": "America_Eastern",
+    "US/Eastern": "America_Eastern",
+    "US/Hawaii": "Hawaii",
+    "US/Indiana-Starke": "America_Central",
+    "US/Michigan": "America_Eastern",
+    "US/Mountain": "America_Mountain",
+    "US/Pacific": "America_Pacific",
+    "US/Pacific-New": "America_Pacific",
+    "US/Samoa": "Samoa",
+    "W-SU": "Moscow",
+}
+
+var metazoneZones = map[string]string{
+    "Acre": "America/Rio_Branco",
+    "Afghanistan": "Asia/Kabul",
+    "Africa_Central": "Africa/Maputo",
+    "Africa_Eastern": "Africa/Nairobi",
+    "Africa_FarWestern": "Africa/El_Aaiun",
+    "Africa_Southern": "Africa/Johannesburg",
+    "Africa_Western": "Africa/Lagos",
+    "Aktyubinsk": "Asia/Aqtobe",
+    "Alaska": "America/Juneau",
+    "Alaska_Hawaii": "America/Anchorage",
+    "Almaty": "Asia/Almaty",
+    "Amazon": "America/Manaus",
+    "America_Central": "America/Chicago",
+    "America_Eastern": "America/New_York",
+    "America_Mountain": "America/Denver",
+    "America_Pacific": "America/Los_Angeles",
+    "Anadyr": "Asia/Anadyr",
+    "Apia": "Pacific/Apia",
+    "Aqtau": "Asia/Aqtau",
+    "Aqtobe": "Asia/Aqtobe",
+    "Arabian": "Asia/Riyadh",
+    "Argentina": "America/Buenos_Aires",
+    "Argentina_Western": "America/Argentina/San_Luis",
+    "Armenia": "Asia/Yerevan",
+    "Ashkhabad": "Asia/Ashgabat",
+    "Atlantic": "America/Halifax",
+    "Australia_Central": "Australia/Adelaide",
+    "Australia_CentralWestern": "Australia/Eucla",
+    "Australia_Eastern": "Australia/Sydney",
+    "Australia_Western": "Australia/Perth",
+    "Azerbaijan": "Asia/Baku",
+    "Azores": "Atlantic/Azores",
+    "Baku": "Asia/Baku",
+    "Bangladesh": "Asia/Dhaka",
+    "Bering": "America/Adak",
+    "Bhutan": "Asia/Thimphu",
+    "Bolivia": "America/La_Paz",
+    "Borneo": "Asia/Kuching",
+    "Brasilia": "America/Sao_Paulo",
+    "British": "Europe/London",
+    "Brunei": "Asia/Brunei",
+    "Cape_Verde": "Atlantic/Cape_Verde",
+    "Casey": "Antarctica/Casey",
+    "Chamorro": "Pacific/Saipan",
+    "Chatham": "Pacific/Chatham",
+    "Chile": "America/Santiago",
+    "China": "Asia/Shanghai",
+    "Choibalsan": "Asia/Choibalsan",
+    "Christmas": "Indian/Christmas",
+    "Cocos": "Indian/Cocos",
+    "Colombia": "America/Bogota",
+    "Cook": "Pacific/Rarotonga",
+    "Cuba": "America/Havana",
+    "Dacca": "Asia/Dhaka",
+    "Davis": "Antarctica/Davis",
+    "Dominican": "America/Santo_Domingo",
+    "DumontDUrville": "Antarctica/DumontDUrville",
+    "Dushanbe": "Asia/Dushanbe",
+    "Dutch_Guiana": "America/Paramaribo",
+    "East_Timor": "Asia/Dili",
+    "Easter": "Pacific/Easter",
+    "Ecuador": "America/Guayaquil",
+    "Europe_Central": "Europe/Paris",
+    "Europe_Eastern": "Europe/Bucharest",
+    "Europe_Further_Eastern": "Europe/Minsk",
+    "Europe_Western": "Atlantic/Canary",
+    "Falkland": "Atlantic/Stanley",
+    "Fiji": "Pacific/Fiji",
+    "French_Guiana": "America/Cayenne",
+    "French_Southern": "Indian/Kerguelen",
+    "Frunze": "Asia/Bishkek",
+    "GMT": "Atlantic/Reykjavik",
+    "Galapagos": "Pacific/Galapagos",
+    "Gambier": "Pacific/Gambier",
+    "Georgia": "Asia/Tbilisi",
+    "Gilbert_Islands": "Pacific/Tarawa",
+    "Goose_Bay": "America/Goose_Bay",
+    "Greenland": "America/Godthab",
+    "Greenland_Central": "America/Scoresbysund",
+    "Greenland_Eastern": "America/Scoresbysund",
+    "Greenland_Western": "America/Godthab",
+    "Guam": "Pacific/Guam",
+    "Gulf": "Asia/Dubai",
+    "Guyana": "America/Guyana",
+    "Hawaii": "Pacific/Honolulu",
+    "Hawaii_Aleutian": "America/Adak",
+    "Hong_Kong": "Asia/Hong_Kong",
+    "Hovd": "Asia/Hovd",
+    "India": "Asia/Calcutta",
+    "Indian_Ocean": "Indian/Chagos",
+    "Indochina": "Asia/Bangkok",
+    "Indonesia_Central": "Asia/Makassar",
+    "Indonesia_Eastern": "Asia/Jayapura",
+    "Indonesia_Western": "Asia/Jakarta",
+    "Iran": "Asia/Tehran",
+    "Irish": "Europe/Dublin",
+    "Irkutsk": "Asia/Irkutsk",
+    "Israel": "Asia/Jerusalem",
+    "Japan": "Asia/Tokyo",
+    "Kamchatka": "Asia/Kamchatka",
+    "Karachi": "Asia/Karachi",
+    "Kazakhstan": "Asia/Almaty",
+    "Kazakhstan_Eastern": "Asia/Almaty",
+    "Kazakhstan_Western": "Asia/Aqtobe",
+    "Kizilorda": "Asia/Qyzylorda",
+    "Korea": "Asia/Seoul",
+    "Kosrae": "Pacific/Kosrae",
+    "Krasnoyarsk": "Asia/Krasnoyarsk",
+    "Kuybyshev": "Europe/Samara",
+    "Kwajalein": "Pacific/Kwajalein",
+    "Kyrgystan": "Asia/Bishkek",
+    "Lanka": "Asia/Colombo",
+    "Liberia": "Africa/Monrovia",
+    "Line_Islands": "Pacific/Kiritimati",
+    "Lord_Howe": "Australia/Lord_Howe",
+    "Macau": "Asia/Macau",
+    "Macquarie": "Antarctica/Macquarie",
+    "Magadan": "Asia/Magadan",
+    "Malaya": "Asia/Kuala_Lumpur",
+    "Malaysia": "Asia/Kuching",
+    "Maldives": "Indian/Maldives",
+    "Marquesas": "Pacific/Marquesas",
+    "Marshall_Islands": "Pacific/Majuro",
+    "Mauritius": "Indian/Mauritius",
+    "Mawson": "Antarctica/Mawson",
+    "Mexico_Northwest": "America/Santa_Isabel",
+    "Mexico_Pacific": "America/Mazatlan",
+    "Mongolia": "Asia/Ulaanbaatar",
+    "Moscow": "Europe/Moscow",
+    "Myanmar": "Asia/Rangoon",
+    "Nauru": "Pacific/Nauru",
+    "Nepal": "Asia/Katmandu",
+    "New_Caledonia": "Pacific/Noumea",
+    "New_Zealand": "Pacific/Auckland",
+    "Newfoundland": "America/St_Johns",
+    "Niue": "Pacific/Niue",
+    "Norfolk": "Pacific/Norfolk",
+    "Noronha": "America/Noronha",
+    "North_Mariana": "Pacific/Saipan",
+    "Novosibirsk": "Asia/Novosibirsk",
+    "Omsk": "Asia/Omsk",
+    "Oral": "Asia/Oral",
+    "Pakistan": "Asia/Karachi",
+    "Palau": "Pacific/Palau",
+    "Papua_New_Guinea": "Pacific/Port_Moresby",
+    "Paraguay": "America/Asuncion",
+    "Peru": "America/Lima",
+    "Philippines": "Asia/Manila",
+    "Phoenix_Islands": "Pacific/Enderbury",
+    "Pierre_Miquelon": "America/Miquelon",
+    "Pitcairn": "Pacific/Pitcairn",
+    "Ponape": "Pacific/Ponape",
+    "Pyongyang": "Asia/Pyongyang",
+    "Qyzylorda": "Asia/Qyzylorda",
+    "Reunion": "Indian/Reunion",
+    "Rothera": "Antarctica/Rothera",
+    "Sakhalin": "Asia/Sakhalin",
+    "Samara": "Europe/Samara",
+    "Samarkand": "Asia/Samarkand",
+    "Samoa": "Pacific/Pago_Pago",
+    "Seychelles": "Indian/Mahe",
+    "Shevchenko": "Asia/Aqtau",
+    "Singapore": "Asia/Singapore",
+    "Solomon": "Pacific/Guadalcanal",
+    "South_Georgia": "Atlantic/South_Georgia",
+    "Suriname": "America/Paramaribo",
+    "Sverdlovsk": "Asia/Yekaterinburg",
+    "Syowa": "Antarctica/Syowa",
+    "Tahiti": "Pacific/Tahiti",
+    "Taipei": "Asia/Taipei",
+    "Tajikistan": "Asia/Dushanbe",
+    "Tashkent": "Asia/Tashkent",
+    "Tbilisi": "Asia/Tbilisi",
+    "Tokelau": "Pacific/Fakaofo",
+    "Tonga": "Pacific/Tongatapu",
+    "Truk": "Pacific/Truk",
+    "Turkey": "Europe/Istanbul",
+    "Turkmenistan": "Asia/Ashgabat",
+    "Tuvalu": "Pacific/Funafuti",
+    "Uralsk": "Asia/Oral",
+    "Uruguay": "America/Montevideo",
+    "Urumqi": "Asia/Urumqi",
+    "Uzbekistan": "Asia/Tashkent",
+    "Vanuatu": "Pacific/Efate",
+    "Venezuela": "America/Caracas",
+    "Vladivostok": "Asia/Vladivostok",
+    "Volgograd": "Europe/Volgograd",
+    "Vostok": "Antarctica/Vostok",
+    "Wake": "Pacific/Wake",
+    "Wallis": "Pacific/Wallis",
+    "Yakutsk": "Asia/Yakutsk",
+    "Yekaterinburg": "Asia/Yekaterinburg",
+    "Yerevan": "Asia/Yerevan",
+    "Yukon": "America/Whitehorse",
+}
+
+var primaryZones = map[string]string{
+    "Africa/Abidjan": "CI",
+    "Africa/Accra": "GH",
+    "Africa/Addis_Ababa": "ET",
+    "Africa/Algiers": "DZ",
+    "Africa/Asmara": "ER",
+    "Africa/Asmera": "ER",
+    "Africa/Bamako": "ML",
+    "Africa/Bangui": "CF",
+    "Africa/Banjul": "GM",
+    "Africa/Bissau": "GW",
+    "Africa/Blantyre": "MW",
+    "Africa/Brazzaville": "CG",
+    "Africa/Bujumbura": "BI",
+    "Africa/Cairo": "EG",
+    "Africa/Casablanca": "MA",
+    "Africa/Conakry": "GN",
+    "Africa/Dakar": "SN",
+    "Africa/Dar_es_Salaam": "TZ",
+    "Africa/Djibouti": "DJ",
+    "Africa/Douala": "CM",
+    "Africa/El_Aaiun": "EH",
+    "Africa/Freetown": "SL",
+    "Africa/Gaborone": "BW",
+    "Africa/Harare": "ZW",
+    "Africa/Johannesburg": "ZA",
+    "Africa/Juba": "SS",
+    "Africa/Kampala": "UG",
+    "Africa/Khartoum": "SD",
+    "Africa/Kigali": "RW",
+    "Africa/Lagos": "NG",
+    "Africa/Libreville": "GA",
+    "Africa/Lome": "TG",
+    "Africa/Luanda": "AO",
+    "Africa/Lusaka": "ZM",
+    "Africa/Malabo": "GQ",
+    "Africa/Maputo": "MZ",
+    "Africa/Maseru": "LS",
+    "Africa/Mbabane": "SZ",
+    "Africa/Mogadishu": "SO",
+    "Africa/Monrovia": "LR",
+    "Africa/Nairobi": "KE",
+    "Africa/Ndjamena": "TD",
+    "Africa/Niamey": "NE",
+    "Africa/Nouakchott": "MR",
+    "Africa/Ouagadougou": "BF",
+    "Africa/Porto-Novo": "BJ",
+    "Africa/Sao_Tome": "ST",
+    "Africa/Timbuktu": "ML",
+    "Africa/Tripoli": "LY",
+    "Africa/Tunis": "TN",
+    "Africa/Windhoek": "NA",
+    "America/Anguilla": "AI",
+    "America/Antigua": "AG",
+    "America/Aruba": "AW",
+    "America/Asuncion": "PY",
+    "America/Barbados": "BB",
+    "America/Belize": "BZ",
+    "America/Bogota": "CO",
+    "America/Caracas": "VE",
+    "America/Cayenne": "GF",
+    "America/Cayman": "KY",
+    "America/Costa_Rica": "CR",
+    "America/Curacao": "CW",
+    "America/Dominica": "DM",
+    "America/El_Salvador": "SV",
+    "America/Grand_Turk": "TC",
+    "America/Grenada": "GD",
+    "America/Guatemala": "GT",
+    "America/Guayaquil": "EC",
+    "America/Guyana": "GY",
+    "America/Havana": "CU",
+    "America/Jamaica": "JM",
+    "America/Kralendijk": "BQ",
+    "America/La_Paz": "BO",
+    "America/Lima": "PE",
+    "America/Lower_Princes": "SX",
+    "America/Managua": "NI",
+    "America/Marigot": "MF",
+    "America/Martinique": "MQ",
+    "America/Miquelon": "PM",
+    "America/Montevideo": "UY",
+    "America/Montserrat": "MS",
+    "America/Nassau": "BS",
+    "America/Panama": "PA",
+    "America/Paramaribo": "SR",
+    "America/Port-au-Prince": "HT",
+    "America/Port_of_Spain": "TT",
+    "America/Puerto_Rico": "PR",
+    "America/Santiago": "CL",
+    "America/Santo_Domingo": "DO",
+    "America/St_Kitts": "KN",
+    "America/St_Lucia": "LC",
+    "America/St_Thomas": "VI",
+    "America/St_Vincent": "VC",
+    "America/Tegucigalpa": "HN",
+    "America/Tortola": "VG",
+    "America/Virgin": "VI",
+    "Antarctica/South_Pole": "NZ",
+    "Arctic/Longyearbyen": "SJ",
+    "Asia/Aden": "YE",
+    "Asia/Amman": "JO",
+    "Asia/Ashgabat": "TM",
+    "Asia/Ashkhabad": "TM",
+    "Asia/Baghdad": "IQ",
+    "Asia/Bahrain": "BH",
+    "Asia/Baku": "AZ",
+    "Asia/Bangkok": "TH",
+    "Asia/Beirut": "LB",
+    "Asia/Bishkek": "KG",
+    "Asia/Brunei": "BN",
+    "Asia/Calcutta": "IN",
+    "Asia/Chongqing": "CN",
+    "Asia/Chungking": "CN",
+    "Asia/Colombo": "LK",
+    "Asia/Dacca": "BD",
+    "Asia/Damascus": "SY",
+    "Asia/Dhaka": "BD",
+    "Asia/Dili": "TL",
+    "Asia/Dubai": "AE",
+    "Asia/Dushanbe": "TJ",
+    "Asia/Harbin": "CN",
+    "Asia/Ho_Chi_Minh": "VN",
+    "Asia/Hong_Kong": "HK",
+    "Asia/Istanbul": "TR",
+    "Asia/Kabul": "AF",
+    "Asia/Karachi": "PK",
+    "Asia/Kathmandu": "NP",
+    "Asia/Katmandu": "NP",
+    "Asia/Kolkata": "IN",
+    "Asia/Kuala_Lumpur": "MY",
+    "Asia/Kuwait": "KW",
+    "Asia/Macao": "MO",
+    "Asia/Macau": "MO",
+    "Asia/Manila": "PH",
+    "Asia/Muscat": "OM",
+    "Asia/Phnom_Penh": "KH",
+    "Asia/Pyongyang": "KP",
+    "Asia/Qatar": "QA",
+    "Asia/Rangoon": "MM",
+    "Asia/Riyadh": "SA",
+    "Asia/Saigon": "VN",
+    "Asia/Seoul": "KR",
+    "Asia/Shanghai": "CN",
+    "Asia/Singapore": "SG",
+    "Asia/Taipei": "TW",
+    "Asia/Tashkent": "UZ",
+    "Asia/Tbilisi": "GE",
+    "Asia/Tehran": "IR",
+    "Asia/Thimbu": "BT",
+    "Asia/Thimphu": "BT",
+    "Asia/Tokyo": "JP",
+    "Asia/Vientiane": "LA",
+    "Asia/Yangon": "MM",
+    "Asia/Yerevan": "AM",
+    "Atlantic/Bermuda": "BM",
+    "Atlantic/Cape_Verde": "CV",
+    "Atlantic/Faeroe": "FO",
+    "Atlantic/Faroe": "FO",
+    "Atlantic/Jan_Mayen": "SJ",
+    "Atlantic/Reykjavik": "IS",
+    "Atlantic/South_Georgia": "GS",
+    "Atlantic/St_Helena": "SH",
+    "Atlantic/Stanley": "FK",
+    "Chile/Continental": "CL",
+    "Cuba": "CU",
+    "Egypt": "EG",
+    "Eire": "IE",
+    "Europe/Amsterdam": "NL",
+    "Europe/Andorra": "AD",
+    "Europe/Athens": "GR",
+    "Europe/Belfast": "GB",
+    "Europe/Belgrade": "RS",
+    "Europe/Berlin": "DE",
+    "Europe/Bratislava": "SK",
+    "Europe/Brussels": "BE",
+    "Europe/Bucharest": "RO",
+    "Europe/Budapest": "HU",
+    "Europe/Chisinau": "MD",
+    "Europe/Copenhagen": "DK",
+    "Europe/Dublin": "IE",
+    "Europe/Gibraltar": "GI",
+    "Europe/Guernsey": "GG",
+    "Europe/Helsinki": "FI",
+    "Europe/Isle_of_Man": "IM",
+    "Europe/Istanbul": "TR",
+    "Europe/Jersey": "JE",
+    "Europe/Kiev": "UA",
+    "Europe/Kyiv": "UA",
+    "Europe/Lisbon": "PT",
+    "Europe/Ljubljana": "SI",
+    "Europe/London": "GB",
+    "Europe/Luxembourg": "LU",
+    "Europe/Madrid": "ES",
+    "Europe/Malta": "MT",
+    "Europe/Mariehamn": "AX",
+    "Europe/Minsk": "BY",
+    "Europe/Monaco": "MC",
+    "Europe/Oslo": "NO",
+    "Europe/Paris": "FR",
+    "Europe/Podgorica": "ME",
+    "Europe/Prague": "CZ",
+    "Europe/Riga": "LV",
+    "Europe/Rome": "IT",
+    "Europe/San_Marino": "SM",
+    "Europe/Sarajevo": "BA",
+    "Europe/Skopje": "MK",
+    "Europe/Sofia": "BG",
+    "Europe/Stockholm": "SE",
+    "Europe/Tallinn": "EE",
+    "Europe/Tirane": "AL",
+    "Europe/Tiraspol": "MD",
+    "Europe/Vaduz": "LI",
+    "Europe/Vatican": "VA",
+    "Europe/Vienna": "AT",
+    "Europe/Vilnius": "LT",
+    "Europe/Warsaw": "PL",
+    "Europe/Zagreb": "HR",
+    "Europe/Zurich": "CH",
+    "GB": "GB",
+    "GB-Eire": "GB",
+    "Hongkong": "HK",
+    "Iceland": "IS",
+    "Indian/Antananarivo": "MG",
+    "Indian/Chagos": "IO",
+    "Indian/Christmas": "CX",
+    "Indian/Cocos": "CC",
+    "Indian/Comoro": "KM",
+    "Indian/Kerguelen": "TF",
+    "Indian/Mahe": "SC",
+    "Indian/Maldives": "MV",
+    "Indian/Mauritius": "MU",
+    "Indian/Mayotte": "YT",
+    "Indian/Reunion": "RE",
+    "Iran": "IR",
+    "Jamaica": "JM",
+    "Japan": "JP",
+    "Libya": "LY",
+    "NZ": "NZ",
+    "PRC": "CN",
+    "Pacific/Apia": "WS",
+    "Pacific/Auckland": "NZ",
+    "Pacific/Efate": "VU",
+    "Pacific/Fakaofo": "TK",
+    "Pacific/Fiji": "FJ",
+    "Pacific/Funafuti": "TV",
+    "Pacific/Guadalcanal": "SB",
+    "Pacific/Guam": "GU",
+    "Pacific/Majuro": "MH",
+    "Pacific/Nauru": "NR",
+    "Pacific/Niue": "NU",
+    "Pacific/Norfolk": "NF",
+    "Pacific/Noumea": "NC",
+    "Pacific/Pago_Pago": "AS",
+    "Pacific/Palau": "PW",
+    "Pacific/Pitcairn": "PN",
+    "Pacific/Rarotonga": "CK",
+    "Pacific/Saipan": "MP",
+    "Pacific/Samoa": "AS",
+    "Pacific/Tongatapu": "TO",
+    "Pacific/Wallis": "WF",
+    "Poland": "PL",
+    "Portugal": "PT",
+    "ROC": "TW",
+    "ROK": "KR",
+    "Singapore": "SG",
+    "Turkey": "TR",
+    "US/Samoa": "AS",
+}
+
+var weekData = map[string]WeekData{
+    "001": {1, 1, 6, 0},
+    "AD": {1, 4, 6, 0},
+    "AE": {6, 1, 5, 6},
+    "AF": {6, 1, 4, 5},
+    "AG": {0, 1, 6, 0},
+    "AI": {1, 1, 6, 0},
+    "AL": {1, 1, 6, 0},
+    "AM": {1, 1, 6, 0},
+    "AN": {1, 4, 6, 0},
+    "AR": {1, 1, 6, 0},
+    "AS": {0, 1, 6, 0},
+    "AT": {1, 4, 6, 0},
+    "AU": {1, 1, 6, 0},
+    "AX": {1, 4, 6, 0},
+    "AZ": {1, 1, 6, 0},
+    "BA": {1, 1, 6, 0},
+    "BD": {0, 1, 6, 0},
+    "BE": {1, 4, 6, 0},
+    "BG": {1, 4, 6, 0},
+    "BH": {6, 1, 5, 6},
+    "BM": {1, 1, 6, 0},
+    "BN": {1, 1, 6, 0},
+    "BR": {0, 1, 6, 0},
+    "BS": {0, 1, 6, 0},
+    "BT": {0, 1, 6, 0},
+    "BW": {0, 1, 6, 0},
+    "BY": {1, 1, 6, 0},
+    "BZ": {0, 1, 6, 0},
+    "CA": {0, 1, 6, 0},
+    "CH": {1, 4, 6, 0},
+    "CL": {1, 1, 6, 0},
+    "CM": {1, 1, 6, 0},
+    "CN": {1, 1, 6, 0},
+    "CO": {0, 1, 6, 0},
+    "CR": {1, 1, 6, 0},
+    "CY": {1, 1, 6, 0},
+    "CZ": {1, 4, 6, 0},
+    "DE": {1, 4, 6, 0},
+    "DJ": {6, 1, 6, 0},
+    "DK": {1, 4, 6, 0},
+    "DM": {0, 1, 6, 0},
+    "DO": {0, 1, 6, 0},
+    "DZ": {6, 1, 5, 6},
+    "EC": {1, 1, 6, 0},
+    "EE": {1, 4, 6, 0},
+    "EG": {6, 1, 5, 6},
+    "ES": {1, 4, 6, 0},
+    "ET": {0, 1, 6, 0},
+    "FI": {1, 4, 6, 0},
+    "FJ": {1, 4, 6, 0},
+    "FO": {1, 4, 6, 0},
+    "FR": {1, 4, 6, 0},
+    "GB": {1, 4, 6, 0},
+    "GE": {1, 1, 6, 0},
+    "GF": {1, 4, 6, 0},
+    "GG": {1, 4, 6, 0},
+    "GI": {1, 4, 6, 0},
+    "GP": {1, 4, 6, 0},
+    "GR": {1, 4, 6, 0},
+    "GT": {0, 1, 6, 0},
+    "GU": {0, 1, 6, 0},
+    "HK": {0, 1, 6, 0},
+    "HN": {0, 1, 6, 0},
+    "HR": {1, 1, 6, 0},
+    "HU": {1, 4, 6, 0},
+    "ID": {0, 1, 6, 0},
+    "IE": {1, 4, 6, 0},
+    "IL": {0, 1, 5, 6},
+    "IM": {1, 4, 6, 0},
+    "IN": {0, 1, 0, 0},
+    "IQ": {6, 1, 5, 6},
+    "IR": {6, 1, 5, 5},
+    "IS": {1, 4, 6, 0},
+    "IT": {1, 4, 6, 0},
+    "JE": {1, 4, 6, 0},
+    "JM": {0, 1, 6, 0},
+    "JO": {6, 1, 5, 6},
+    "JP": {0, 1, 6, 0},
+    "KE": {0, 1, 6, 0},
+    "KG": {1, 1, 6, 0},
+    "KH": {0, 1, 6, 0},
+    "KR": {0, 1, 6, 0},
+    "KW": {6, 1, 5, 6},
+    "KZ": {1, 1, 6, 0},
+    "LA": {0, 1, 6, 0},
+    "LB": {1, 1, 6, 0},
+    "LI": {1, 4, 6, 0},
+    "LK": {1, 1, 6, 0},
+    "LT": {1, 4, 6, 0},
+    "LU": {1, 4, 6, 0},
+    "LV": {1, 1, 6, 0},
+    "LY": {6, 1, 5, 6},
+    "MC": {1, 4, 6, 0},
+    "MD": {1, 1, 6, 0},
+    "ME": {1, 1, 6, 0},
+    "MH": {0, 1, 6, 0},
+    "MK": {1, 1, 6, 0},
+    "MM": {0, 1, 6, 0},
+    "MN": {1, 1, 6, 0},
+    "MO": {0, 1, 6, 0},
+    "MQ": {1, 4, 6, 0},
+    "MT": {0, 1, 6, 0},
+    "MV": {5, 1, 6, 0},
+    "MX": {0, 1, 6, 0},
+    "MY": {1, 1, 6, 0},
+    "MZ": {0, 1, 6, 0},
+    "NI": {0, 1, 6, 0},
+    "NL": {1, 4, 6, 0},
+    "NO": {1, 4, 6, 0},
+    "NP": {0, 1, 6, 0},
+    "NZ": {1, 1, 6, 0},
+    "OM": {6, 1, 5, 6},
+    "PA": {0, 1, 6, 0},
+    "PE": {0, 1, 6, 0},
+    "PH": {0, 1, 6, 0},
+    "PK": {0, 1, 6, 0},
+    "PL": {1, 4, 6, 0},
+    "PR": {0, 1, 6, 0},
+    "PT": {0, 4, 6, 0},
+    "PY": {0, 1, 6, 0},
+    "QA": {6, 1, 5, 6},
+    "RE": {1, 4, 6, 0},
+    "RO": {1, 1, 6, 0},
+    "RS": {1, 1, 6, 0},
+    "RU": {1, 4, 6, 0},
+    "SA": {0, 1, 5, 6},
+    "SD": {6, 1, 5, 6},
+    "SE": {1, 4, 6, 0},
+    "SG": {0, 1, 6, 0},
+    "SI": {1, 1, 6, 0},
+    "SJ": {1, 4, 6, 0},
+    "SK": {1, 4, 6, 0},
+    "SM": {1, 4, 6, 0},
+    "SV": {0, 1, 6, 0},
+    "SY": {6, 1, 5, 6},
+    "TH": {0, 1, 6, 0},
+    "TJ": {1, 1, 6, 0},
+    "TM": {1, 1, 6, 0},
+    "TR": {1, 1, 6, 0},
+    "TT": {0, 1, 6, 0},
+    "TW": {0, 1, 6, 0},
+    "UA": {1, 1, 6, 0},
+    "UG": {1, 1, 0, 0},
+    "UM": {0, 1, 6, 0},
+    "US": {0, 1, 6, 0},
+    "UY": {1, 1, 6, 0},
+    "UZ": {1, 1, 6, 0},
+    "VA": {1, 4, 6, 0},
+    "VE": {0, 1, 6, 0},
+    "VI": {0, 1, 6, 0},
+    "VN": {1, 1, 6, 0},
+    "WS": {0, 1, 6, 0},
+    "XK": {1, 1, 6, 0},
+    "YE": {0, 1, 5, 6},
+    "ZA": {0, 1, 6, 0},
+    "ZW": {0, 1, 6, 0},
+}
+
+var timeData = map[string]TimeData{
+    "001": {"H", []string{
+        "H",
+        "h",
+    }},
+    "419": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "AC": {"H", []string{
+        "H",
+        "h",
+    }},
+    "AD": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "AE": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "AG": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "AI": {"H", []string{
+        "H",
+        "h",
+    }},
+    "AM": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "AO": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "AR": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "AS": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "AT": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "AU": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "AW": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "AX": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "AZ": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "BA": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "BB": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "BD": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "BE": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "BF": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "BG": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "BH": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "BJ": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "BL": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "BM": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "BO": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "BQ": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "BR": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "BS": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "BT": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "BW": {"H", []string{
+        "H",
+        "h",
+    }},
+    "BZ": {"H", []string{
+        "H",
+        "h",
+    }},
+    "CA": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "CC": {"H", []string{
+        "H",
+        "h",
+    }},
+    "CD": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "CF": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "CG": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "CH": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "CI": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "CK": {"H", []string{
+        "H",
+        "h",
+    }},
+    "CL": {"H", []string{
+        "H",
+        "h",
+        "hB",
+        "hb",
+    }},
+    "CM": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "CN": {"H", []string{
+        "H",
+        "hB",
+        "hb",
+        "h",
+    }},
+    "CO": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "CR": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "CU": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "CV": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "CX": {"H", []string{
+        "H",
+        "h",
+    }},
+    "CZ": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "DE": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "DG": {"H", []string{
+        "H",
+        "h",
+    }},
+    "DJ": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "DK": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "DM": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "DO": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "DZ": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "EC": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "EE": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "EG": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "EH": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "ER": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "ES": {"H", []string{
+        "H",
+        "h",
+        "hB",
+        "hb",
+    }},
+    "FI": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "FJ": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "FK": {"H", []string{
+        "H",
+        "h",
+    }},
+    "FM": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "FO": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "FR": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "GA": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "GB": {"H", []string{
+        "H",
+        "h",
+    }},
+    "GD": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "GE": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "GF": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "GG": {"H", []string{
+        "H",
+        "h",
+    }},
+    "GH": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "GI": {"H", []string{
+        "H",
+        "h",
+    }},
+    "GL": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "GN": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "GP": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "GQ": {"H", []string{
+        "H",
+        "h",
+        "hB",
+        "hb",
+    }},
+    "GT": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "GU": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "GW": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "GY": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "HK": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "HN": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "HR": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "HU": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "ID": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "IE": {"H", []string{
+        "H",
+        "h",
+    }},
+    "IL": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "IM": {"H", []string{
+        "H",
+        "h",
+    }},
+    "IN": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "IO": {"H", []string{
+        "H",
+        "h",
+    }},
+    "IQ": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "IS": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "IT": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "JE": {"H", []string{
+        "H",
+        "h",
+    }},
+    "JM": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "JO": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "JP": {"H", []string{
+        "H",
+        "K",
+        "h",
+    }},
+    "KH": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "KI": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "KN": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "KR": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "KW": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "KY": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "KZ": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "LB": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "LC": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "LI": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "LR": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "LS": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "LT": {"H", []string{
+        "H",
+        "h",
+    }},
+    "LU": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "LY": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "MC": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "MD": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "ME": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "MF": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "MH": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "MK": {"H", []string{
+        "H",
+        "h",
+    }},
+    "MN": {"H", []string{
+        "H",
+        "h",
+    }},
+    "MO": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "MP": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "MQ": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "MR": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "MS": {"H", []string{
+        "H",
+        "h",
+    }},
+    "MW": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "MX": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "MY": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "MZ": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "NC": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "NF": {"H", []string{
+        "H",
+        "h",
+    }},
+    "NG": {"H", []string{
+        "H",
+        "h",
+    }},
+    "NI": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "NL": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "NO": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "NP": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "NR": {"H", []string{
+        "H",
+        "h",
+    }},
+    "NU": {"H", []string{
+        "H",
+        "h",
+    }},
+    "NZ": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "OM": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "PA": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "PE": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "PF": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "PG": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "PH": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "PK": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "PL": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "PM": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "PN": {"H", []string{
+        "H",
+        "h",
+    }},
+    "PR": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "PS": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "PT": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "PW": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "PY": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "QA": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "RE": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "RO": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "RS": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "RU": {"H", []string{
+        "H",
+        "h",
+        "hB",
+        "hb",
+    }},
+    "SA": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "SB": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "SC": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "SD": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "SE": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "SG": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "SH": {"H", []string{
+        "H",
+        "h",
+    }},
+    "SI": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "SJ": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "SK": {"H", []string{
+        "H",
+        "hb",
+    }},
+    "SL": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "SM": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "SN": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "SO": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "SR": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "SS": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "ST": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "SV": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "SY": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "TA": {"H", []string{
+        "H",
+        "h",
+    }},
+    "TC": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "TF": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "TG": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "TH": {"H", []string{
+        "H",
+        "h",
+    }},
+    "TN": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "TO": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "TR": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "TT": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "TW": {"h", []string{
+        "hB",
+        "hb",
+        "h",
+        "H",
+    }},
+    "UA": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "UM": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "US": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "UY": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "UZ": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "VA": {"H", []string{
+        "H",
+        "hB",
+        "h",
+        "hb",
+    }},
+    "VC": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "VE": {"h", []string{
+        "h",
+        "H",
+        "hB",
+        "hb",
+    }},
+    "VG": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "VI": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "VN": {"H", []string{
+        "H",
+        "h",
+    }},
+    "VU": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "WF": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "WS": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "XK": {"H", []string{
+        "H",
+        "h",
+        "hb",
+        "hB",
+    }},
+    "YE": {"h", []string{
+        "h",
+        "hB",
+        "hb",
+        "H",
+    }},
+    "YT": {"H", []string{
+        "H",
+        "hB",
+    }},
+    "ZA": {"H", []string{
+        "H",
+        "h",
+    }},
+    "ZM": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "ca_ES": {"H", []string{
+        "H",
+        "h",
+        "hB",
+        "hb",
+    }},
+    "en_001": {"h", []string{
+        "h",
+        "hb",
+        "H",
+        "hB",
+    }},
+    "eu_ES": {"H", []string{
+        "H",
+        "h",
+        "hB",
+        "hb",
+    }},
+    "gl_ES": {"H", []string{
+        "H",
+        "h",
+        "hB",
+        "hb",
+    }},
+}
+
+var measurementSystems = map[string]string{
+    "001": "metric",
+    "GB": "uksystem",
+    "LR": "ussystem",
+    "MM": "ussystem",
+    "US": "ussystem",
+}
+
+var numberingSystems = map[string]string{
+    "adlm": "𞥐𞥑𞥒𞥓𞥔𞥕𞥖𞥗𞥘𞥙",
+    "ahom": "𑜰𑜱𑜲𑜳𑜴𑜵𑜶𑜷𑜸𑜹",
+    "arab": "٠١٢٣٤٥٦٧٨٩",
+    "arabext": "۰۱۲۳۴۵۶۷۸۹",
+    "bali": "᭐᭑᭒᭓᭔᭕᭖᭗᭘᭙",
+    "beng": "০১২৩৪৫৬৭৮৯",
+    "bhks": "𑱐𑱑𑱒𑱓𑱔𑱕𑱖𑱗𑱘𑱙",
+    "brah": "𑁦𑁧𑁨𑁩𑁪𑁫𑁬𑁭𑁮𑁯",
+    "cakm": "𑄶𑄷𑄸𑄹𑄺𑄻𑄼𑄽𑄾𑄿",
+    "cham": "꩐꩑꩒꩓꩔꩕꩖꩗꩘꩙",
+    "deva": "०१२३४५६७८९",
+    "fullwide": "０１２３４５６７８９",
+    "gong": "𑶠𑶡𑶢𑶣𑶤𑶥𑶦𑶧𑶨𑶩",
+    "gonm": "𑵐𑵑𑵒𑵓𑵔𑵕𑵖𑵗𑵘𑵙",
+    "gujr": "૦૧૨૩૪૫૬૭૮૯",
+    "guru": "੦੧੨੩੪੫੬੭੮੯",
+    "hanidec": "〇一二三四五六七八九",
+    "hmng": "𖭐𖭑𖭒𖭓𖭔𖭕𖭖𖭗𖭘𖭙",
+    "hmnp": "𞅀𞅁𞅂𞅃𞅄𞅅𞅆𞅇𞅈𞅉",
+    "java": "꧐꧑꧒꧓꧔꧕꧖꧗꧘꧙",
+    "kali": "꤀꤁꤂꤃꤄꤅꤆꤇꤈꤉",
+    "kawi": "𑽐𑽑𑽒𑽓𑽔𑽕𑽖𑽗𑽘𑽙",
+    "khmr": "០១២៣៤៥៦៧៨៩",
+    "knda": "೦೧೨೩೪೫೬೭೮೯",
+    "lana": "᪀᪁᪂᪃᪄᪅᪆᪇᪈᪉",
+    "lanatham": "᪐᪑᪒᪓᪔᪕᪖᪗᪘᪙",
+    "laoo": "໐໑໒໓໔໕໖໗໘໙",
+    "latn": "0123456789",
+    "lepc": "᱀᱁᱂᱃᱄᱅᱆᱇᱈᱉",
+    "limb": "᥆᥇᥈᥉᥊᥋᥌᥍᥎᥏",
+    "mathbold": "𝟎𝟏𝟐𝟑𝟒𝟓𝟔𝟕𝟖𝟗",
+    "mathdbl": "𝟘𝟙𝟚𝟛𝟜𝟝𝟞𝟟𝟠𝟡",
+    "mathmono": "𝟶𝟷𝟸𝟹𝟺𝟻𝟼𝟽𝟾𝟿",
+    "mathsanb": "𝟬𝟭𝟮𝟯𝟰𝟱𝟲𝟳𝟴𝟵",
+    "mathsans": "𝟢𝟣𝟤𝟥𝟦𝟧𝟨𝟩𝟪𝟫",
+    "mlym": "൦൧൨൩൪൫൬൭൮൯",
+    "modi": "𑙐𑙑𑙒𑙓𑙔𑙕𑙖𑙗𑙘𑙙",
+    "mong": "᠐᠑᠒᠓᠔᠕᠖᠗᠘᠙",
+    "mroo": "𖩠𖩡𖩢𖩣𖩤𖩥𖩦𖩧𖩨𖩩",
+    "mtei": "꯰꯱꯲꯳꯴꯵꯶꯷꯸꯹",
+    "mymr": "၀၁၂၃၄၅၆၇၈၉",
+    "mymrshan": "႐႑႒႓႔႕႖႗႘႙",
+    "mymrtlng": "꧰꧱꧲꧳꧴꧵꧶꧷꧸꧹",
+    "nagm": "𞓰𞓱𞓲𞓳𞓴𞓵𞓶𞓷𞓸𞓹",
+    "newa": "𑑐𑑑𑑒𑑓𑑔𑑕𑑖𑑗𑑘𑑙",
+    "nkoo": "߀߁߂߃߄߅߆߇߈߉",
+    "olck": "᱐᱑᱒᱓᱔᱕᱖᱗᱘᱙",
+    "orya": "୦୧୨୩୪୫୬୭୮୯",
+    "osma": "𐒠𐒡𐒢𐒣𐒤𐒥𐒦𐒧𐒨𐒩",
+    "rohg": "𐴰𐴱𐴲𐴳𐴴𐴵𐴶𐴷𐴸𐴹",
+    "saur": "꣐꣑꣒꣓꣔꣕꣖꣗꣘꣙",
+    "shrd": "𑇐𑇑𑇒𑇓𑇔𑇕𑇖𑇗𑇘𑇙",
+    "sind": "𑋰𑋱𑋲𑋳𑋴𑋵𑋶𑋷𑋸𑋹",
+    "sinh": "෦෧෨෩෪෫෬෭෮෯",
+    "sora": "𑃰𑃱𑃲𑃳𑃴𑃵𑃶𑃷𑃸𑃹",
+    "sund": "᮰᮱᮲᮳᮴᮵᮶᮷᮸᮹",
+    "takr": "𑛀𑛁𑛂𑛃𑛄𑛅𑛆𑛇𑛈𑛉",
+    "talu": "᧐᧑᧒᧓᧔᧕᧖᧗᧘᧙",
+    "tamldec": "௦௧௨௩௪௫௬௭௮௯",
+    "telu": "౦౧౨౩౪౫౬౭౮౯",
+    "thai": "๐๑๒๓๔๕๖๗๘๙",
+    "tibt": "༠༡༢༣༤༥༦༧༨༩",
+    "tirh": "𑓐𑓑𑓒𑓓𑓔𑓕𑓖𑓗𑓘𑓙",
+    "tnsa": "𖫀𖫁𖫂𖫃𖫄𖫅𖫆𖫇𖫈𖫉",
+    "vaii": "꘠꘡꘢꘣꘤꘥꘦꘧꘨꘩",
+    "wara": "𑣠𑣡𑣢𑣣𑣤𑣥𑣦𑣧𑣨𑣩",
+    "wcho": "𞋰𞋱𞋲𞋳𞋴𞋵𞋶𞋷𞋸𞋹",
+}
+
+var bcp47Timezones = map[string]string{
+    "adalv": "Europe/Andorra",
+    "aedxb": "Asia/Dubai",
+    "afkbl": "Asia/Kabul",
+    "aganu": "America/Antigua",
+    "aiaxa": "America/Anguilla",
+    "altia": "Europe/Tirane",
+    "amevn": "Asia/Yerevan",
+    "ancur": "America/Curacao",
+    "aolad": "Africa/Luanda",
+    "aqcas": "Antarctica/Casey",
+    "aqdav": "Antarctica/Davis",
+    "aqddu": "Antarctica/DumontDUrville",
+    "aqmaw": "Antarctica/Mawson",
+    "aqmcm": "Antarctica/McMurdo",
+    "aqplm": "Antarctica/Palmer",
+    "aqrot": "Antarctica/Rothera",
+    "aqsyw": "Antarctica/Syowa",
+    "aqtrl": "Antarctica/Troll",
+    "aqvos": "Antarctica/Vostok",
+    "arbue": "America/Argentina/Buenos_Aires",
+    "arcor": "America/Argentina/Cordoba",
+    "arctc": "America/Argentina/Catamarca",
+    "arirj": "America/Argentina/La_Rioja",
+    "arjuj": "America/Argentina/Jujuy",
+    "arluq": "America/Argentina/San_Luis",
+    "armdz": "America/Argentina/Mendoza",
+    "arrgl": "America/Argentina/Rio_Gallegos",
+    "arsla": "America/Argentina/Salta",
+    "artuc": "America/Argentina/Tucuman",
+    "aruaq": "America/Argentina/San_Juan",
+    "arush": "America/Argentina/Ushuaia",
+    "asppg": "Pacific/Pago_Pago",
+    "atvie": "Europe/Vienna",
+    "auadl": "Australia/Adelaide",
+    "aubhq": "Australia/Broken_Hill",
+    "aubne": "Australia/Brisbane",
+    "audrw": "Australia/Darwin",
+    "aueuc": "Australia/Eucla",
+    "auhba": "Australia/Hobart",
+    "aukns": "Australia/Currie",
+    "auldc": "Australia/Lindeman",
+    "auldh": "Australia/Lord_Howe",
+    "aumel": "Australia/Melbourne",
+    "aumqi": "Antarctica/Macquarie",
+    "auper": "Australia/Perth",
+    "ausyd": "Australia/Sydney",
+    "awaua": "America/Aruba",
+    "azbak": "Asia/Baku",
+    "basjj": "Europe/Sarajevo",
+    "bbbgi": "America/Barbados",
+    "bddac": "Asia/Dhaka",
+    "bebru": "Europe/Brussels",
+    "bfoua": "Africa/Ouagadougou",
+    "bgsof": "Europe/Sofia",
+    "bhbah": "Asia/Bahrain",
+    "bibjm": "Africa/Bujumbura",
+    "bjptn": "Africa/Porto-Novo",
+    "bmbda": "Atlantic/Bermuda",
+    "bnbwn": "Asia/Brunei",
+    "bolpb": "America/La_Paz",
+    "bqkra": "America/Kralendijk",
+    "braux": "America/Araguaina",
+    "brbel": "America/Belem",
+    "brbvb": "America/Boa_Vista",
+    "brcgb": "America/Cuiaba",
+    "brcgr": "America/Campo_Grande",
+    "brern": "America/Eirunepe",
+    "brfen": "America/Noronha",
+    "brfor": "America/Fortaleza",
+    "brmao": "America/Manaus",
+    "brmcz": "America/Maceio",
+    "brpvh": "America/Porto_Velho",
+    "brrbr": "America/Rio_Branco",
+    "brrec": "America/Recife",
+    "brsao": "America/Sao_Paulo",
+    "brssa": "America/Bahia",
+    "brstm": "America/Santarem",
+    "bsnas": "America/Nassau",
+    "btthi": "Asia/Thimphu",
+    "bwgbe": "Africa/Gaborone",
+    "bymsq": "Europe/Minsk",
+    "bzbze": "America/Belize",
+    "cacfq": "America/Creston",
+    "caedm": "America/Edmonton",
+    "caffs": "America/Rainy_River",
+    "cafne": "America/Fort_Nelson",
+    "caglb": "America/Glace_Bay",
+    "cagoo": "America/Goose_Bay",
+    "cahal": "America/Halifax",
+    "caiql": "America/Iqaluit",
+    "camon": "America/Moncton",
+    "canpg": "America/Nipigon",
+    "capnt": "America/Pangnirtung",
+    "careb": "America/Resolute",
+    "careg": "America/Regina",
+    "casjf": "America/St_Johns",
+    "cathu": "America/Thunder_Bay",
+    "cator": "America/Toronto",
+    "cavan": "America/Vancouver",
+    "cawnp": "America/Winnipeg",
+    "caybx": "America/Blanc-Sablon",
+    "caycb": "America/Cambridge_Bay",
+    "cayda": "America/Dawson",
+    "caydq": "America/Dawson_Creek",
+    "cayek": "America/Rankin_Inlet",
+    "cayev": "America/Inuvik",
+    "cayxy": "America/Whitehorse",
+    "cayyn": "America/Swift_Current",
+    "cayzf": "America/Yellowknife",
+    "cayzs": "America/Atikokan",
+    "cccck": "Indian/Cocos",
+    "cdfbm": "Africa/Lubumbashi",
+    "cdfih": "Africa/Kinshasa",
+    "cfbgf": "Africa/Bangui",
+    "cgbzv": "Africa/Brazzaville",
+    "chzrh": "Europe/Zurich",
+    "ciabj": "Africa/Abidjan",
+    "ckrar": "Pacific/Rarotonga",
+    "clipc": "Pacific/Easter",
+    "clpuq": "America/Punta_Arenas",
+    "clscl": "America/Santiago",
+    "cmdla": "Africa/Douala",
+    "cnsha": "Asia/Shanghai",
+    "cnurc": "Asia/Urumqi",
+    "cobog": "America/Bogota",
+    "crsjo": "America/Costa_Rica",
+    "cst6cdt": "CST6CDT",
+    "cuhav": "America/Havana",
+    "cvrai": "Atlantic/Cape_Verde",
+    "cxxch": "Indian/Christmas",
+    "cyfmg": "Asia/Famagusta",
+    "cynic": "Asia/Nicosia",
+    "czprg": "Europe/Prague",
+    "deber": "Europe/Berlin",
+    "debsngn": "Europe/Busingen",
+    "djjib": "Africa/Djibouti",
+    "dkcph": "Europe/Copenhagen",
+    "dmdom": "America/Dominica",
+    "dosdq": "America/Santo_Domingo",
+    "dzalg": "Africa/Algiers",
+    "ecgps": "Pacific/Galapagos",
+    "ecgye": "America/Guayaquil",
+    "eetll": "Europe/Tallinn",
+    "egcai": "Africa/Cairo",
+    "eheai": "Africa/El_Aaiun",
+    "erasm": "Africa/Asmara",
+    "esceu": "Africa/Ceuta",
+    "eslpa": "Atlantic/Canary",
+    "esmad": "Europe/Madrid",
+    "est5edt": "EST5EDT",
+    "etadd": "Africa/Addis_Ababa",
+    "fihel": "Europe/Helsinki",
+    "fimhq": "Europe/Mariehamn",
+    "fjsuv": "Pacific/Fiji",
+    "fkpsy": "Atlantic/Stanley",
+    "fmksa": "Pacific/Kosrae",
+    "fmpni": "Pacific/Pohnpei",
+    "fmtkk": "Pacific/Chuuk",
+    "fotho": "Atlantic/Faroe",
+    "frpar": "Europe/Paris",
+    "galbv": "Africa/Libreville",
+    "gazastrp": "Asia/Gaza",
+    "gblon": "Europe/London",
+    "gdgnd": "America/Grenada",
+    "getbs": "Asia/Tbilisi",
+    "gfcay": "America/Cayenne",
+    "gggci": "Europe/Guernsey",
+    "ghacc": "Africa/Accra",
+    "gigib": "Europe/Gibraltar",
+    "gldkshvn": "America/Danmarkshavn",
+    "glgoh": "America/Nuuk",
+    "globy": "America/Scoresbysund",
+    "glthu": "America/Thule",
+    "gmbjl": "Africa/Banjul",
+    "gmt": "Etc/GMT",
+    "gncky": "Africa/Conakry",
+    "gpbbr": "America/Guadeloupe",
+    "gpmsb": "America/Marigot",
+    "gpsbh": "America/St_Barthelemy",
+    "gqssg": "Africa/Malabo",
+    "grath": "Europe/Athens",
+    "gsgrv": "Atlantic/South_Georgia",
+    "gtgua": "America/Guatemala",
+    "gugum": "Pacific/Guam",
+    "gwoxb": "Africa/Bissau",
+    "gygeo": "America/Guyana",
+    "hebron": "Asia/Hebron",
+    "hkhkg": "Asia/Hong_Kong",
+    "hntgu": "America/Tegucigalpa",
+    "hrzag": "Europe/Zagreb",
+    "htpap": "America/Port-au-Prince",
+    "hubud": "Europe/Budapest",
+    "iddjj": "Asia/Jayapura",
+    "idjkt": "Asia/Jakarta",
+    "idmak": "Asia/Makassar",
+    "idpnk": "Asia/Pontianak",
+    "iedub": "Europe/Dublin",
+    "imdgs": "Europe/Isle_of_Man",
+    "inccu": "Asia/Kolkata",
+    "iodga": "Indian/Chagos",
+    "iqbgw": "Asia/Baghdad",
+    "irthr": "Asia/Tehran",
+    "isrey": "Atlantic/Reykjavik",
+    "itrom": "Europe/Rome",
+    "jeruslm": "Asia/Jerusalem",
+    "jesth": "Europe/Jersey",
+    "jmkin": "America/Jamaica",
+    "joamm": "Asia/Amman",
+    "jptyo": "Asia/Tokyo",
+    "kenbo": "Africa/Nairobi",
+    "kgfru": "Asia/Bishkek",
+    "khpnh": "Asia/Phnom_Penh",
+    "kicxi": "Pacific/Kiritimati",
+    "kipho": "Pacific/Kanton",
+    "kitrw": "Pacific/Tarawa",
+    "kmyva": "Indian/Comoro",
+    "knbas": "America/St_Kitts",
+    "kpfnj": "Asia/Pyongyang",
+    "krsel": "Asia/Seoul",
+    "kwkwi": "Asia/Kuwait",
+    "kygec": "America/Cayman",
+    "kzaau": "Asia/Aqtau",
+    "kzakx": "Asia/Aqtobe",
+    "kzala": "Asia/Almaty",
+    "kzguw": "Asia/Atyrau",
+    "kzksn": "Asia/Qostanay",
+    "kzkzo": "Asia/Qyzylorda",
+    "kzura": "Asia/Oral",
+    "lavte": "Asia/Vientiane",
+    "lbbey": "Asia/Beirut",
+    "lccas": "America/St_Lucia",
+    "livdz": "Europe/Vaduz",
+    "lkcmb": "Asia/Colombo",
+    "lrmlw": "Africa/Monrovia",
+    "lsmsu": "Africa/Maseru",
+    "ltvno": "Europe/Vilnius",
+    "lulux": "Europe/Luxembourg",
+    "lvrix": "Europe/Riga",
+    "lytip": "Africa/Tripoli",
+    "macas": "Africa/Casablanca",
+    "mcmon": "Europe/Monaco",
+    "mdkiv": "Europe/Chisinau",
+    "metgd": "Europe/Podgorica",
+    "mgtnr": "Indian/Antananarivo",
+    "mhkwa": "Pacific/Kwajalein",
+    "mhmaj": "Pacific/Majuro",
+    "mkskp": "Europe/Skopje",
+    "mlbko": "Africa/Bamako",
+    "mmrgn": "Asia/Yangon",
+    "mncoq": "Asia/Choibalsan",
+    "mnhvd": "Asia/Hovd",
+    "mnuln": "Asia/Ulaanbaatar",
+    "momfm": "Asia/Macau",
+    "mpspn": "Pacific/Saipan",
+    "mqfdf": "America/Martinique",
+    "mrnkc": "Africa/Nouakchott",
+    "msmni": "America/Montserrat",
+    "mst7mdt": "MST7MDT",
+    "mtmla": "Europe/Malta",
+    "muplu": "Indian/Mauritius",
+    "mvmle": "Indian/Maldives",
+    "mwblz": "Africa/Blantyre",
+    "mxchi": "America/Chihuahua",
+    "mxcjs": "America/Ciudad_Juarez",
+    "mxcun": "America/Cancun",
+    "mxhmo": "America/Hermosillo",
+    "mxmam": "America/Matamoros",
+    "mxmex": "America/Mexico_City",
+    "mxmid": "America/Merida",
+    "mxmty": "America/Monterrey",
+    "mxmzt": "America/Mazatlan",
+    "mxoji": "America/Ojinaga",
+    "mxpvr": "America/Bahia_Banderas",
+    "mxstis": "America/Santa_Isabel",
+    "mxtij": "America/Tijuana",
+    "mykch": "Asia/Kuching",
+    "mykul": "Asia/Kuala_Lumpur",
+    "mzmpm": "Africa/Maputo",
+    "nawdh": "Africa/Windhoek",
+    "ncnou": "Pacific/Noumea",
+    "nenim": "Africa/Niamey",
+    "nfnlk": "Pacific/Norfolk",
+    "nglos": "Africa/Lagos",
+    "nimga": "America/Managua",
+    "nlams": "Europe/Amsterdam",
+    "noosl": "Europe/Oslo",
+    "npktm": "Asia/Kathmandu",
+    "nrinu": "Pacific/Nauru",
+    "nuiue": "Pacific/Niue",
+    "nzakl": "Pacific/Auckland",
+    "nzcht": "Pacific/Chatham",
+    "ommct": "Asia/Muscat",
+    "papty": "America/Panama",
+    "pelim": "America/Lima",
+    "pfgmr": "Pacific/Gambier",
+    "pfnhv": "Pacific/Marquesas",
+    "pfppt": "Pacific/Tahiti",
+    "pgpom": "Pacific/Port_Moresby",
+    "pgraw": "Pacific/Bougainville",
+    "phmnl": "Asia/Manila",
+    "pkkhi": "Asia/Karachi",
+    "plwaw": "Europe/Warsaw",
+    "pmmqc": "America/Miquelon",
+    "pnpcn": "Pacific/Pitcairn",
+    "prsju": "America/Puerto_Rico",
+    "pst8pdt": "PST8PDT",
+    "ptfnc": "Atlantic/Madeira",
+    "ptlis": "Europe/Lisbon",
+    "ptpdl": "Atlantic/Azores",
+    "pwror": "Pacific/Palau",
+    "pyasu": "America/Asuncion",
+    "qadoh": "Asia/Qatar",
+    "rereu": "Indian/Reunion",
+    "robuh": "Europe/Bucharest",
+    "rsbeg": "Europe/Belgrade",
+    "ruasf": "Europe/Astrakhan",
+    "rubax": "Asia/Barnaul",
+    "ruchita": "Asia/Chita",
+    "rudyr": "Asia/Anadyr",
+    "rugdx": "Asia/Magadan",
+    "ruikt": "Asia/Irkutsk",
+    "rukgd": "Europe/Kaliningrad",
+    "rukhndg": "Asia/Khandyga",
+    "rukra": "Asia/Krasnoyarsk",
+    "rukuf": "Europe/Samara",
+    "rukvx": "Europe/Kirov",
+    "rumow": "Europe/Moscow",
+    "runoz": "Asia/Novokuznetsk",
+    "ruoms": "Asia/Omsk",
+    "ruovb": "Asia/Novosibirsk",
+    "rupkc": "Asia/Kamchatka",
+    "rurtw": "Europe/Saratov",
+    "rusred": "Asia/Srednekolymsk",
+    "rutof": "Asia/Tomsk",
+    "ruuly": "Europe/Ulyanovsk",
+    "ruunera": "Asia/Ust-Nera",
+    "ruuus": "Asia/Sakhalin",
+    "ruvog": "Europe/Volgograd",
+    "ruvvo": "Asia/Vladivostok",
+    "ruyek": "Asia/Yekaterinburg",
+    "ruyks": "Asia/Yakutsk",
+    "rwkgl": "Africa/Kigali",
+    "saruh": "Asia/Riyadh",
+    "sbhir": "Pacific/Guadalcanal",
+    "scmaw": "Indian/Mahe",
+    "sdkrt": "Africa/Khartoum",
+    "sesto": "Europe/Stockholm",
+    "sgsin": "Asia/Singapore",
+    "shshn": "Atlantic/St_Helena",
+    "silju": "Europe/Ljubljana",
+    "sjlyr": "Arctic/Longyearbyen",
+    "skbts": "Europe/Bratislava",
+    "slfna": "Africa/Freetown",
+    "smsai": "Europe/San_Marino",
+    "sndkr": "Africa/Dakar",
+    "somgq": "Africa/Mogadishu",
+    "srpbm": "America/Paramaribo",
+    "ssjub": "Africa/Juba",
+    "sttms": "Africa/Sao_Tome",
+    "svsal": "America/El_Salvador",
+    "sxphi": "America/Lower_Princes",
+    "sydam": "Asia/Damascus",
+    "szqmn": "Africa/Mbabane",
+    "tcgdt": "America/Grand_Turk",
+    "tdndj": "Africa/Ndjamena",
+    "tfpfr": "Indian/Kerguelen",
+    "tglfw": "Africa/Lome",
+    "thbkk": "Asia/Bangkok",
+    "tjdyu": "Asia/Dushanbe",
+    "tkfko": "Pacific/Fakaofo",
+    "tldil": "Asia/Dili",
+    "tmasb": "Asia/Ashgabat",
+    "tntun": "Africa/Tunis",
+    "totbu": "Pacific/Tongatapu",
+    "trist": "Europe/Istanbul",
+    "ttpos": "America/Port_of_Spain",
+    "tvfun": "Pacific/Funafuti",
+    "twtpe": "Asia/Taipei",
+    "tzdar": "Africa/Dar_es_Salaam",
+    "uaiev": "Europe/Kyiv",
+    "uaozh": "Europe/Zaporozhye",
+    "uasip": "Europe/Simferopol",
+    "uauzh": "Europe/Uzhgorod",
+    "ugkla": "Africa/Kampala",
+    "umawk": "Pacific/Wake",
+    "umjon": "Pacific/Johnston",
+    "ummdy": "Pacific/Midway",
+    "unk": "Etc/Unknown",
+    "usadk": "America/Adak",
+    "usaeg": "America/Indiana/Marengo",
+    "usanc": "America/Anchorage",
+    "usboi": "America/Boise",
+    "uschi": "America/Chicago",
+    "usden": "America/Denver",
+    "usdet": "America/Detroit",
+    "ushnl": "Pacific/Honolulu",
+    "usind": "America/Indiana/Indianapolis",
+    "usinvev": "America/Indiana/Vevay",
+    "usjnu": "America/Juneau",
+    "usknx": "America/Indiana/Knox",
+    "uslax": "America/Los_Angeles",
+    "uslui": "America/Kentucky/Louisville",
+    "usmnm": "America/Menominee",
+    "usmoc": "America/Kentucky/Monticello",
+    "usmtm": "America/Metlakatla",
+    "usndcnt": "America/North_Dakota/Center",
+    "usndnsl": "America/North_Dakota/New_Salem",
+    "usnyc": "America/New_York",
+    "usoea": "America/Indiana/Vincennes",
+    "usome": "America/Nome",
+    "usphx": "America/Phoenix",
+    "ussit": "America/Sitka",
+    "ustel": "America/Indiana/Tell_City",
+    "uswlz": "America/Indiana/Winamac",
+    "uswsq": "America/Indiana/Petersburg",
+    "usxul": "America/North_Dakota/Beulah",
+    "usyak": "America/Yakutat",
+    "utc": "Etc/UTC",
+    "utce01": "Etc/GMT-1",
+    "utce02": "Etc/GMT-2",
+    "utce03": "Etc/GMT-3",
+    "utce04": "Etc/GMT-4",
+    "utce05": "Etc/GMT-5",
+    "utce06": "Etc/GMT-6",
+    "utce07": "Etc/GMT-7",
+    "utce08": "Etc/GMT-8",
+    "utce09": "Etc/GMT-9",
+    "utce10": "Etc/GMT-10",
+    "utce11": "Etc/GMT-11",
+    "utce12": "Etc/GMT-12",
+    "utce13": "Etc/GMT-13",
+    "utce14": "Etc/GMT-14",
+    "utcw01": "Etc/GMT+1",
+    "utcw02": "Etc/GMT+2",
+    "utcw03": "Etc/GMT+3",
+    "utcw04": "Etc/GMT+4",
+    "utcw05": "Etc/GMT+5",
+    "utcw06": "Etc/GMT+6",
+    "utcw07": "Etc/GMT+7",
+    "utcw08": "Etc/GMT+8",
+    "utcw09": "Etc/GMT+9",
+    "utcw10": "Etc/GMT+10",
+    "utcw11": "Etc/GMT+11",
+    "utcw12": "Etc/GMT+12",
+    "uymvd": "America/Montevideo",
+    "uzskd": "Asia/Samarkand",
+    "uztas": "Asia/Tashkent",
+    "vavat": "Europe/Vatican",
+    "vcsvd": "America/St_Vincent",
+    "veccs": "America/Caracas",
+    "vgtov": "America/Tortola",
+    "vistt": "America/St_Thomas",
+    "vnsgn": "Asia/Ho_Chi_Minh",
+    "vuvli": "Pacific/Efate",
+    "wfmau": "Pacific/Wallis",
+    "wsapw": "Pacific/Apia",
+    "yeade": "Asia/Aden",
+    "ytmam": "Indian/Mayotte",
+    "zajnb": "Africa/Johannesburg",
+    "zmlun": "Africa/Lusaka",
+    "zwhre": "Africa/Harare",
+}
+
+var japaneseEras = []string{
+    "1868-09-08",
+    "1912-07-30",
+    "1926-12-25",
+    "1989-01-08",
+    "2019-05-01",
 }
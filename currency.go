@@ -1,16 +1,22 @@
 package locale
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math"
+	"math/big"
 	"regexp"
+	"slices"
 	"strings"
 	"unicode"
 	"unicode/utf8"
 
 	"golang.org/x/text/currency"
+	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 
 	"github.com/tdewolff/parse/v2/strconv"
@@ -77,6 +83,206 @@ func ParseAmountLocale(tag language.Tag, unit currency.Unit, s string) (Amount,
 	return NewAmount(unit, amount, dec)
 }
 
+var ErrUnknownCurrency = fmt.Errorf("unknown currency")
+var ErrAmbiguousCurrency = fmt.Errorf("ambiguous currency")
+
+func MustParseAmountFormat(tag language.Tag, s string, units ...currency.Unit) Amount {
+	a, err := ParseAmountFormat(tag, s, units...)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// ParseAmountFormat parses an amount formatted for the given language, such as by AmountFormatter, and is its inverse. It accepts currency symbols before or after the number, ISO codes, standard and narrow symbols, minus signs before or after the symbol, and accounting parentheses such as "(€5.00)". The currency is determined by the symbol if it is unambiguous for the language, or standard symbols are preferred over narrow symbols. Optionally, units restricts the currencies to choose from, which is required when the amount has no symbol.
+func ParseAmountFormat(tag language.Tag, s string, units ...currency.Unit) (Amount, error) {
+	locale := GetLocale(tag)
+
+	// currencies with their own decimal and group symbols, such as the Cape Verdean escudo, are tried first
+	for _, code := range customSymbolCurrencies(tag, locale, units) {
+		decSym, groupSym := currencySymbols(locale, getCurrencyNames(tag, locale, code))
+		if a, err := parseAmountFormat(tag, locale, s, decSym, groupSym, units); err == nil && a.Unit.String() == code {
+			return a, nil
+		}
+	}
+	return parseAmountFormat(tag, locale, s, locale.CurrencyDecimalSymbol, locale.CurrencyGroupSymbol, units)
+}
+
+// customSymbolCurrencies returns the currencies that have their own decimal or group symbols for the language.
+func customSymbolCurrencies(tag language.Tag, locale Locale, units []currency.Unit) []string {
+	codes := []string{}
+	if len(units) != 0 {
+		for _, unit := range units {
+			codes = append(codes, unit.String())
+		}
+	} else {
+		for code := range locale.Currency {
+			codes = append(codes, code)
+		}
+		registeredCurrenciesMu.RLock()
+		for code := range registeredCurrencies {
+			codes = append(codes, code)
+		}
+		registeredCurrenciesMu.RUnlock()
+	}
+
+	custom := []string{}
+	for _, code := range codes {
+		if names := getCurrencyNames(tag, locale, code); (names.Decimal != 0 || names.Group != 0) && !slices.Contains(custom, code) {
+			custom = append(custom, code)
+		}
+	}
+	slices.Sort(custom)
+	return custom
+}
+
+// parseAmountFormat parses an amount using the given decimal and group symbols, and requires the currency to use the same symbols.
+func parseAmountFormat(tag language.Tag, locale Locale, s string, decSym, groupSym rune, units []currency.Unit) (Amount, error) {
+	isSign := func(r rune) bool {
+		return r == '-' || r == '+' || r == '\u2212' || r == locale.MinusSymbol || r == locale.PlusSymbol
+	}
+	isNumber := func(r rune) bool {
+		return '0' <= r && r <= '9' || r == groupSym || r == decSym
+	}
+
+	// find number, prefix and suffix
+	start, end := strings.IndexFunc(s, func(r rune) bool { return '0' <= r && r <= '9' }), -1
+	if start == -1 {
+		return Amount{}, fmt.Errorf("invalid amount: %v", s)
+	}
+	for i, r := range s[start:] {
+		if !isNumber(r) {
+			break
+		}
+		end = start + i + utf8.RuneLen(r)
+	}
+	for 0 < start {
+		// leading decimal symbol
+		if r, n := utf8.DecodeLastRuneInString(s[:start]); r == decSym {
+			start -= n
+		} else {
+			break
+		}
+	}
+	number, prefix, suffix := s[start:end], s[:start], s[end:]
+	for 0 < len(number) {
+		// trailing group symbol, such as a non-breaking space
+		if r, n := utf8.DecodeLastRuneInString(number); r == groupSym {
+			number = number[:len(number)-n]
+			suffix = s[start+len(number):]
+		} else {
+			break
+		}
+	}
+
+	// remove signs, parentheses and spaces
+	neg, signs := false, 0
+	symbols := [2]strings.Builder{}
+	for i, affix := range []string{prefix, suffix} {
+		for _, r := range affix {
+			if isSign(r) {
+				neg = r != '+' && r != locale.PlusSymbol
+				signs++
+			} else if i == 0 && r == '(' || i == 1 && r == ')' {
+				neg = true
+				signs++
+			} else if !unicode.IsSpace(r) {
+				symbols[i].WriteRune(r)
+			}
+		}
+	}
+	if 1 < signs && (signs != 2 || !strings.ContainsRune(prefix, '(') || !strings.ContainsRune(suffix, ')')) {
+		return Amount{}, fmt.Errorf("invalid amount: %v", s)
+	} else if signs == 1 && (strings.ContainsRune(prefix, '(') || strings.ContainsRune(suffix, ')')) {
+		return Amount{}, fmt.Errorf("invalid amount: unbalanced parentheses: %v", s)
+	} else if symbols[0].Len() != 0 && symbols[1].Len() != 0 {
+		return Amount{}, fmt.Errorf("invalid amount: %v", s)
+	}
+
+	// determine currency
+	var unit currency.Unit
+	// the currency set by the -u-cu- extension is used for amounts without symbol or with an ambiguous symbol
+	preferred, err := currency.ParseISO(locale.PreferredCurrency)
+	hasPreferred := err == nil && (len(units) == 0 || slices.Contains(units, preferred))
+	if symbol := symbols[0].String() + symbols[1].String(); symbol == "" {
+		if len(units) == 1 {
+			unit = units[0]
+		} else if hasPreferred {
+			unit = preferred
+		} else {
+			return Amount{}, fmt.Errorf("%w: %v", ErrUnknownCurrency, s)
+		}
+	} else {
+		var err error
+		if unit, err = parseCurrencySymbol(tag, locale, symbol, units); err == ErrUnknownCurrency {
+			// fall back to the international symbols, such as US$
+			unit, err = parseCurrencySymbol(language.Und, locales["root"], symbol, units)
+		} else if err == ErrAmbiguousCurrency && hasPreferred {
+			unit, err = parseCurrencySymbol(tag, locale, symbol, []currency.Unit{preferred})
+		}
+		if err != nil {
+			return Amount{}, fmt.Errorf("%w: %v", err, symbol)
+		}
+	}
+
+	if unitDecSym, unitGroupSym := currencySymbols(locale, getCurrencyNames(tag, locale, unit.String())); unitDecSym != decSym || unitGroupSym != groupSym {
+		return Amount{}, fmt.Errorf("invalid amount: %v", s)
+	}
+	amount, dec, n := strconv.ParseNumber([]byte(number), groupSym, decSym)
+	if n != len(number) {
+		return Amount{}, fmt.Errorf("invalid amount: %v", s)
+	} else if neg {
+		amount = -amount
+	}
+	return NewAmount(unit, amount, dec)
+}
+
+// parseCurrencySymbol returns the currency for an ISO code or symbol, preferring standard symbols over narrow symbols.
+func parseCurrencySymbol(tag language.Tag, locale Locale, symbol string, units []currency.Unit) (currency.Unit, error) {
+	allowed := func(unit currency.Unit) bool {
+		if len(units) == 0 {
+			return true
+		}
+		for _, u := range units {
+			if u == unit {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(symbol) == 3 && strings.ToUpper(symbol) == symbol {
+		if unit, err := currency.ParseISO(symbol); err == nil && allowed(unit) {
+			return unit, nil
+		}
+	}
+
+	var standard, narrow []currency.Unit
+	for _, iso := range getCurrencyISOs(locale) {
+		cur := getCurrencyNames(tag, locale, iso)
+		if cur.Standard != symbol && cur.Narrow != symbol {
+			continue
+		}
+		unit, err := currency.ParseISO(iso)
+		if err != nil || !allowed(unit) {
+			continue
+		}
+		if cur.Standard == symbol {
+			standard = append(standard, unit)
+		} else {
+			narrow = append(narrow, unit)
+		}
+	}
+	if len(standard) == 1 {
+		return standard[0], nil
+	} else if len(standard) == 0 && len(narrow) == 1 {
+		return narrow[0], nil
+	} else if len(standard) == 0 && len(narrow) == 0 {
+		return currency.Unit{}, ErrUnknownCurrency
+	}
+	return currency.Unit{}, ErrAmbiguousCurrency
+}
+
 func MustNewZeroAmount(unit currency.Unit) Amount {
 	a, err := NewZeroAmount(unit)
 	if err != nil {
@@ -88,7 +294,10 @@ func MustNewZeroAmount(unit currency.Unit) Amount {
 func NewZeroAmount(unit currency.Unit) (Amount, error) {
 	cur := GetCurrency(unit)
 	if cur.Rounding != 0 && cur.Rounding != 1 && cur.Rounding != 10 && cur.Rounding != 100 {
-		panic(fmt.Sprintf("unsupported currency rounding: %v", cur.Rounding))
+		return Amount{}, fmt.Errorf("unsupported currency rounding: %v", cur.Rounding)
+	}
+	if len(int64Scales) <= cur.Digits+AmountPrecision {
+		return Amount{}, fmt.Errorf("unsupported currency digits for Amount, use BigAmount: %v", cur.Digits)
 	}
 	return Amount{unit, 0, cur.Digits, cur.Rounding}, nil
 }
@@ -106,6 +315,9 @@ func NewAmount(unit currency.Unit, amount int64, dec int) (Amount, error) {
 	if cur.Rounding != 0 && cur.Rounding != 1 && cur.Rounding != 10 && cur.Rounding != 100 {
 		return Amount{}, fmt.Errorf("unsupported currency rounding: %v", cur.Rounding)
 	}
+	if len(int64Scales) <= cur.Digits+AmountPrecision {
+		return Amount{}, fmt.Errorf("unsupported currency digits for Amount, use BigAmount: %v", cur.Digits)
+	}
 	prec := cur.Digits + AmountPrecision
 	if dec < prec {
 		scale := int64Scales[prec-dec]
@@ -135,6 +347,9 @@ func NewAmountFromFloat64(unit currency.Unit, amount float64) (Amount, error) {
 	if cur.Rounding != 0 && cur.Rounding != 1 && cur.Rounding != 10 && cur.Rounding != 100 {
 		return Amount{}, fmt.Errorf("unsupported currency rounding: %v", cur.Rounding)
 	}
+	if len(int64Scales) <= cur.Digits+AmountPrecision {
+		return Amount{}, fmt.Errorf("unsupported currency digits for Amount, use BigAmount: %v", cur.Digits)
+	}
 	prec := cur.Digits + AmountPrecision
 	amount = math.RoundToEven(amount * math.Pow10(prec))
 	if float64(MaxAmount) < amount {
@@ -214,6 +429,8 @@ func (a Amount) Compare(b Amount) int {
 func bankersRounding(amount int64, prec int) int64 {
 	if prec <= 0 {
 		return amount
+	} else if amount < 0 {
+		return -bankersRounding(-amount, prec)
 	}
 	shift := int64(0)
 	scale := int64Scales[prec]
@@ -228,6 +445,78 @@ func bankersRounding(amount int64, prec int) int64 {
 	return amount
 }
 
+// bankersRoundingRat performs bankers rounding of a rational number to an integer.
+func bankersRoundingRat(r *big.Rat) *big.Int {
+	return roundRat(r, RoundHalfEven)
+}
+
+// RoundingMode specifies how to round to an increment.
+type RoundingMode int
+
+// Available rounding modes.
+const (
+	RoundHalfEven RoundingMode = iota // round to nearest, ties to even (banker's rounding)
+	RoundHalfUp                       // round to nearest, ties away from zero
+	RoundHalfDown                     // round to nearest, ties towards zero
+	RoundUp                           // round away from zero
+	RoundDown                         // round towards zero (truncate)
+	RoundCeiling                      // round towards positive infinity
+	RoundFloor                        // round towards negative infinity
+)
+
+func (mode RoundingMode) String() string {
+	switch mode {
+	case RoundHalfEven:
+		return "HalfEven"
+	case RoundHalfUp:
+		return "HalfUp"
+	case RoundHalfDown:
+		return "HalfDown"
+	case RoundUp:
+		return "Up"
+	case RoundDown:
+		return "Down"
+	case RoundCeiling:
+		return "Ceiling"
+	case RoundFloor:
+		return "Floor"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(mode))
+}
+
+// roundRat rounds a rational number to an integer using the given rounding mode.
+func roundRat(r *big.Rat, mode RoundingMode) *big.Int {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if m.Sign() == 0 {
+		return q
+	}
+
+	away := false
+	cmp := m.Abs(m).Lsh(m, 1).Cmp(r.Denom())
+	switch mode {
+	case RoundHalfEven:
+		away = 0 < cmp || cmp == 0 && q.Bit(0) == 1
+	case RoundHalfUp:
+		away = 0 <= cmp
+	case RoundHalfDown:
+		away = 0 < cmp
+	case RoundUp:
+		away = true
+	case RoundCeiling:
+		away = 0 < r.Sign()
+	case RoundFloor:
+		away = r.Sign() < 0
+	}
+	if away {
+		if r.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
 // round performs banker's rounding to the given increments
 func (a Amount) round(incr int) (Amount, error) {
 	prec := AmountPrecision
@@ -333,15 +622,113 @@ func (a Amount) Mul(f int) (Amount, error) {
 }
 
 func (a Amount) Div(f int) Amount {
-	if a.amount < MaxAmount/10 {
-		a.amount = bankersRounding(a.amount*10/int64(f), 1) / 10
-	} else {
-		// TODO: to proper rounding
-		a.amount /= int64(f)
-	}
+	a.amount = bankersRoundingRat(big.NewRat(a.amount, int64(f))).Int64()
 	return a
 }
 
+// Split splits the amount into n parts that sum exactly to the amount rounded to the currency's increments. The remainder is distributed over the first parts, ie. splitting €100.00 three ways results in €33.34, €33.33, and €33.33.
+func (a Amount) Split(n int) ([]Amount, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid number of parts: %v", n)
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return a.Allocate(ratios...)
+}
+
+// SplitCash is like Split but uses the currency's cash increments, ie. CHF 10.00 split three ways results in CHF 3.35, CHF 3.35, and CHF 3.30.
+func (a Amount) SplitCash(n int) ([]Amount, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid number of parts: %v", n)
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return a.AllocateCash(ratios...)
+}
+
+// Allocate allocates the amount over parts proportional to the given ratios, such that the parts sum exactly to the amount rounded to the currency's increments. The remainder is distributed over the parts with the largest fractional remainders, where ties go to the first part.
+func (a Amount) Allocate(ratios ...int) ([]Amount, error) {
+	incr := int64(a.rounding)
+	if incr == 0 {
+		incr = 1
+	}
+	return a.allocate(incr*int64Scales[AmountPrecision], ratios)
+}
+
+// AllocateCash is like Allocate but uses the currency's cash increments.
+func (a Amount) AllocateCash(ratios ...int) ([]Amount, error) {
+	cur := GetCurrency(a.Unit)
+	if a.digits < cur.CashDigits {
+		return nil, fmt.Errorf("unsupported currency cash digits: %v", cur.CashDigits)
+	}
+	incr := int64(cur.CashRounding)
+	if incr == 0 {
+		incr = 1
+	}
+	return a.allocate(incr*int64Scales[a.digits-cur.CashDigits+AmountPrecision], ratios)
+}
+
+func (a Amount) allocate(incr int64, ratios []int) ([]Amount, error) {
+	if len(ratios) == 0 {
+		return nil, fmt.Errorf("no ratios given")
+	}
+	total := int64(0)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("invalid negative ratio: %v", ratio)
+		}
+		total += int64(ratio)
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("ratios sum to zero")
+	}
+
+	// round the amount to a whole number of increments
+	amount := a.amount
+	if amount < 0 {
+		amount = -amount
+	}
+	units, rem := amount/incr, amount%incr
+	if incr < 2*rem || 2*rem == incr && units%2 == 1 {
+		units++
+	}
+
+	// distribute the increments using the largest remainder method
+	parts := make([]int64, len(ratios))
+	remainders := make([]int64, len(ratios))
+	left := units
+	bigUnits, bigTotal := big.NewInt(units), big.NewInt(total)
+	for i, ratio := range ratios {
+		q, r := new(big.Int).QuoRem(new(big.Int).Mul(bigUnits, big.NewInt(int64(ratio))), bigTotal, new(big.Int))
+		parts[i], remainders[i] = q.Int64(), r.Int64()
+		left -= parts[i]
+	}
+	for ; 0 < left; left-- {
+		j := 0
+		for i := range remainders {
+			if remainders[j] < remainders[i] {
+				j = i
+			}
+		}
+		parts[j]++
+		remainders[j] = -1
+	}
+
+	amounts := make([]Amount, len(ratios))
+	for i := range parts {
+		amounts[i] = a
+		amounts[i].amount = parts[i] * incr
+		if a.amount < 0 {
+			amounts[i].amount = -amounts[i].amount
+		}
+	}
+	return amounts, nil
+}
+
 func (a Amount) DivAmount(b Amount) float64 {
 	A, B, ok := normaliseAmounts(a, b)
 	if !ok {
@@ -358,19 +745,162 @@ func (a Amount) MustMulf(f float64) Amount {
 	return c
 }
 
+// Mulf multiplies the amount by f. The calculation is exact for the binary value of f, but since most decimal fractions cannot be represented exactly by a float64 it is better to use MulRat or MulBasisPoints.
 func (a Amount) Mulf(f float64) (Amount, error) {
-	// TODO: is this right?
-	incr := 1.0 < f && 0 < a.amount || f < -1.0 && a.amount < 0
-	decr := f < -1.0 && 0 < a.amount || 1.0 < f && a.amount < 0
-	if incr && float64(MaxAmount) < f*float64(a.amount) {
+	r := new(big.Rat)
+	if r.SetFloat64(f) == nil {
+		return Amount{}, fmt.Errorf("invalid factor: %v", f)
+	}
+	return a.MulRat(r)
+}
+
+// setBig sets the amount, which is multiplied by 10^(digits + AmountPrecision), and returns an error if it overflows.
+func (a Amount) setBig(amount *big.Int) (Amount, error) {
+	if !amount.IsInt64() || amount.Int64() == -MaxAmount-1 {
+		if amount.Sign() < 0 {
+			return Amount{}, ErrUnderflow
+		}
 		return Amount{}, ErrOverflow
-	} else if decr && f*float64(a.amount) < float64(-MaxAmount) {
-		return Amount{}, ErrUnderflow
 	}
-	a.amount = int64(math.RoundToEven(float64(a.amount) * f))
+	a.amount = amount.Int64()
 	return a, nil
 }
 
+// increment returns the currency's rounding increment in units of the amount.
+func (a Amount) increment() int64 {
+	incr := int64(a.rounding)
+	if incr == 0 {
+		incr = 1
+	}
+	return incr * int64Scales[AmountPrecision]
+}
+
+func (a Amount) MustMulRat(r *big.Rat) Amount {
+	c, err := a.MulRat(r)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// MulRat multiplies the amount by r exactly, and uses banker's rounding only for the extra precision of the amount (see AmountPrecision).
+func (a Amount) MulRat(r *big.Rat) (Amount, error) {
+	v := new(big.Rat).SetInt64(a.amount)
+	return a.setBig(bankersRoundingRat(v.Mul(v, r)))
+}
+
+func (a Amount) MustRoundMode(mode RoundingMode) Amount {
+	c, err := a.RoundMode(mode)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RoundMode rounds the amount to the currency's increments using the given rounding mode.
+func (a Amount) RoundMode(mode RoundingMode) (Amount, error) {
+	incr := a.increment()
+	q := roundRat(big.NewRat(a.amount, incr), mode)
+	return a.setBig(q.Mul(q, big.NewInt(incr)))
+}
+
+func (a Amount) MustQuo(d int, mode RoundingMode) Amount {
+	c, err := a.Quo(d, mode)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// Quo divides the amount by d and rounds the result to the currency's increments using the given rounding mode.
+func (a Amount) Quo(d int, mode RoundingMode) (Amount, error) {
+	if d == 0 {
+		return Amount{}, fmt.Errorf("division by zero")
+	}
+	incr := a.increment()
+	q := roundRat(new(big.Rat).SetFrac(big.NewInt(a.amount), new(big.Int).Mul(big.NewInt(int64(d)), big.NewInt(incr))), mode)
+	return a.setBig(q.Mul(q, big.NewInt(incr)))
+}
+
+// DivMod divides the amount by d and returns the quotient truncated to the currency's increments and the remainder, such that q*d + r equals the amount exactly. The remainder has the same sign as the amount.
+func (a Amount) DivMod(d int) (Amount, Amount, error) {
+	if d == 0 {
+		return Amount{}, Amount{}, fmt.Errorf("division by zero")
+	}
+	incr := big.NewInt(a.increment())
+	v := new(big.Int).Quo(big.NewInt(a.amount), new(big.Int).Mul(big.NewInt(int64(d)), incr))
+	q, err := a.setBig(v.Mul(v, incr))
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	r, err := a.setBig(v.Sub(big.NewInt(a.amount), v.Mul(v, big.NewInt(int64(d)))))
+	if err != nil {
+		return Amount{}, Amount{}, err
+	}
+	return q, r, nil
+}
+
+// BasisPoints is a ratio in hundredths of a percent, such as 2100 for 21%, that allows percentages to be calculated exactly.
+type BasisPoints int64
+
+// Percent is one percent in basis points, ie. 21*Percent is 21%.
+const Percent BasisPoints = 100
+
+// Rat returns the basis points as a ratio, ie. 2100 returns 21/100.
+func (bp BasisPoints) Rat() *big.Rat {
+	return big.NewRat(int64(bp), 10000)
+}
+
+func (bp BasisPoints) String() string {
+	b := strconv.AppendNumber(nil, int64(bp), 2, 0, 0, '.')
+	for b[len(b)-1] == '0' {
+		b = b[:len(b)-1]
+	}
+	if b[len(b)-1] == '.' {
+		b = b[:len(b)-1]
+	}
+	return string(b) + "%"
+}
+
+func (a Amount) MustMulBasisPoints(bp BasisPoints) Amount {
+	c, err := a.MulBasisPoints(bp)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// MulBasisPoints returns the percentage of the amount exactly, such as the tax amount. Round the result to obtain a monetary amount.
+func (a Amount) MulBasisPoints(bp BasisPoints) (Amount, error) {
+	return a.MulRat(bp.Rat())
+}
+
+func (a Amount) MustAddBasisPoints(bp BasisPoints) Amount {
+	c, err := a.AddBasisPoints(bp)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// AddBasisPoints increases the amount by the percentage exactly, such as adding tax or interest.
+func (a Amount) AddBasisPoints(bp BasisPoints) (Amount, error) {
+	return a.MulRat(big.NewRat(10000+int64(bp), 10000))
+}
+
+func (a Amount) MustSubBasisPoints(bp BasisPoints) Amount {
+	c, err := a.SubBasisPoints(bp)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// SubBasisPoints decreases the amount by the percentage exactly, such as subtracting a discount.
+func (a Amount) SubBasisPoints(bp BasisPoints) (Amount, error) {
+	return a.MulRat(big.NewRat(10000-int64(bp), 10000))
+}
+
 func (a Amount) Float64() float64 {
 	return float64(a.amount) / math.Pow10(a.digits+AmountPrecision)
 }
@@ -488,34 +1018,206 @@ func (n NullAmount) String() string {
 	return n.Amount.String()
 }
 
-func AmountRegex(tag language.Tag, unit currency.Unit) string {
-	cur := GetCurrency(unit)
-	locale := GetLocale(tag)
+// parseAmountText parses the canonical form of an amount as written by StringAmount, ie. an optional minus sign followed by digits and optionally a decimal point and more digits. The amount must be representable exactly.
+func parseAmountText(unit currency.Unit, b []byte) (Amount, error) {
+	i := 0
+	if 0 < len(b) && b[0] == '-' {
+		i++
+	}
+	start, dec := i, -1
+	for ; i < len(b); i++ {
+		if b[i] == '.' && dec == -1 && start < i {
+			dec = i
+		} else if b[i] < '0' || '9' < b[i] {
+			break
+		}
+	}
+	if i != len(b) || i == start || dec == len(b)-1 {
+		return Amount{}, fmt.Errorf("invalid amount: %v", string(b))
+	}
 
-	var decimals string
-	if 0 < cur.Digits {
-		decimals = fmt.Sprintf("(?:%s", regexp.QuoteMeta(string(locale.DecimalSymbol)))
-		switch cur.Rounding {
-		case 0, 1:
-			decimals += fmt.Sprintf("[0-9]{,%d}", cur.Digits)
-		case 10:
-			if 1 < cur.Digits {
-				decimals += fmt.Sprintf("(?:[0-9]{,%d}|[0-9]{%d}0)", cur.Digits-1, cur.Digits-1)
-			} else {
-				decimals += "0"
-			}
-		case 100:
-			if 2 < cur.Digits {
-				decimals += fmt.Sprintf("(?:[0-9]{,%d}|[0-9]{%d}00)", cur.Digits-2, cur.Digits-2)
-			} else {
-				decimals += "00"
-			}
-		default:
-			panic(fmt.Sprintf("unexpected increment: %v", cur.Rounding))
+	amount, dec, n := strconv.ParseNumber(b, 0, '.')
+	if n != len(b) {
+		return Amount{}, fmt.Errorf("invalid amount: %v", string(b))
+	} else if cur := GetCurrency(unit); cur.Digits+AmountPrecision < dec {
+		return Amount{}, fmt.Errorf("invalid amount: too many decimals: %v", string(b))
+	}
+	return NewAmount(unit, amount, dec)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The canonical form is the ISO 4217 currency code followed by a space and the amount without grouping and with a period as the decimal symbol, such as "EUR 12.34". ZeroAmount has the canonical form "0".
+func (a Amount) MarshalText() ([]byte, error) {
+	if a == ZeroAmount {
+		return []byte("0"), nil
+	}
+	return []byte(a.Unit.String() + " " + a.StringAmount()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface and accepts only the canonical form of MarshalText.
+func (a *Amount) UnmarshalText(b []byte) error {
+	if string(b) == "0" {
+		*a = ZeroAmount
+		return nil
+	} else if len(b) < 5 || b[3] != ' ' {
+		return fmt.Errorf("invalid amount: %v", string(b))
+	}
+	unit, err := currency.ParseISO(string(b[:3]))
+	if err != nil {
+		return fmt.Errorf("%v: %v", err, string(b))
+	} else if unit.String() != string(b[:3]) {
+		return fmt.Errorf("invalid currency: %v", string(b[:3]))
+	}
+	amount, err := parseAmountText(unit, b[4:])
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+type jsonAmount struct {
+	Currency *string `json:"currency"`
+	Amount   *string `json:"amount"`
+}
+
+// MarshalJSON implements the json.Marshaler interface. The canonical form is an object with the ISO 4217 currency code and the amount as a string, such as {"currency":"EUR","amount":"12.34"}. ZeroAmount has the canonical form {"currency":"","amount":"0"}.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	unit, amount := "", "0"
+	if a != ZeroAmount {
+		unit, amount = a.Unit.String(), a.StringAmount()
+	}
+	return json.Marshal(jsonAmount{&unit, &amount})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface and accepts only the canonical form of MarshalJSON.
+func (a *Amount) UnmarshalJSON(b []byte) error {
+	var v jsonAmount
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Errorf("invalid amount: %v", err)
+	} else if dec.More() {
+		return fmt.Errorf("invalid amount: %v", string(b))
+	} else if v.Currency == nil || v.Amount == nil {
+		return fmt.Errorf("invalid amount: currency and amount are required")
+	} else if *v.Currency == "" {
+		if *v.Amount != "0" {
+			return fmt.Errorf("invalid amount: currency is required")
 		}
-		decimals += ")?"
+		*a = ZeroAmount
+		return nil
+	}
+	return a.UnmarshalText([]byte(*v.Currency + " " + *v.Amount))
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The binary form is the ISO 4217 currency code followed by the amount as a varint and its number of decimals as an uvarint, so that it doesn't depend on the currency's digits or AmountPrecision. ZeroAmount has an empty binary form.
+func (a Amount) MarshalBinary() ([]byte, error) {
+	if a == ZeroAmount {
+		return []byte{}, nil
 	}
-	return fmt.Sprintf("^(?:[0-9]+%s)*[0-9]+%s$", regexp.QuoteMeta(string(locale.GroupSymbol)), decimals)
+	b := []byte(a.Unit.String())
+	b = binary.AppendVarint(b, a.amount)
+	return binary.AppendUvarint(b, uint64(a.digits+AmountPrecision)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface. The amount is rescaled to the currency's digits.
+func (a *Amount) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 {
+		*a = ZeroAmount
+		return nil
+	} else if len(b) < 5 {
+		return fmt.Errorf("invalid amount: unexpected length")
+	}
+	for _, c := range b[:3] {
+		if c < 'A' || 'Z' < c {
+			return fmt.Errorf("invalid amount: bad currency code")
+		}
+	}
+	unit, err := currency.ParseISO(string(b[:3]))
+	if err != nil {
+		return fmt.Errorf("invalid amount: %v", err)
+	}
+	amount, n := binary.Varint(b[3:])
+	if n <= 0 {
+		return fmt.Errorf("invalid amount: bad varint")
+	}
+	dec, m := binary.Uvarint(b[3+n:])
+	if m <= 0 || 3+n+m != len(b) || uint64(len(int64Scales)) <= dec {
+		return fmt.Errorf("invalid amount: bad decimals")
+	}
+	v, err := NewAmount(unit, amount, int(dec))
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, an invalid amount has an empty form.
+func (n NullAmount) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Amount.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (n *NullAmount) UnmarshalText(b []byte) error {
+	if len(b) == 0 {
+		n.Amount, n.Valid = Amount{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Amount.UnmarshalText(b)
+}
+
+// MarshalJSON implements the json.Marshaler interface, an invalid amount is null.
+func (n NullAmount) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Amount.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullAmount) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		n.Amount, n.Valid = Amount{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Amount.UnmarshalJSON(b)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface. The binary form starts with a byte that is 1 if the amount is valid and 0 otherwise, followed by the binary form of the amount.
+func (n NullAmount) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{0}, nil
+	}
+	b, err := n.Amount.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{1}, b...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (n *NullAmount) UnmarshalBinary(b []byte) error {
+	if len(b) == 0 || 1 < b[0] || b[0] == 0 && len(b) != 1 {
+		return fmt.Errorf("invalid amount: bad validity byte")
+	} else if b[0] == 0 {
+		n.Amount, n.Valid = Amount{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Amount.UnmarshalBinary(b[1:])
+}
+
+func AmountRegex(tag language.Tag, unit currency.Unit) string {
+	locale := GetLocale(tag)
+	decSym, groupSym := currencySymbols(locale, getCurrencyNames(tag, locale, unit.String()))
+	decimals := amountDecimalsPattern(GetCurrency(unit), decSym)
+	return fmt.Sprintf("^(?:[0-9]+%s)*[0-9]+%s$", regexp.QuoteMeta(string(groupSym)), decimals)
 }
 
 type CurrencyFormatter struct {
@@ -524,24 +1226,37 @@ type CurrencyFormatter struct {
 }
 
 func (f CurrencyFormatter) Format(state fmt.State, verb rune) {
+	tag := language.Und
 	locale := locales["root"]
 	if languager, ok := state.(Languager); ok {
-		locale = GetLocale(languager.Language())
+		tag = languager.Language()
+		locale = GetLocale(tag)
 	}
 
 	s := ""
 	unit := f.Unit.String()
+	names := getCurrencyNames(tag, locale, unit)
 	switch f.Layout {
 	case "US Dollar":
-		s = locale.Currency[unit].Name
+		s = names.Name
 	case "USD":
 		s = unit
+	case "US dollar":
+		s = names.PluralName.One
+		if s == "" {
+			s = names.Name
+		}
+	case "US dollars":
+		s = names.PluralName.Other
+		if s == "" {
+			s = names.Name
+		}
 	case "US$":
-		s = locale.Currency[unit].Standard
+		s = names.Standard
 	case "$":
-		s = locale.Currency[unit].Narrow
+		s = names.Narrow
 	default:
-		s = locale.Currency[f.Unit.String()].Name
+		s = names.Name
 	}
 	state.Write([]byte(s))
 }
@@ -553,6 +1268,7 @@ const (
 	CurrencyISO             = "USD 100"
 	CurrencyStandard        = "US$ 100"
 	CurrencyNarrow          = "$100"
+	CurrencyLong            = "100 US dollars"
 )
 
 type AmountFormatter struct {
@@ -561,88 +1277,140 @@ type AmountFormatter struct {
 }
 
 func (f AmountFormatter) Format(state fmt.State, verb rune) {
+	tag := language.Und
 	locale := locales["root"]
 	if languager, ok := state.(Languager); ok {
-		locale = GetLocale(languager.Language())
+		tag = languager.Language()
+		locale = GetLocale(tag)
 	}
+	b, spans := appendAmount(nil, nil, tag, locale, f.Unit, big.NewInt(f.Amount.amount), f.Amount.digits, f.Layout)
+	writeParts(state, locale, b, spans)
+}
+
+// currencySymbols returns the decimal and group symbols for currency amounts, which may be specific to the currency such as for the Cape Verdean escudo.
+func currencySymbols(locale Locale, names Currency) (rune, rune) {
+	decSym, groupSym := locale.CurrencyDecimalSymbol, locale.CurrencyGroupSymbol
+	if names.Decimal != 0 {
+		decSym = names.Decimal
+	}
+	if names.Group != 0 {
+		groupSym = names.Group
+	}
+	return decSym, groupSym
+}
+
+// currencyPattern returns the standard currency pattern, or the alphaNextToNumber pattern if the currency symbol is next to the number and its adjacent character is not a symbol, such as for "USD".
+func currencyPattern(locale Locale, symbol string) string {
+	pattern := locale.CurrencyFormat.Standard
+	if symbol == "" || locale.CurrencyFormat.ISO == pattern {
+		return pattern
+	}
+
+	positive := pattern
+	if idx := strings.IndexByte(positive, ';'); idx != -1 {
+		positive = positive[:idx]
+	}
+	isNumber := func(c byte) bool {
+		return c == '#' || c == '0'
+	}
+	if i := strings.Index(positive, "¤"); i != -1 {
+		if j := i + len("¤"); j < len(positive) && isNumber(positive[j]) {
+			if r, _ := utf8.DecodeLastRuneInString(symbol); matchUnicodeSet(locale.CurrencySpacing.BeforeCurrency.CurrencyMatch, r) {
+				return locale.CurrencyFormat.ISO
+			}
+		} else if 0 < i && isNumber(positive[i-1]) {
+			if r, _ := utf8.DecodeRuneInString(symbol); matchUnicodeSet(locale.CurrencySpacing.AfterCurrency.CurrencyMatch, r) {
+				return locale.CurrencyFormat.ISO
+			}
+		}
+	}
+	return pattern
+}
 
+// appendAmount formats an amount that is multiplied by 10^(digits + AmountPrecision) using the given layout, and marks its parts.
+func appendAmount(b []byte, spans partSpans, tag language.Tag, locale Locale, unit currency.Unit, amount *big.Int, digits int, layout string) ([]byte, partSpans) {
 	// parse trailing .00 (force decimals) or .99 (allow decimals)
-	minDecimals, maxDecimals := 0, f.Amount.digits
-	if dot := strings.IndexByte(f.Layout, '.'); dot == len(f.Layout)-1 {
-		minDecimals = f.Amount.digits
-		f.Layout = f.Layout[:dot]
+	minDecimals, maxDecimals := 0, digits
+	if dot := strings.IndexByte(layout, '.'); dot == len(layout)-1 {
+		minDecimals = digits
+		layout = layout[:dot]
 	} else if dot != -1 {
 		maxDecimals = 0
-		for _, c := range f.Layout[dot+1:] {
+		for _, c := range layout[dot+1:] {
 			if c == '0' {
 				maxDecimals++
 				minDecimals = maxDecimals
 			} else if c == '9' {
 				maxDecimals++
 			} else {
-				log.Printf("INFO: locale: unsupported currency format: %v\n", f.Layout)
+				log.Printf("INFO: locale: unsupported currency format: %v\n", layout)
 				break
 			}
 		}
-		f.Layout = f.Layout[:dot]
+		layout = layout[:dot]
 	}
 
-	unit := f.Unit.String()
+	iso := unit.String()
+	names := getCurrencyNames(tag, locale, iso)
+	decSym, groupSym := currencySymbols(locale, names)
+
 	var symbol, pattern string
-	switch f.Layout {
+	switch layout {
 	case CurrencyISO:
-		symbol = unit
-		pattern = locale.CurrencyFormat.ISO
+		symbol = iso
+		pattern = currencyPattern(locale, symbol)
 	case CurrencyStandard:
-		symbol = locale.Currency[unit].Standard
-		hasLetter := false
-		for _, r := range symbol {
-			if unicode.IsLetter(r) {
-				hasLetter = true
-				break
-			}
-		}
-		if hasLetter {
-			pattern = locale.CurrencyFormat.ISO
-		} else {
-			pattern = locale.CurrencyFormat.Standard
-		}
+		symbol = names.Standard
+		pattern = currencyPattern(locale, symbol)
 	case CurrencyNarrow:
-		symbol = locale.Currency[unit].Narrow
-		pattern = locale.CurrencyFormat.Standard
+		symbol = names.Narrow
+		pattern = currencyPattern(locale, symbol)
 	case CurrencyAmount:
 		pattern = locale.CurrencyFormat.Amount
+	case CurrencyLong:
+		pattern = locale.DecimalFormat
+		decSym, groupSym = locale.DecimalSymbol, locale.GroupSymbol
 	default:
-		log.Printf("INFO: locale: unsupported currency format: %v\n", f.Layout)
+		log.Printf("INFO: locale: unsupported currency format: %v\n", layout)
 	}
 
 	if idx := strings.IndexByte(pattern, ';'); idx != -1 {
-		if f.Amount.IsNegative() {
+		if amount.Sign() < 0 {
 			pattern = pattern[idx+1:]
-			f.Amount = f.Amount.Neg()
+			amount = new(big.Int).Neg(amount)
 		} else {
 			pattern = pattern[:idx]
 		}
 	}
 
-	var amount int64
-	if prec := AmountPrecision + f.Amount.digits - maxDecimals; 0 < prec {
-		amount = bankersRounding(f.Amount.amount, prec)
-		amount /= int64Scales[prec]
+	if prec := AmountPrecision + digits - maxDecimals; 0 < prec {
+		amount = bankersRoundingRat(new(big.Rat).SetFrac(amount, bigScale(prec)))
+	} else if prec < 0 {
+		amount = new(big.Int).Mul(amount, bigScale(-prec))
 	}
 	dec := maxDecimals
-	for minDecimals < dec && amount%10 == 0 {
-		amount /= 10
-		dec--
+	ten, mod := big.NewInt(10), new(big.Int)
+	for minDecimals < dec {
+		if q, _ := new(big.Int).QuoRem(amount, ten, mod); mod.Sign() == 0 {
+			amount = q
+			dec--
+		} else {
+			break
+		}
 	}
 
-	var b []byte
+	start, startSpans := len(b), len(spans)
+	symbolStart, symbolEnd, numberStart, numberEnd := -1, -1, -1, -1
 	for i := 0; i < len(pattern); {
 		r, n := utf8.DecodeRuneInString(pattern[i:])
 		switch r {
 		// TODO: handle negative amounts
 		case '¤':
+			symbolStart = len(b)
+			spans = spans.add(PartLiteral, symbolStart)
 			b = append(b, symbol...)
+			symbolEnd = len(b)
+			spans = spans.add(PartCurrency, symbolEnd)
 		case '0', '#':
 			j := i + 1
 			group, decimal := -1, -1
@@ -667,7 +1435,10 @@ func (f AmountFormatter) Format(state fmt.State, verb rune) {
 			if decimal != -1 && group != -1 {
 				groupSize = decimal - group - 1
 			}
-			b = strconv.AppendNumber(b, amount, dec, groupSize, locale.GroupSymbol, locale.DecimalSymbol)
+			numberStart = len(b)
+			b = appendBigNumber(b, amount, dec, groupSize, groupSym, decSym)
+			numberEnd = len(b)
+			spans = spans.addNumber(b, numberStart, groupSym, decSym)
 			i = j - 1
 		case '\'':
 			j := i + 1
@@ -677,12 +1448,65 @@ func (f AmountFormatter) Format(state fmt.State, verb rune) {
 				}
 				j++
 			}
+			spans = spans.add(PartLiteral, len(b))
 			b = append(b, pattern[i+1:j]...)
 			i = j - 1
+		case '-':
+			spans = spans.add(PartLiteral, len(b))
+			b = append(b, '-')
+			spans = spans.add(PartMinusSign, len(b))
 		default:
+			spans = spans.add(PartLiteral, len(b))
 			b = append(b, []byte(pattern[i:i+n])...)
 		}
 		i += n
 	}
-	state.Write(b)
+	spans = spans.add(PartLiteral, len(b))
+
+	// insert spacing between the currency symbol and the number, such as for "USD" next to the number
+	if symbolStart < symbolEnd && numberStart < numberEnd {
+		if symbolEnd == numberStart {
+			rule := locale.CurrencySpacing.BeforeCurrency
+			c, _ := utf8.DecodeLastRune(b[symbolStart:symbolEnd])
+			d, _ := utf8.DecodeRune(b[numberStart:numberEnd])
+			if matchUnicodeSet(rule.CurrencyMatch, c) && matchUnicodeSet(rule.SurroundingMatch, d) {
+				b = append(b[:symbolEnd], append([]byte(rule.InsertBetween), b[symbolEnd:]...)...)
+				spans = spans.insert(PartLiteral, symbolEnd, len(rule.InsertBetween))
+			}
+		} else if numberEnd == symbolStart {
+			rule := locale.CurrencySpacing.AfterCurrency
+			c, _ := utf8.DecodeRune(b[symbolStart:symbolEnd])
+			d, _ := utf8.DecodeLastRune(b[numberStart:numberEnd])
+			if matchUnicodeSet(rule.CurrencyMatch, c) && matchUnicodeSet(rule.SurroundingMatch, d) {
+				b = append(b[:numberEnd], append([]byte(rule.InsertBetween), b[numberEnd:]...)...)
+				spans = spans.insert(PartLiteral, numberEnd, len(rule.InsertBetween))
+			}
+		}
+	}
+
+	if layout == CurrencyLong {
+		// use the plural form of the currency name, ie. "1 US dollar" and "2 US dollars"
+		form := plural.Other
+		if amount.IsInt64() {
+			form = pluralForm(tag, amount.Int64(), dec)
+		}
+		name := names.PluralName.Select(form)
+		if name == "" {
+			name = names.Name
+		}
+		if name == "" {
+			name = iso
+		}
+		number := append([]byte{}, b[start:]...)
+		numberSpans := partSpans{}
+		for _, span := range spans[startSpans:] {
+			numberSpans = append(numberSpans, partSpan{span.typ, span.source, span.end - start})
+		}
+		b, spans = appendPlaceholders(b[:start], spans[:startSpans].truncate(start), locale.CurrencyFormat.Long.Select(form), func() ([]byte, partSpans) {
+			return number, numberSpans
+		}, func() ([]byte, partSpans) {
+			return []byte(name), partSpans{{PartCurrency, "", len(name)}}
+		})
+	}
+	return b, spans
 }
@@ -1,10 +1,15 @@
 package locale
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
 
 	"github.com/tdewolff/test"
 )
@@ -142,3 +147,505 @@ func TestAmountFormat(t *testing.T) {
 		})
 	}
 }
+
+func TestAmountFormatLong(t *testing.T) {
+	var tests = []struct {
+		p *Printer
+		f string
+		s string
+		r string
+	}{
+		{en, "100 US dollars", "USD1", "1 US dollar"},
+		{en, "100 US dollars", "USD1234.56", "1,234.56 US dollars"},
+		{en, "100 US dollars.", "USD1", "1.00 US dollars"},
+		{en, "100 US dollars", "EUR-2", "-2 euros"},
+		{en, "100 US dollars", "XTS5", "5 Testing Currency Code"},
+		{es, "100 US dollars", "EUR1", "1 euro"},
+		{es, "100 US dollars", "USD2.5", "2,5 dólares estadounidenses"},
+		{nl, "100 US dollars", "EUR1234.56", "1.234,56 euro"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.r, func(t *testing.T) {
+			var amount Amount
+			err := amount.Scan(tt.s)
+			test.Error(t, err)
+			test.T(t, tt.p.T(amount, tt.f), tt.r)
+		})
+	}
+}
+
+func TestAmountAllocate(t *testing.T) {
+	CHF := currency.CHF
+	var tests = []struct {
+		a      Amount
+		cash   bool
+		ratios []int
+		r      []string
+	}{
+		{MustNewAmount(EUR, 100, 0), false, []int{1, 1, 1}, []string{"EUR 33.34", "EUR 33.33", "EUR 33.33"}},
+		{MustNewAmount(EUR, -100, 0), false, []int{1, 1, 1}, []string{"EUR -33.34", "EUR -33.33", "EUR -33.33"}},
+		{MustNewAmount(EUR, 5, 2), false, []int{70, 30}, []string{"EUR 0.04", "EUR 0.01"}},
+		{MustNewAmount(EUR, 1, 2), false, []int{1, 1, 1}, []string{"EUR 0.01", "EUR 0.00", "EUR 0.00"}},
+		{MustNewAmount(EUR, 1, 2), false, []int{1, 0, 2}, []string{"EUR 0.00", "EUR 0.00", "EUR 0.01"}},
+		{MustNewAmount(EUR, 100005, 4), false, []int{1, 1}, []string{"EUR 5.00", "EUR 5.00"}},
+		{MustNewAmount(CHF, 10, 0), true, []int{1, 1, 1}, []string{"CHF 3.35", "CHF 3.35", "CHF 3.30"}},
+		{MustNewAmount(currency.JPY, 1000, 0), false, []int{1, 1, 1}, []string{"JPY 334", "JPY 333", "JPY 333"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.String(), func(t *testing.T) {
+			var parts []Amount
+			var err error
+			if tt.cash {
+				parts, err = tt.a.AllocateCash(tt.ratios...)
+			} else {
+				parts, err = tt.a.Allocate(tt.ratios...)
+			}
+			test.Error(t, err)
+
+			r := []string{}
+			sum := ZeroAmount
+			for _, part := range parts {
+				r = append(r, part.String())
+				sum = sum.MustAdd(part)
+			}
+			test.T(t, r, tt.r)
+			test.T(t, sum.String(), tt.a.String())
+		})
+	}
+
+	parts, err := MustNewAmount(EUR, 100, 0).Split(3)
+	test.Error(t, err)
+	test.T(t, len(parts), 3)
+
+	_, err = MustNewAmount(EUR, 100, 0).Split(0)
+	test.That(t, err != nil)
+	_, err = MustNewAmount(EUR, 100, 0).Allocate(1, -1)
+	test.That(t, err != nil)
+}
+
+func TestAmountMarshal(t *testing.T) {
+	var tests = []struct {
+		a    Amount
+		text string
+		json string
+	}{
+		{MustNewAmount(EUR, 1234, 2), "EUR 12.34", `{"currency":"EUR","amount":"12.34"}`},
+		{MustNewAmount(EUR, -1234567, 5), "EUR -12.34567", `{"currency":"EUR","amount":"-12.34567"}`},
+		{MustNewAmount(currency.JPY, 500, 0), "JPY 500", `{"currency":"JPY","amount":"500"}`},
+		{MustNewZeroAmount(currency.USD), "USD 0", `{"currency":"USD","amount":"0"}`},
+		{ZeroAmount, "0", `{"currency":"","amount":"0"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			text, err := tt.a.MarshalText()
+			test.Error(t, err)
+			test.T(t, string(text), tt.text)
+
+			var a Amount
+			test.Error(t, a.UnmarshalText(text))
+			test.T(t, a, tt.a)
+
+			b, err := json.Marshal(tt.a)
+			test.Error(t, err)
+			test.T(t, string(b), tt.json)
+			a = Amount{}
+			test.Error(t, json.Unmarshal(b, &a))
+			test.T(t, a, tt.a)
+
+			b, err = tt.a.MarshalBinary()
+			test.Error(t, err)
+			a = Amount{}
+			test.Error(t, a.UnmarshalBinary(b))
+			test.T(t, a, tt.a)
+		})
+	}
+
+	for _, s := range []string{"", "EUR", "EUR12.34", "EUR  12.34", "eur 12.34", "EUR 1,234.56", "EUR 12.", "EUR .5", "EUR 12.345678", "EUR +5", "ABC 5"} {
+		t.Run(s, func(t *testing.T) {
+			var a Amount
+			test.That(t, a.UnmarshalText([]byte(s)) != nil, "must return error")
+		})
+	}
+	for _, b := range [][]byte{[]byte("eur\x02\x05"), []byte("EUR\x02"), []byte("EUR\x02\x05\x00"), []byte("EUR\x02\x7f"), []byte("ABC\x02\x05")} {
+		t.Run(string(b), func(t *testing.T) {
+			var a Amount
+			test.That(t, a.UnmarshalBinary(b) != nil, "must return error")
+		})
+	}
+
+	// the binary form doesn't depend on the currency's digits
+	var a Amount
+	test.Error(t, a.UnmarshalBinary([]byte("EUR\xa4\x13\x02")))
+	test.T(t, a, MustNewAmount(EUR, 1234, 2))
+	test.Error(t, a.UnmarshalBinary([]byte("JPY\xa4\x13\x02")))
+	test.T(t, a, MustNewAmount(currency.JPY, 1234, 2))
+
+	for _, s := range []string{`{"currency":"EUR"}`, `{"currency":"EUR","amount":12.34}`, `{"currency":"EUR","amount":"12.34","x":1}`, `{"currency":"","amount":"5"}`, `"EUR 12.34"`} {
+		t.Run(s, func(t *testing.T) {
+			var a Amount
+			test.That(t, json.Unmarshal([]byte(s), &a) != nil, "must return error")
+		})
+	}
+}
+
+func TestNullAmountMarshal(t *testing.T) {
+	var tests = []struct {
+		a    NullAmount
+		json string
+	}{
+		{NullAmount{}, `null`},
+		{NullAmount{ZeroAmount, true}, `{"currency":"","amount":"0"}`},
+		{NullAmount{MustNewAmount(EUR, 1234, 2), true}, `{"currency":"EUR","amount":"12.34"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			b, err := json.Marshal(tt.a)
+			test.Error(t, err)
+			test.T(t, string(b), tt.json)
+
+			var a NullAmount
+			test.Error(t, json.Unmarshal(b, &a))
+			test.T(t, a, tt.a)
+
+			text, err := tt.a.MarshalText()
+			test.Error(t, err)
+			a = NullAmount{}
+			test.Error(t, a.UnmarshalText(text))
+			test.T(t, a, tt.a)
+
+			b, err = tt.a.MarshalBinary()
+			test.Error(t, err)
+			a = NullAmount{}
+			test.Error(t, a.UnmarshalBinary(b))
+			test.T(t, a, tt.a)
+		})
+	}
+}
+
+func TestParseAmountFormat(t *testing.T) {
+	var tests = []struct {
+		p     *Printer
+		s     string
+		units []currency.Unit
+		r     string
+	}{
+		{nl, "€ 1.234,56", nil, "EUR 1,234.56"},
+		{nl, "€ 1.234,56", nil, "EUR 1,234.56"},
+		{nl, "€ -5", nil, "EUR -5.00"},
+		{nl, "€ -5,00", nil, "EUR -5.00"},
+		{nl, "1.234,56 EUR", nil, "EUR 1,234.56"},
+		{nl, "US$ 3,50", nil, "USD 3.50"},
+		{nl, "$ 3,50", []currency.Unit{currency.USD}, "USD 3.50"},
+		{en, "US$1,234.56", nil, "USD 1,234.56"},
+		{en, "$1,234.56", nil, "USD 1,234.56"},
+		{en, "-$5", nil, "USD -5.00"},
+		{en, "$-5", nil, "USD -5.00"},
+		{en, "($5.00)", nil, "USD -5.00"},
+		{en, "(5.00)", []currency.Unit{currency.USD}, "USD -5.00"},
+		{en, "5", []currency.Unit{EUR}, "EUR 5.00"},
+		{en, "CA$5", nil, "CAD 5.00"},
+		{en, "USD 1,234.56", nil, "USD 1,234.56"},
+		{en, "1,234.56 USD", nil, "USD 1,234.56"},
+		{en, "€.5", nil, "EUR 0.50"},
+		{en, "£5", nil, "GBP 5.00"},
+		{es, "5,00 €", nil, "EUR 5.00"},
+		{es, "-1.234,56 US$", nil, "USD -1,234.56"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			a, err := ParseAmountFormat(tt.p.LanguageTag, tt.s, tt.units...)
+			test.Error(t, err)
+			test.T(t, a.String(), tt.r)
+		})
+	}
+
+	var errorTests = []struct {
+		p   *Printer
+		s   string
+		err error
+	}{
+		{en, "5.00", ErrUnknownCurrency},
+		{en, "XYZ 5.00", ErrUnknownCurrency},
+		{nl, "$ 5,00", ErrAmbiguousCurrency},
+		{en, "--$5", nil},
+		{en, "($5.00", nil},
+		{en, "-($5.00)", nil},
+		{en, "$5$", nil},
+		{en, "$1.234.56", nil},
+		{en, "$", nil},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.s, func(t *testing.T) {
+			_, err := ParseAmountFormat(tt.p.LanguageTag, tt.s)
+			test.That(t, err != nil, "must return error")
+			if tt.err != nil {
+				test.That(t, errors.Is(err, tt.err), err)
+			}
+		})
+	}
+
+	// currency with its own decimal symbol, such as the Cape Verdean escudo
+	escudo := currency.MustParseISO("XTS")
+	test.Error(t, RegisterCurrency(escudo, CurrencyInfo{2, 0, 2, 0}, map[language.Tag]Currency{
+		language.English: {Standard: "Esc", Decimal: '$', Group: ' '},
+	}))
+	defer UnregisterCurrency(escudo)
+	a, err := ParseAmountFormat(language.English, "Esc 1 234$56")
+	test.Error(t, err)
+	test.T(t, a.String(), "XTS 1,234.56")
+	a, err = ParseAmountFormat(language.English, "1 234$56", escudo)
+	test.Error(t, err)
+	test.T(t, a.String(), "XTS 1,234.56")
+
+	// round trip
+	for _, p := range []*Printer{en, es, nl} {
+		for _, layout := range []string{CurrencyISO + ".", CurrencyStandard + "."} {
+			for _, a := range []Amount{MustNewAmount(EUR, 123456, 2), MustNewAmount(currency.USD, -123456, 2), MustNewAmount(currency.JPY, 5, 0)} {
+				s := p.T(a, layout)
+				t.Run(s, func(t *testing.T) {
+					b, err := ParseAmountFormat(p.LanguageTag, s)
+					test.Error(t, err)
+					test.T(t, b, a)
+				})
+			}
+		}
+	}
+}
+
+func TestCurrenciesForRegion(t *testing.T) {
+	var tests = []struct {
+		region string
+		at     time.Time
+		units  []currency.Unit
+	}{
+		{"NL", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), []currency.Unit{EUR}},
+		{"NL", time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), []currency.Unit{EUR, currency.MustParseISO("NLG")}},
+		{"NL", time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC), []currency.Unit{currency.MustParseISO("NLG")}},
+		{"HR", time.Date(2023, 1, 14, 0, 0, 0, 0, time.UTC), []currency.Unit{EUR, currency.MustParseISO("HRK")}},
+		{"HR", time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC), []currency.Unit{EUR}},
+		{"CH", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), []currency.Unit{currency.CHF}},
+		{"US", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), []currency.Unit{currency.USD}},
+		{"SA", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), []currency.Unit{currency.MustParseISO("SAR")}},
+		{"AQ", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), []currency.Unit{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			test.T(t, CurrenciesForRegion(language.MustParseRegion(tt.region), tt.at), tt.units)
+		})
+	}
+}
+
+func TestDefaultCurrency(t *testing.T) {
+	var tests = []struct {
+		tag  string
+		unit currency.Unit
+		ok   bool
+	}{
+		{"en", currency.USD, true},
+		{"en-GB", currency.GBP, true},
+		{"nl", EUR, true},
+		{"es", EUR, true},
+		{"es-CL", currency.MustParseISO("CLP"), true},
+		{"ja", currency.JPY, true},
+		{"ar-EG", currency.MustParseISO("EGP"), true},
+		{"th", currency.MustParseISO("THB"), true},
+		{"id", currency.MustParseISO("IDR"), true},
+		{"es-419", currency.Unit{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			unit, ok := DefaultCurrency(language.MustParse(tt.tag))
+			test.T(t, ok, tt.ok)
+			test.T(t, unit, tt.unit)
+		})
+	}
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	points := currency.MustParseISO("XTS")
+	test.Error(t, RegisterCurrency(points, CurrencyInfo{0, 0, 0, 0}, map[language.Tag]Currency{
+		language.English: {Name: "loyalty points", Standard: "pts", Narrow: "P", PluralName: Count{"point", "points"}},
+		language.Dutch:   {Name: "spaarpunten", Standard: "ptn", PluralName: Count{"punt", "punten"}},
+	}))
+	defer UnregisterCurrency(points)
+
+	token := currency.MustParseISO("XBB")
+	test.Error(t, RegisterCurrency(token, CurrencyInfo{18, 0, 18, 0}, nil))
+	defer UnregisterCurrency(token)
+
+	test.That(t, RegisterCurrency(token, CurrencyInfo{2, 5, 2, 0}, nil) != nil, "must return error for rounding")
+	test.That(t, RegisterCurrency(token, CurrencyInfo{19, 0, 0, 0}, nil) != nil, "must return error for digits")
+
+	a := MustNewAmount(points, 1500, 0)
+	test.T(t, a.String(), "XTS 1,500")
+	test.T(t, MustNewAmount(points, 25, 1).String(), "XTS 2")
+	test.T(t, en.T(a, CurrencyStandard), "pts 1,500")
+	test.T(t, en.T(a, CurrencyNarrow), "P\u00A01,500")
+	test.T(t, en.T(a, CurrencyLong), "1,500 points")
+	test.T(t, en.T(MustNewAmount(points, 1, 0), CurrencyLong), "1 point")
+	test.T(t, nl.T(a, CurrencyStandard), "ptn 1.500")
+	test.T(t, nl.T(a, CurrencyLong), "1.500 punten")
+	test.T(t, en.T(points, "US Dollar"), "loyalty points")
+	test.T(t, NewPrinter(language.BritishEnglish, nil).T(points, "US$"), "pts")
+	test.T(t, nl.T(points, "US$"), "ptn")
+	test.T(t, AmountRegex(language.English, points), `^(?:[0-9]+,)*[0-9]+$`)
+
+	b, err := ParseAmountFormat(language.English, "pts 1,500")
+	test.Error(t, err)
+	test.T(t, b, a)
+
+	_, err = NewAmount(token, 1, 0)
+	test.That(t, err != nil, "must return error for too many digits")
+
+	// bypass the validation of RegisterCurrency
+	nickel := currency.MustParseISO("XXX")
+	registeredCurrenciesMu.Lock()
+	registeredCurrencies[nickel.String()] = registeredCurrency{CurrencyInfo{2, 5, 2, 0}, nil}
+	registeredCurrenciesMu.Unlock()
+	defer UnregisterCurrency(nickel)
+	_, err = NewAmount(nickel, 1, 0)
+	test.That(t, err != nil, "must return error for rounding")
+	_, err = NewAmountFromFloat64(nickel, 1.0)
+	test.That(t, err != nil, "must return error for rounding")
+	_, err = NewZeroAmount(nickel)
+	test.That(t, err != nil, "must return error for rounding")
+	c := MustNewBigAmount(token, big.NewInt(123456789), 18)
+	test.T(t, c.String(), "XBB 0.000000000123456789")
+	test.T(t, c.MustMul(1000000000).StringAmount(), "0.123456789")
+}
+
+func TestCurrencySpacing(t *testing.T) {
+	test.T(t, en.T(MustNewAmount(currency.CAD, 5, 0), CurrencyStandard+"."), "CA$5.00")
+	test.T(t, en.T(MustNewAmount(currency.USD, 5, 0), CurrencyISO+"."), "USD 5.00")
+	test.T(t, en.T(MustNewAmount(currency.USD, 5, 0), CurrencyStandard+"."), "$5.00")
+
+	// without alphaNextToNumber pattern
+	locale := locales["en"]
+	locale.CurrencyFormat.ISO = locale.CurrencyFormat.Standard
+	var tests = []struct {
+		pattern string
+		unit    currency.Unit
+		layout  string
+		r       string
+	}{
+		{"¤#,##0.00", currency.USD, CurrencyISO, "USD 5.00"},
+		{"¤#,##0.00", currency.USD, CurrencyStandard, "$5.00"},
+		{"#,##0.00¤", currency.USD, CurrencyISO, "5.00 USD"},
+		{"#,##0.00¤", EUR, CurrencyStandard, "5.00€"},
+		{"#,##0.00 ¤", currency.USD, CurrencyISO, "5.00 USD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.r, func(t *testing.T) {
+			locale.CurrencyFormat.Standard = tt.pattern
+			locale.CurrencyFormat.ISO = tt.pattern
+			b, _ := appendAmount(nil, nil, language.English, locale, tt.unit, big.NewInt(500000), 2, tt.layout+".")
+			test.T(t, string(b), tt.r)
+		})
+	}
+
+	// currency-specific symbols
+	cve := currency.MustParseISO("CVE")
+	test.Error(t, RegisterCurrency(cve, CurrencyInfo{2, 0, 2, 0}, map[language.Tag]Currency{
+		language.Und: {Decimal: '$', Group: ' '},
+	}))
+	defer UnregisterCurrency(cve)
+	test.T(t, en.T(MustNewAmount(cve, 123456, 2), CurrencyISO+"."), "CVE 1 234$56")
+	test.T(t, AmountRegex(language.English, cve), `^(?:[0-9]+ )*[0-9]+(?:\$[0-9]{1,2})?$`)
+}
+
+func TestMatchUnicodeSet(t *testing.T) {
+	var tests = []struct {
+		set string
+		r   rune
+		ok  bool
+	}{
+		{"[:digit:]", '5', true},
+		{"[:digit:]", 'a', false},
+		{"[[:^S:]&[:^Z:]]", 'D', true},
+		{"[[:^S:]&[:^Z:]]", '$', false},
+		{"[[:^S:]&[:^Z:]]", ' ', false},
+		{"[a-c]", 'b', true},
+		{"[^a-c]", 'b', false},
+		{"[[:L:]-[a]]", 'a', false},
+		{"[[:L:]-[a]]", 'b', true},
+		{"", 'a', false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.set, func(t *testing.T) {
+			test.T(t, matchUnicodeSet(tt.set, tt.r), tt.ok)
+		})
+	}
+}
+
+func TestAmountRational(t *testing.T) {
+	price := MustNewAmount(EUR, 1999, 2)
+	test.T(t, price.MustMulBasisPoints(21*Percent).StringAmount(), "4.1979")
+	test.T(t, price.MustMulBasisPoints(21*Percent).Round().String(), "EUR 4.20")
+	test.T(t, price.MustAddBasisPoints(21*Percent).Round().String(), "EUR 24.19")
+	test.T(t, price.MustSubBasisPoints(10*Percent).StringAmount(), "17.991")
+	test.T(t, MustNewAmount(EUR, 1, 0).MustMulRat(big.NewRat(1, 3)).StringAmount(), "0.33333")
+	test.T(t, MustNewAmount(EUR, 1, 0).MustMulRat(big.NewRat(-2, 3)).StringAmount(), "-0.66667")
+	test.T(t, MustNewAmount(EUR, 10, 0).MustMulf(0.1).StringAmount(), "1")
+
+	_, err := MustNewAmount(EUR, MaxAmount/1000000, 5).MulRat(big.NewRat(1000001, 1))
+	test.T(t, err, ErrOverflow)
+
+	var tests = []struct {
+		a    Amount
+		d    int
+		mode RoundingMode
+		r    string
+	}{
+		{MustNewAmount(EUR, 10, 0), 3, RoundHalfEven, "EUR 3.33"},
+		{MustNewAmount(EUR, 10, 0), 3, RoundUp, "EUR 3.34"},
+		{MustNewAmount(EUR, 10, 0), 3, RoundDown, "EUR 3.33"},
+		{MustNewAmount(EUR, 10, 0), 3, RoundCeiling, "EUR 3.34"},
+		{MustNewAmount(EUR, -10, 0), 3, RoundCeiling, "EUR -3.33"},
+		{MustNewAmount(EUR, -10, 0), 3, RoundFloor, "EUR -3.34"},
+		{MustNewAmount(EUR, 25, 2), 10, RoundHalfEven, "EUR 0.02"},
+		{MustNewAmount(EUR, 35, 2), 10, RoundHalfEven, "EUR 0.04"},
+		{MustNewAmount(EUR, 25, 2), 10, RoundHalfUp, "EUR 0.03"},
+		{MustNewAmount(EUR, -25, 2), 10, RoundHalfUp, "EUR -0.03"},
+		{MustNewAmount(EUR, 25, 2), 10, RoundHalfDown, "EUR 0.02"},
+		{MustNewAmount(EUR, 26, 2), 10, RoundHalfDown, "EUR 0.03"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.r+" "+tt.mode.String(), func(t *testing.T) {
+			q, err := tt.a.Quo(tt.d, tt.mode)
+			test.Error(t, err)
+			test.T(t, q.String(), tt.r)
+			test.T(t, q.StringAmount(), q.Round().StringAmount())
+		})
+	}
+	_, err = price.Quo(0, RoundHalfEven)
+	test.That(t, err != nil, "must return error for division by zero")
+
+	q, r, err := MustNewAmount(EUR, 10, 0).DivMod(3)
+	test.Error(t, err)
+	test.T(t, q.String(), "EUR 3.33")
+	test.T(t, r.String(), "EUR 0.01")
+	q, r, err = MustNewAmount(EUR, -1000001, 5).DivMod(3)
+	test.Error(t, err)
+	test.T(t, q.StringAmount(), "-3.33")
+	test.T(t, r.StringAmount(), "-0.01001")
+	test.T(t, q.MustMul(3).MustAdd(r).StringAmount(), "-10.00001")
+
+	q, r, err = MustNewAmount(EUR, 5, 0).DivMod(1 << 62)
+	test.Error(t, err)
+	test.T(t, q.String(), "EUR 0.00")
+	test.T(t, r.String(), "EUR 5.00")
+
+	test.T(t, MustNewAmount(EUR, 1005, 3).MustRoundMode(RoundHalfUp).String(), "EUR 1.01")
+	test.T(t, MustNewAmount(EUR, 1005, 3).MustRoundMode(RoundHalfEven).String(), "EUR 1.00")
+	test.T(t, MustNewAmount(EUR, -1001, 3).MustRoundMode(RoundFloor).String(), "EUR -1.01")
+	_, err = Amount{EUR, MaxAmount, 2, 0}.RoundMode(RoundUp)
+	test.T(t, err, ErrOverflow)
+	_, err = Amount{EUR, -MaxAmount, 2, 0}.RoundMode(RoundFloor)
+	test.T(t, err, ErrUnderflow)
+
+	test.T(t, (21 * Percent).String(), "21%")
+	test.T(t, BasisPoints(2150).String(), "21.5%")
+	test.T(t, BasisPoints(5).String(), "0.05%")
+	test.T(t, BasisPoints(-250).String(), "-2.5%")
+}
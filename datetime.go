@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
@@ -47,7 +49,8 @@ func (f TimeFormatter) Format(state fmt.State, verb rune) {
 	pattern, datePattern, timePattern := layoutToPatterns(locale, f.Layout)
 	pattern = strings.ReplaceAll(pattern, "{0}", timePattern)
 	pattern = strings.ReplaceAll(pattern, "{1}", datePattern)
-	state.Write(formatTime([]byte{}, pattern, locale, f.Time))
+	b, spans := formatTime(nil, nil, pattern, locale, inTimezone(locale, f.Time))
+	writeParts(state, locale, b, spans)
 }
 
 type IntervalFormatter struct {
@@ -63,12 +66,15 @@ func (f IntervalFormatter) Format(state fmt.State, verb rune) {
 
 	pattern, datePattern, timePattern := layoutToPatterns(locale, f.Layout)
 
+	f.From, f.To = inTimezone(locale, f.From), inTimezone(locale, f.To)
+	from, to := getCalendarDate(locale, f.From), getCalendarDate(locale, f.To)
+
 	var greatestDifference string
-	if f.From.Year() != f.To.Year() {
+	if from.Era != to.Era || from.Year != to.Year {
 		greatestDifference = "y"
-	} else if f.From.Month() != f.To.Month() {
+	} else if from.Month != to.Month || from.Leap != to.Leap {
 		greatestDifference = "M"
-	} else if f.From.Day() != f.To.Day() {
+	} else if from.Day != to.Day {
 		greatestDifference = "d"
 	} else if f.From.Hour()/12 != f.To.Hour()/12 {
 		greatestDifference = "a"
@@ -109,23 +115,53 @@ func (f IntervalFormatter) Format(state fmt.State, verb rune) {
 				intervalPattern = strings.ReplaceAll(intervalPattern, "{1}", datePattern)
 				intervalPattern = strings.ReplaceAll(intervalPattern, "{0}", timeIntervalPattern)
 			} else {
-				b := []byte(locale.DatetimeFormat.Full)
-				b = bytes.ReplaceAll(b, []byte("{1}"), formatTime([]byte{}, datePattern, locale, f.From))
-				b = bytes.ReplaceAll(b, []byte("{0}"), []byte(locale.DatetimeIntervalFormat[""][""]))
-				b = bytes.ReplaceAll(b, []byte("{0}"), formatTime([]byte{}, timePattern, locale, f.From))
-				b = bytes.ReplaceAll(b, []byte("{1}"), formatTime([]byte{}, timePattern, locale, f.To))
-				state.Write(b)
+				b, spans := appendPlaceholders(nil, nil, locale.DatetimeFormat.Full, func() ([]byte, partSpans) {
+					return appendPlaceholders(nil, nil, locale.DatetimeIntervalFormat[""][""], func() ([]byte, partSpans) {
+						return formatTimeSource(timePattern, locale, f.From, SourceStartRange)
+					}, func() ([]byte, partSpans) {
+						return formatTimeSource(timePattern, locale, f.To, SourceEndRange)
+					})
+				}, func() ([]byte, partSpans) {
+					return formatTime(nil, nil, datePattern, locale, f.From)
+				})
+				spans.setSource(0, SourceShared)
+				writeParts(state, locale, b, spans)
 				return
 			}
 		} else {
-			b := []byte(locale.DatetimeIntervalFormat[""][""])
-			b = bytes.ReplaceAll(b, []byte("{0}"), formatTime([]byte{}, fullPattern, locale, f.From))
-			b = bytes.ReplaceAll(b, []byte("{1}"), formatTime([]byte{}, fullPattern, locale, f.To))
-			state.Write(b)
+			b, spans := appendPlaceholders(nil, nil, locale.DatetimeIntervalFormat[""][""], func() ([]byte, partSpans) {
+				return formatTimeSource(fullPattern, locale, f.From, SourceStartRange)
+			}, func() ([]byte, partSpans) {
+				return formatTimeSource(fullPattern, locale, f.To, SourceEndRange)
+			})
+			spans.setSource(0, SourceShared)
+			writeParts(state, locale, b, spans)
 			return
 		}
 	}
-	state.Write(formatInterval([]byte{}, intervalPattern, locale, f.From, f.To))
+	b, spans := formatInterval(nil, nil, intervalPattern, locale, f.From, f.To)
+	writeParts(state, locale, b, spans)
+}
+
+// SkeletonFormatter formats a time using a skeleton such as "yMMMd", which lists the fields to display without their order or punctuation, using the locale's best matching pattern, such as "MMM d, y" for English or "d MMM y" for Spanish.
+type SkeletonFormatter struct {
+	Time     time.Time
+	Skeleton string
+}
+
+func (f SkeletonFormatter) Format(state fmt.State, verb rune) {
+	locale := locales["root"]
+	if languager, ok := state.(Languager); ok {
+		locale = GetLocale(languager.Language())
+	}
+
+	skeleton, noDayPeriod := expandHourSkeleton(locale, f.Skeleton)
+	pattern := skeletonToPattern(locale, skeleton)
+	if noDayPeriod {
+		pattern = strings.Join(removeDayPeriods(splitPattern(pattern)), "")
+	}
+	b, spans := formatTime(nil, nil, pattern, locale, inTimezone(locale, f.Time))
+	writeParts(state, locale, b, spans)
 }
 
 type skeletonSymbol struct {
@@ -135,11 +171,13 @@ type skeletonSymbol struct {
 
 type skeletonSymbols [12]skeletonSymbol
 
+// skeletonSymbolLists are the symbols of each field of a skeleton, the first seven are date fields and the others time fields.
+var skeletonSymbolLists = []string{"G", "yYuUr", "Qq", "MLl", "wW", "dDFg", "Eec", "abB", "hHKkjJC", "m", "sSA", "zZOvVXx"}
+
 func makeSkeletonSymbols(pattern string) skeletonSymbols {
 	v := skeletonSymbols{}
-	symbolLists := []string{"G", "yYuUr", "Qq", "MLl", "wW", "dDFg", "Eec", "abB", "hHKkjJC", "m", "sSA", "zZOvVXx"}
 LoopElems:
-	for i, symbolList := range symbolLists {
+	for i, symbolList := range skeletonSymbolLists {
 		for _, symbol := range symbolList {
 			if idx := strings.Index(pattern, string(symbol)); idx != -1 {
 				n := 1
@@ -180,6 +218,7 @@ func matchSkeletonSymbols[T any](formats map[string]T, pattern string) (T, map[b
 			skeletons = append(skeletons, v)
 		}
 	}
+	sort.Strings(skeletons) // deterministic choice between equally good matches
 
 	bestSubs := map[byte]string{}
 	best, bestScore := -1, math.MaxInt
@@ -226,6 +265,9 @@ LoopList:
 }
 
 func getIntervalPattern(locale Locale, pattern, greatestDifference string) (string, bool) {
+	if locale.Calendar != "gregorian" && strings.ContainsAny(pattern, "GyMLd") {
+		return "", false // interval formats are only available for the Gregorian calendar
+	}
 	intervalPatterns, subs := matchSkeletonSymbols(locale.DatetimeIntervalFormat, pattern)
 	if subs == nil {
 		return "", false
@@ -251,9 +293,135 @@ func getIntervalPattern(locale Locale, pattern, greatestDifference string) (stri
 	return intervalPattern, ok
 }
 
-func formatTime(b []byte, pattern string, locale Locale, t time.Time) []byte {
+// skeletonToPattern returns the locale's pattern for a skeleton. Skeletons with both date and time fields that have no pattern are split and combined using the datetime format, as in LDML. Skeletons without any matching pattern are used as the pattern.
+func skeletonToPattern(locale Locale, skeleton string) string {
+	if pattern, ok := matchSkeletonPattern(locale, skeleton); ok {
+		return pattern
+	}
+
+	var dateSymbols, timeSymbols skeletonSymbols
+	symbols := makeSkeletonSymbols(skeleton)
+	copy(dateSymbols[:7], symbols[:7])
+	copy(timeSymbols[7:], symbols[7:])
+	dateSkeleton, timeSkeleton := fromSkeletonSymbols(dateSymbols), fromSkeletonSymbols(timeSymbols)
+	if dateSkeleton == "" || timeSkeleton == "" {
+		return skeleton
+	}
+
+	datePattern, ok := matchSkeletonPattern(locale, dateSkeleton)
+	if !ok {
+		datePattern = dateSkeleton
+	}
+	timePattern, ok := matchSkeletonPattern(locale, timeSkeleton)
+	if !ok {
+		timePattern = timeSkeleton
+	}
+
+	// the length of the datetime format follows the month and weekday of the date
+	pattern := locale.DatetimeFormat.Short
+	if month := symbols[3]; 4 <= month.N && symbols[6].N != 0 {
+		pattern = locale.DatetimeFormat.Full
+	} else if 4 <= month.N {
+		pattern = locale.DatetimeFormat.Long
+	} else if month.N == 3 {
+		pattern = locale.DatetimeFormat.Medium
+	}
+	pattern = strings.ReplaceAll(pattern, "{0}", timePattern)
+	pattern = strings.ReplaceAll(pattern, "{1}", datePattern)
+	return pattern
+}
+
+// matchSkeletonPattern returns the locale's available format that best matches the skeleton, with its field widths adjusted to those of the skeleton, such as "MMMM d, y" for "yMMMMd" using the format for "yMMMd". Widths are not adjusted between numeric and text fields, nor for hours, minutes, and seconds.
+func matchSkeletonPattern(locale Locale, skeleton string) (string, bool) {
+	pattern, subs := matchSkeletonSymbols(locale.DatetimeAvailableFormat, skeleton)
+	if subs == nil {
+		return "", false
+	}
+
+	target := makeSkeletonSymbols(skeleton)
+	sb := strings.Builder{}
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		if c == '\'' {
+			j := strings.IndexByte(pattern[i+1:], '\'')
+			if j == -1 {
+				j = len(pattern) - i - 1
+			}
+			sb.WriteString(pattern[i : i+j+2])
+			i += j + 2
+			continue
+		}
+
+		n := 1
+		for i+n < len(pattern) && pattern[i+n] == c {
+			n++
+		}
+		symbol := skeletonSymbol{c, n}
+		for j, symbolList := range skeletonSymbolLists {
+			if strings.IndexByte(symbolList, c) == -1 || target[j].N == 0 {
+				continue
+			} else if j == 7 || j == 11 {
+				// day period and time zone as requested
+				symbol = target[j]
+			} else if j != 8 && j != 9 && j != 10 {
+				numeric := n < 3 && strings.IndexByte("MLQqec", c) != -1
+				if numeric == (target[j].N < 3 && strings.IndexByte("MLQqec", target[j].Symbol) != -1) || strings.IndexByte("MLQqec", c) == -1 {
+					symbol.N = target[j].N
+				}
+			}
+			break
+		}
+		if symbol.Symbol == c && symbol.N == n {
+			sb.WriteString(pattern[i : i+n])
+		} else {
+			sb.WriteString(strings.Repeat(string(symbol.Symbol), symbol.N))
+		}
+		i += n
+	}
+	return sb.String(), true
+}
+
+// datetimePartType returns the part type of a date/time pattern symbol.
+func datetimePartType(symbol byte) PartType {
+	switch symbol {
+	case 'G':
+		return PartEra
+	case 'y', 'Y', 'u':
+		return PartYear
+	case 'U':
+		return PartYearName
+	case 'r':
+		return PartRelatedYear
+	case 'Q', 'q':
+		return PartQuarter
+	case 'M', 'L':
+		return PartMonth
+	case 'w', 'W':
+		return PartWeek
+	case 'd', 'D', 'F', 'g':
+		return PartDay
+	case 'E', 'e', 'c':
+		return PartWeekday
+	case 'a', 'b', 'B':
+		return PartDayPeriod
+	case 'h', 'H', 'K', 'k':
+		return PartHour
+	case 'm':
+		return PartMinute
+	case 's':
+		return PartSecond
+	case 'S', 'A':
+		return PartFractionalSecond
+	case 'z', 'Z', 'O', 'v', 'V', 'X', 'x':
+		return PartTimeZoneName
+	}
+	return PartLiteral
+}
+
+func formatTime(b []byte, spans partSpans, pattern string, locale Locale, t time.Time) ([]byte, partSpans) {
 	for i := 0; i < len(pattern); {
 		r, n := utf8.DecodeRuneInString(pattern[i:])
+		spans = spans.add(PartLiteral, len(b))
 		switch r {
 		case '\'':
 			j := i + 1
@@ -272,6 +440,7 @@ func formatTime(b []byte, pattern string, locale Locale, t time.Time) []byte {
 				log.Printf("INFO: locale: unsupported date/time format: %v\n", pattern[i:i+m])
 				i += m
 			} else if m != 0 {
+				spans = spans.add(datetimePartType(pattern[i]), len(b))
 				i += m
 			} else {
 				b = utf8.AppendRune(b, r)
@@ -279,14 +448,28 @@ func formatTime(b []byte, pattern string, locale Locale, t time.Time) []byte {
 			}
 		}
 	}
-	return b
+	return b, spans.add(PartLiteral, len(b))
 }
 
-func formatInterval(b []byte, pattern string, locale Locale, from, to time.Time) []byte {
-	t := from
+// formatTimeSource formats the time and marks all its parts with the given source.
+func formatTimeSource(pattern string, locale Locale, t time.Time, source string) ([]byte, partSpans) {
+	b, spans := formatTime(nil, nil, pattern, locale, t)
+	spans.setSource(0, source)
+	return b, spans
+}
+
+func formatInterval(b []byte, spans partSpans, pattern string, locale Locale, from, to time.Time) ([]byte, partSpans) {
+	// fields that occur twice are of the start and end time, the others are shared
+	counts := map[rune]int{}
+	for _, r := range pattern {
+		counts[r]++
+	}
+
+	t, source := from, SourceStartRange
 	handled := map[rune]bool{}
 	for i := 0; i < len(pattern); {
 		r, n := utf8.DecodeRuneInString(pattern[i:])
+		spans = spans.add(PartLiteral, len(b))
 		switch r {
 		case '\'':
 			j := i + 1
@@ -304,7 +487,7 @@ func formatInterval(b []byte, pattern string, locale Locale, from, to time.Time)
 				i += n
 			} else {
 				if handled[r] {
-					t = to // first repeating field switches to 'to' time
+					t, source = to, SourceEndRange // first repeating field switches to 'to' time
 				}
 				handled[r] = true
 
@@ -314,6 +497,11 @@ func formatInterval(b []byte, pattern string, locale Locale, from, to time.Time)
 					log.Printf("INFO: locale: unsupported date/time format: %v\n", pattern[i:i+m])
 					i += m
 				} else if m != 0 {
+					if m < counts[r] {
+						spans = append(spans, partSpan{datetimePartType(byte(r)), source, len(b)})
+					} else {
+						spans = append(spans, partSpan{datetimePartType(byte(r)), SourceShared, len(b)})
+					}
 					i += m
 				} else {
 					b = utf8.AppendRune(b, r)
@@ -322,7 +510,47 @@ func formatInterval(b []byte, pattern string, locale Locale, from, to time.Time)
 			}
 		}
 	}
-	return b
+	spans = spans.add(PartLiteral, len(b))
+	spans.setSource(0, SourceShared)
+	return b, spans
+}
+
+// timezones caches the loaded time zones.
+var timezones sync.Map
+
+// loadLocation returns the location of the IANA time zone, or nil if it cannot be loaded.
+func loadLocation(timezone string) *time.Location {
+	if loc, ok := timezones.Load(timezone); ok {
+		return loc.(*time.Location)
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil
+	}
+	timezones.Store(timezone, loc)
+	return loc
+}
+
+// getLocation returns the location of the time zone set by the -u-tz- extension, or nil if not set.
+func getLocation(locale Locale) *time.Location {
+	if locale.Timezone == "" {
+		return nil
+	}
+
+	loc := loadLocation(locale.Timezone)
+	if loc == nil {
+		log.Printf("INFO: locale: unsupported time zone: %v\n", locale.Timezone)
+	}
+	return loc
+}
+
+// inTimezone returns the time in the time zone set by the -u-tz- extension, if any.
+func inTimezone(locale Locale, t time.Time) time.Time {
+	if loc := getLocation(locale); loc != nil {
+		return t.In(loc)
+	}
+	return t
 }
 
 func getTimezone(locale Locale, t time.Time) string {
@@ -333,8 +561,269 @@ func getTimezone(locale Locale, t time.Time) string {
 	return timezone
 }
 
-func is12Hours(locale Locale) bool {
-	return strings.IndexByte(locale.TimeFormat.Full, 'h') != -1
+// getTimezoneCity returns the exemplar city of the time zone, which is derived from the time zone ID if the locale has no name for it.
+func getTimezoneCity(locale Locale, timezone string) (string, bool) {
+	if city, ok := locale.TimezoneCity[timezone]; ok {
+		return city, true
+	} else if i := strings.LastIndexByte(timezone, '/'); i != -1 && !strings.HasPrefix(timezone, "Etc/") {
+		return strings.ReplaceAll(timezone[i+1:], "_", " "), true
+	}
+	return "", false
+}
+
+// getTimezoneLocation returns the region name for time zones that are the only or primary one in their region, and the exemplar city otherwise.
+func getTimezoneLocation(locale Locale, timezone string) (string, bool) {
+	if region, ok := primaryZones[timezone]; ok {
+		if name, ok := locale.Territory[region]; ok {
+			return name, true
+		}
+	}
+	return getTimezoneCity(locale, timezone)
+}
+
+// appendGenericName appends the generic name of the metazone, adding the location when the time zone's offset differs from the metazone's golden zone, such as "Mountain Time (Phoenix)".
+func appendGenericName(b []byte, locale Locale, t time.Time, name string) []byte {
+	timezone := getTimezone(locale, t)
+	if golden, ok := metazoneZones[metazones[timezone]]; ok && golden != timezone {
+		if loc := loadLocation(golden); loc != nil {
+			_, offset := t.Zone()
+			if _, goldenOffset := t.In(loc).Zone(); offset != goldenOffset {
+				if location, ok := getTimezoneLocation(locale, timezone); ok {
+					return append(b, strings.NewReplacer("{0}", location, "{1}", name).Replace(locale.FallbackFormat)...)
+				}
+			}
+		}
+	}
+	return append(b, name...)
+}
+
+// appendLocationFormat appends the generic location format using the region format, such as "France Time", or the daylight or standard region format if specific is set, such as "France Standard Time".
+func appendLocationFormat(b []byte, locale Locale, t time.Time, specific bool) ([]byte, bool) {
+	location, ok := getTimezoneLocation(locale, getTimezone(locale, t))
+	if !ok {
+		return b, false
+	}
+
+	format := locale.RegionFormat
+	if specific && t.IsDST() {
+		format = locale.RegionDaylightFormat
+	} else if specific {
+		format = locale.RegionStandardFormat
+	}
+	return append(b, strings.Replace(format, "{0}", location, 1)...), true
+}
+
+// appendGMTFormat appends the localized GMT format, such as "GMT-7" or "GMT-07:00" if long is set, using the locale's hour format.
+func appendGMTFormat(b []byte, locale Locale, t time.Time, long bool) []byte {
+	_, offset := t.Zone()
+	if offset == 0 {
+		return append(b, locale.GMTZeroFormat...)
+	}
+
+	hourFormat := locale.HourFormat
+	if positive, negative, ok := strings.Cut(hourFormat, ";"); ok {
+		if offset < 0 {
+			hourFormat = negative
+		} else {
+			hourFormat = positive
+		}
+	}
+	if offset < 0 {
+		offset = -offset
+	}
+	hours, minutes := int64(offset/3600), int64(offset/60%60)
+
+	var hour []byte
+	end := 0 // end of the hours, minutes are omitted in the short format if zero
+	for i := 0; i < len(hourFormat); {
+		n := 1
+		for i+n < len(hourFormat) && hourFormat[i+n] == hourFormat[i] {
+			n++
+		}
+		switch hourFormat[i] {
+		case 'H':
+			if long {
+				hour = appendPadded(hour, hours, n)
+			} else {
+				hour = strconv.AppendInt(hour, hours, 10)
+			}
+			end = len(hour)
+		case 'm':
+			if long || minutes != 0 {
+				hour = appendPadded(hour, minutes, n)
+			} else {
+				hour = hour[:end]
+			}
+		default:
+			hour = append(hour, hourFormat[i:i+n]...)
+		}
+		i += n
+	}
+	return append(b, strings.Replace(locale.GMTFormat, "{0}", string(hour), 1)...)
+}
+
+// hourCycles are the hour symbols of the BCP 47 hour cycles as used by the -u-hc- extension.
+var hourCycles = map[string]byte{
+	"h11": 'K',
+	"h12": 'h',
+	"h23": 'H',
+	"h24": 'k',
+}
+
+func isTwelveHour(symbol byte) bool {
+	return symbol == 'h' || symbol == 'K'
+}
+
+// withHourCycle returns the locale set to use the given hour symbol (h, H, K, or k) for its time formats and the j, J, and C skeleton symbols.
+func withHourCycle(locale Locale, symbol byte) Locale {
+	locale.HourCycle = string(symbol)
+	locale.AllowedHours = []string{string(symbol)}
+	locale.TimeFormat = CalendarFormat{
+		Full:   setHourCycle(locale, locale.TimeFormat.Full, symbol),
+		Long:   setHourCycle(locale, locale.TimeFormat.Long, symbol),
+		Medium: setHourCycle(locale, locale.TimeFormat.Medium, symbol),
+		Short:  setHourCycle(locale, locale.TimeFormat.Short, symbol),
+	}
+	return locale
+}
+
+// setHourCycle returns the pattern with its hour field changed to the given hour symbol. The day period is removed when changing to a 24-hour cycle and added when changing to a 12-hour cycle.
+func setHourCycle(locale Locale, pattern string, symbol byte) string {
+	items := splitPattern(pattern)
+	for i, item := range items {
+		if strings.IndexByte("hHKk", item[0]) == -1 {
+			continue
+		}
+		items[i] = strings.Repeat(string(symbol), len(item))
+		if isTwelveHour(item[0]) && !isTwelveHour(symbol) {
+			items = removeDayPeriods(items)
+		} else if !isTwelveHour(item[0]) && isTwelveHour(symbol) {
+			items = addDayPeriod(locale, items)
+		}
+		return strings.Join(items, "")
+	}
+	return pattern
+}
+
+// splitPattern splits a date/time pattern into its fields and literals, where quoted literals are kept together.
+func splitPattern(pattern string) []string {
+	items := []string{}
+	for i := 0; i < len(pattern); {
+		n := 1
+		if c := pattern[i]; c == '\'' {
+			if j := strings.IndexByte(pattern[i+1:], '\''); j != -1 {
+				n = j + 2
+			} else {
+				n = len(pattern) - i
+			}
+		} else if isPatternSymbol(c) {
+			for i+n < len(pattern) && pattern[i+n] == c {
+				n++
+			}
+		} else {
+			_, n = utf8.DecodeRuneInString(pattern[i:])
+		}
+		items = append(items, pattern[i:i+n])
+		i += n
+	}
+	return items
+}
+
+func isPatternSymbol(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// removeDayPeriods removes the day period fields from the pattern items together with the whitespace that separates them from the other fields.
+func removeDayPeriods(items []string) []string {
+	for i := 0; i < len(items); i++ {
+		if strings.IndexByte("abB", items[i][0]) == -1 {
+			continue
+		}
+		start, end := i, i+1
+		if 0 < start && strings.TrimSpace(items[start-1]) == "" {
+			start--
+		} else if end < len(items) && strings.TrimSpace(items[end]) == "" {
+			end++
+		}
+		items = append(items[:start], items[end:]...)
+		i = start - 1
+	}
+	return items
+}
+
+// addDayPeriod adds a day period field to the pattern items at the position and with the separator used by the locale's "hm" format, such as "h:mm a" or "a h:mm".
+func addDayPeriod(locale Locale, items []string) []string {
+	hm := splitPattern(locale.DatetimeAvailableFormat["hm"])
+	period, hour := -1, -1
+	for i, item := range hm {
+		if item[0] == 'a' {
+			period = i
+		} else if item[0] == 'h' || item[0] == 'K' {
+			hour = i
+		}
+	}
+
+	if period != -1 && period < hour {
+		for i, item := range items {
+			if strings.IndexByte("hHKk", item[0]) != -1 {
+				dayPeriod := strings.Join(hm[period:hour], "")
+				return append(items[:i], append([]string{dayPeriod}, items[i:]...)...)
+			}
+		}
+		return items
+	}
+
+	dayPeriod := " a"
+	if period != -1 {
+		start := period
+		for 0 < start && !isPatternSymbol(hm[start-1][0]) {
+			start--
+		}
+		dayPeriod = strings.Join(hm[start:period+1], "")
+	}
+	last := len(items) - 1
+	for 0 < last && strings.IndexByte("hHKkmsSA", items[last][0]) == -1 {
+		last--
+	}
+	return append(items[:last+1], append([]string{dayPeriod}, items[last+1:]...)...)
+}
+
+// expandHourSkeleton replaces the j, J, and C symbols of a skeleton by the locale's preferred hour symbol (j and J) or the first allowed hour symbol and its day period (C). The width of the day period follows the number of symbols, such as "jjj" for a wide day period. It also returns whether the day period must be removed from the pattern, which is the case for J.
+func expandHourSkeleton(locale Locale, skeleton string) (string, bool) {
+	i := strings.IndexAny(skeleton, "jJC")
+	if i == -1 {
+		return skeleton, false
+	}
+	c := skeleton[i]
+	n := 1
+	for i+n < len(skeleton) && skeleton[i+n] == c {
+		n++
+	}
+
+	hours := locale.HourCycle
+	if c == 'C' && 0 < len(locale.AllowedHours) {
+		hours = locale.AllowedHours[0]
+	}
+	symbol, period := hours[0], hours[1:]
+	if period == "" && isTwelveHour(symbol) && c != 'J' {
+		period = "a"
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString(skeleton[:i])
+	sb.WriteString(strings.Repeat(string(symbol), 2-n%2))
+	if period != "" && (period != "a" || 2 < n) {
+		// an abbreviated 'a' is implied by the hour symbol
+		width := 1
+		if 5 <= n {
+			width = 5
+		} else if 3 <= n {
+			width = 4
+		}
+		sb.WriteString(strings.Repeat(period, width))
+	}
+	sb.WriteString(skeleton[i+n:])
+	return sb.String(), c == 'J'
 }
 
 func getDayPeriod(locale Locale, t time.Time) string {
@@ -351,6 +840,150 @@ func getDayPeriod(locale Locale, t time.Time) string {
 	return ""
 }
 
+// getEra returns the era index of the Gregorian calendar, which is 0 before and 1 from year 1 onwards.
+func getEra(t time.Time) int {
+	if t.Year() <= 0 {
+		return 0
+	}
+	return 1
+}
+
+// getEraYear returns the year within the era, such that 1 BC precedes 1 AD.
+func getEraYear(t time.Time) int {
+	if year := t.Year(); 0 < year {
+		return year
+	} else {
+		return 1 - year
+	}
+}
+
+func getQuarter(t time.Time) int {
+	return int(t.Month()-1) / 3
+}
+
+// getWeekRule returns the first day of the week and the minimal number of days in the first week of the year or month.
+func getWeekRule(locale Locale) (time.Weekday, int) {
+	return time.Weekday(locale.FirstDay), locale.MinDays
+}
+
+// getLocalWeekday returns the day of the week counted from the locale's first day of the week, starting at 1.
+func getLocalWeekday(locale Locale, t time.Time) int {
+	firstDay, _ := getWeekRule(locale)
+	return (int(t.Weekday())-int(firstDay)+7)%7 + 1
+}
+
+// firstWeekStart returns the day of the year or month, counted from zero, at which the first week starts. It is negative when the first week starts in the previous year or month.
+func firstWeekStart(wday time.Weekday, firstDay time.Weekday, minDays int) int {
+	offset := (int(wday) - int(firstDay) + 7) % 7
+	if 7-offset < minDays {
+		return 7 - offset
+	}
+	return -offset
+}
+
+// getWeekOfYear returns the week-based year and the week of the year.
+func getWeekOfYear(locale Locale, t time.Time) (int, int) {
+	firstDay, minDays := getWeekRule(locale)
+	year, yday := t.Year(), t.YearDay()-1
+	start := firstWeekStart(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Weekday(), firstDay, minDays)
+	if yday < start {
+		year--
+		yday += time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+		start = firstWeekStart(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Weekday(), firstDay, minDays)
+	} else if next := time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay() + firstWeekStart(time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC).Weekday(), firstDay, minDays); next <= yday {
+		return year + 1, 1
+	}
+	return year, (yday-start)/7 + 1
+}
+
+// getWeekOfMonth returns the week of the month, which is zero for days before the first week.
+func getWeekOfMonth(locale Locale, t time.Time) int {
+	firstDay, minDays := getWeekRule(locale)
+	start := firstWeekStart(time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC).Weekday(), firstDay, minDays)
+	if mday := t.Day() - 1; start <= mday {
+		return (mday-start)/7 + 1
+	}
+	return 0
+}
+
+// getJulianDay returns the Julian day number of the local date, where days start at local midnight instead of noon GMT.
+func getJulianDay(t time.Time) int64 {
+	year, month, day := t.Date()
+	days := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400
+	return days + 2440588
+}
+
+// appendCalendarSymbol appends the abbreviated (n=3), wide (n=4), narrow (n=5), or short (n=6) form of the symbol. Missing short and abbreviated forms fall back to the abbreviated and wide forms respectively.
+func appendCalendarSymbol(b []byte, symbol CalendarSymbol, n int) []byte {
+	switch n {
+	case 4:
+		return append(b, symbol.Wide...)
+	case 5:
+		return append(b, symbol.Narrow...)
+	case 6:
+		if symbol.Short != "" {
+			return append(b, symbol.Short...)
+		}
+	}
+	if symbol.Abbreviated == "" {
+		return append(b, symbol.Wide...)
+	}
+	return append(b, symbol.Abbreviated...)
+}
+
+// appendPadded appends the integer zero-padded to at least n digits.
+func appendPadded(b []byte, v int64, n int) []byte {
+	if v < 0 {
+		b = append(b, '-')
+		v = -v
+	}
+	for i := len(strconv.FormatInt(v, 10)); i < n; i++ {
+		b = append(b, '0')
+	}
+	return strconv.AppendInt(b, v, 10)
+}
+
+// appendFractionalSeconds appends the nanoseconds truncated to n digits.
+func appendFractionalSeconds(b []byte, nsec, n int) []byte {
+	digits := fmt.Sprintf("%09d", nsec)
+	if n <= len(digits) {
+		return append(b, digits[:n]...)
+	}
+	b = append(b, digits...)
+	for i := len(digits); i < n; i++ {
+		b = append(b, '0')
+	}
+	return b
+}
+
+// appendISOOffset appends the zone offset in seconds in the ISO 8601 format for the X and x symbols of width n. The offset is written as hours with optional minutes (n=1), hours and minutes (n=2,3), or hours, minutes, and optional seconds (n=4,5), where odd widths above one use colon separators. If utc is set, a zero offset is written as "Z".
+func appendISOOffset(b []byte, offset, n int, utc bool) []byte {
+	if utc && offset == 0 {
+		return append(b, 'Z')
+	}
+
+	sign := byte('+')
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	b = append(b, sign)
+	b = appendPadded(b, int64(offset/3600), 2)
+	if n != 1 || offset/60%60 != 0 {
+		if n == 3 || n == 5 {
+			b = append(b, ':')
+		}
+		b = appendPadded(b, int64(offset/60%60), 2)
+	}
+	if 4 <= n && offset%60 != 0 {
+		if n == 5 {
+			b = append(b, ':')
+		}
+		b = appendPadded(b, int64(offset%60), 2)
+	}
+	return b
+}
+
 func formatDatetimeItem(b []byte, pattern string, locale Locale, t time.Time) ([]byte, int, bool) {
 	switch pattern[0] {
 	case '\'':
@@ -366,40 +999,62 @@ func formatDatetimeItem(b []byte, pattern string, locale Locale, t time.Time) ([
 			n++
 		}
 
-		// TODO: does not support all patterns, missing: GYuUrQqLwWecDFgKkSAXx
 		symbol := pattern[:n]
 	TrySymbol:
 		switch symbol {
+		case "G", "GG", "GGG", "GGGG", "GGGGG":
+			b = appendCalendarSymbol(b, getCalendarDate(locale, t).eraSymbol(locale), max(len(symbol), 3))
 		case "y":
-			b = strconv.AppendInt(b, int64(t.Year()), 10)
+			b = strconv.AppendInt(b, int64(getCalendarDate(locale, t).Year), 10)
 		case "yy":
-			b = t.AppendFormat(b, "06")
-		case "yyyy":
-			b = t.AppendFormat(b, "2006")
-		case "M":
-			b = t.AppendFormat(b, "1")
-		case "MM":
-			b = t.AppendFormat(b, "01")
-		case "MMM":
-			if locale.MonthSymbol[t.Month()-1].Abbreviated == "" {
-				b = append(b, []byte(locale.MonthSymbol[t.Month()-1].Wide)...)
-			} else {
-				b = append(b, []byte(locale.MonthSymbol[t.Month()-1].Abbreviated)...)
-			}
-		case "MMMM":
-			b = append(b, []byte(locale.MonthSymbol[t.Month()-1].Wide)...)
-		case "MMMMM":
-			b = append(b, []byte(locale.MonthSymbol[t.Month()-1].Narrow)...)
-		case "E", "EE", "EEE":
-			b = append(b, []byte(locale.DaySymbol[t.Weekday()].Abbreviated)...)
-		case "EEEE":
-			b = append(b, []byte(locale.DaySymbol[t.Weekday()].Wide)...)
-		case "EEEEE":
-			b = append(b, []byte(locale.DaySymbol[t.Weekday()].Narrow)...)
+			b = appendPadded(b, int64(getCalendarDate(locale, t).Year%100), 2)
+		case "Y":
+			year, _ := getWeekOfYear(locale, t)
+			b = strconv.AppendInt(b, int64(year), 10)
+		case "YY":
+			year, _ := getWeekOfYear(locale, t)
+			b = appendPadded(b, int64(year%100), 2)
+		case "Q", "q":
+			b = strconv.AppendInt(b, int64(getQuarter(t)+1), 10)
+		case "QQ", "qq":
+			b = appendPadded(b, int64(getQuarter(t)+1), 2)
+		case "QQQ", "QQQQ", "QQQQQ":
+			b = appendCalendarSymbol(b, locale.QuarterSymbol[getQuarter(t)], len(symbol))
+		case "qqq", "qqqq", "qqqqq":
+			b = appendCalendarSymbol(b, locale.QuarterStandaloneSymbol[getQuarter(t)], len(symbol))
+		case "M", "L":
+			b = strconv.AppendInt(b, int64(getCalendarDate(locale, t).Month), 10)
+		case "MM", "LL":
+			b = appendPadded(b, int64(getCalendarDate(locale, t).Month), 2)
+		case "MMM", "MMMM", "MMMMM":
+			b = appendCalendarSymbol(b, getCalendarDate(locale, t).monthSymbol(locale, false), len(symbol))
+		case "LLL", "LLLL", "LLLLL":
+			b = appendCalendarSymbol(b, getCalendarDate(locale, t).monthSymbol(locale, true), len(symbol))
+		case "w":
+			_, week := getWeekOfYear(locale, t)
+			b = strconv.AppendInt(b, int64(week), 10)
+		case "ww":
+			_, week := getWeekOfYear(locale, t)
+			b = appendPadded(b, int64(week), 2)
+		case "W":
+			b = strconv.AppendInt(b, int64(getWeekOfMonth(locale, t)), 10)
+		case "e", "c", "cc":
+			b = strconv.AppendInt(b, int64(getLocalWeekday(locale, t)), 10)
+		case "ee":
+			b = appendPadded(b, int64(getLocalWeekday(locale, t)), 2)
+		case "E", "EE":
+			symbol = "EEE"
+			goto TrySymbol
+		case "EEE", "EEEE", "EEEEE", "EEEEEE", "eee", "eeee", "eeeee", "eeeeee":
+			b = appendCalendarSymbol(b, locale.DaySymbol[t.Weekday()], len(symbol))
+		case "ccc", "cccc", "ccccc", "cccccc":
+			b = appendCalendarSymbol(b, locale.DayStandaloneSymbol[t.Weekday()], len(symbol))
 		case "d":
-			b = t.AppendFormat(b, "2")
+			b = strconv.AppendInt(b, int64(getCalendarDate(locale, t).Day), 10)
 		case "dd":
-			b = t.AppendFormat(b, "02")
+			b = appendPadded(b, int64(getCalendarDate(locale, t).Day), 2)
+		case "F":
+			b = strconv.AppendInt(b, int64((t.Day()-1)/7+1), 10)
 		case "a", "aa", "aaa":
 			if t.Format("PM") == "PM" {
 				b = append(b, []byte(locale.DayPeriodSymbol["pm"].Abbreviated)...)
@@ -468,6 +1123,14 @@ func formatDatetimeItem(b []byte, pattern string, locale Locale, t time.Time) ([
 			b = strconv.AppendInt(b, int64(t.Hour()), 10)
 		case "HH":
 			b = t.AppendFormat(b, "15")
+		case "K":
+			b = strconv.AppendInt(b, int64(t.Hour()%12), 10)
+		case "KK":
+			b = appendPadded(b, int64(t.Hour()%12), 2)
+		case "k":
+			b = strconv.AppendInt(b, int64((t.Hour()+23)%24+1), 10)
+		case "kk":
+			b = appendPadded(b, int64((t.Hour()+23)%24+1), 2)
 		case "m":
 			b = t.AppendFormat(b, "4")
 		case "mm":
@@ -478,16 +1141,16 @@ func formatDatetimeItem(b []byte, pattern string, locale Locale, t time.Time) ([
 			b = t.AppendFormat(b, "05")
 		case "v":
 			if metazone, ok := locale.Metazones[metazones[getTimezone(locale, t)]]; ok && metazone.Generic.Short != "" {
-				b = append(b, metazone.Generic.Short...)
-			} else {
-				symbol = "O" // should try VVVV and otherwise O
+				b = appendGenericName(b, locale, t, metazone.Generic.Short)
+			} else if b, ok = appendLocationFormat(b, locale, t, false); !ok {
+				symbol = "O"
 				goto TrySymbol
 			}
 		case "vvvv":
 			if metazone, ok := locale.Metazones[metazones[getTimezone(locale, t)]]; ok && metazone.Generic.Long != "" {
-				b = append(b, metazone.Generic.Long...)
-			} else {
-				symbol = "OOOO" // should try VVVV and otherwise OOOO
+				b = appendGenericName(b, locale, t, metazone.Generic.Long)
+			} else if b, ok = appendLocationFormat(b, locale, t, false); !ok {
+				symbol = "OOOO"
 				goto TrySymbol
 			}
 		case "V":
@@ -496,14 +1159,17 @@ func formatDatetimeItem(b []byte, pattern string, locale Locale, t time.Time) ([
 		case "VV":
 			b = append(b, getTimezone(locale, t)...)
 		case "VVV":
-			if city, ok := locale.TimezoneCity[getTimezone(locale, t)]; ok {
+			if city, ok := getTimezoneCity(locale, getTimezone(locale, t)); ok {
 				b = append(b, city...)
 			} else {
 				b = append(b, locale.TimezoneCity["Etc/Unknown"]...)
 			}
 		case "VVVV":
-			symbol = "OOOO" // TODO: VVVV values don't exist in CLDR database?
-			goto TrySymbol
+			var ok bool
+			if b, ok = appendLocationFormat(b, locale, t, false); !ok {
+				symbol = "OOOO"
+				goto TrySymbol
+			}
 		case "z", "zz", "zzz":
 			if metazone, ok := locale.Metazones[metazones[getTimezone(locale, t)]]; ok && (t.IsDST() && metazone.Daylight.Short != "" || !t.IsDST() && metazone.Standard.Short != "") {
 				if t.IsDST() {
@@ -522,39 +1188,71 @@ func formatDatetimeItem(b []byte, pattern string, locale Locale, t time.Time) ([
 				} else {
 					b = append(b, metazone.Standard.Long...)
 				}
-			} else {
-				symbol = "O" // should try specific location format first, but doensn't exist in CLDR database?
+			} else if b, ok = appendLocationFormat(b, locale, t, true); !ok {
+				symbol = "OOOO"
 				goto TrySymbol
 			}
-		case "Z", "ZZ", "ZZZ", "xxxx":
-			b = t.AppendFormat(b, "-0700")
+		case "Z", "ZZ", "ZZZ":
+			symbol = "xxxx"
+			goto TrySymbol
+		case "ZZZZZ":
+			symbol = "XXXXX"
+			goto TrySymbol
+		case "X", "XX", "XXX", "XXXX", "XXXXX", "x", "xx", "xxx", "xxxx", "xxxxx":
+			_, offset := t.Zone()
+			b = appendISOOffset(b, offset, len(symbol), symbol[0] == 'X')
 		case "O":
-			if t.Location() == time.UTC {
-				b = append(b, []byte("GMT")...)
-			} else {
-				b = t.AppendFormat(b, "MST")
-			}
-			b = t.AppendFormat(b, "-7")
+			b = appendGMTFormat(b, locale, t, false)
 		case "ZZZZ", "OOOO":
-			if t.Location() == time.UTC {
-				b = append(b, []byte("GMT")...)
-			} else {
-				b = t.AppendFormat(b, "MST")
-			}
-			b = t.AppendFormat(b, "-07:00")
-		case "ZZZZZ", "XXXXX":
-			b = t.AppendFormat(b, "-07:00:00")
-			if bytes.Equal(b[len(b)-3:], []byte(":00")) {
-				b = b[:len(b)-3]
-			}
+			b = appendGMTFormat(b, locale, t, true)
 		default:
-			return b, n, false
+			// variable-width numeric fields
+			switch symbol[0] {
+			case 'y':
+				b = appendPadded(b, int64(getCalendarDate(locale, t).Year), n)
+			case 'Y':
+				// week-based years, weeks, quarters, and days of the year are Gregorian for all calendars
+				year, _ := getWeekOfYear(locale, t)
+				b = appendPadded(b, int64(year), n)
+			case 'u', 'U', 'r':
+				// cyclic year names and related years only apply to non-Gregorian calendars
+				b = appendPadded(b, int64(t.Year()), n)
+			case 'D':
+				if 3 < n {
+					return b, n, false
+				}
+				b = appendPadded(b, int64(t.YearDay()), n)
+			case 'g':
+				b = appendPadded(b, getJulianDay(t), n)
+			case 'S':
+				b = appendFractionalSeconds(b, t.Nanosecond(), n)
+			case 'A':
+				ms := int64(t.Hour()*3600+t.Minute()*60+t.Second())*1000 + int64(t.Nanosecond()/1e6)
+				b = appendPadded(b, ms, n)
+			default:
+				return b, n, false
+			}
 		}
 		return b, n, true
 	}
 	return b, 0, true
 }
 
+// fractionalSecondsLen returns the number of zeros of fractional seconds such as .000 at the start of the layout, which like for time.Format may not be followed by another digit.
+func fractionalSecondsLen(layout string) int {
+	if len(layout) < 2 || layout[0] != '.' && layout[0] != ',' {
+		return 0
+	}
+	n := 0
+	for 1+n < len(layout) && layout[1+n] == '0' {
+		n++
+	}
+	if 1+n < len(layout) && '0' <= layout[1+n] && layout[1+n] <= '9' {
+		return 0
+	}
+	return n
+}
+
 func layoutToPattern(locale Locale, layout string) string {
 	// TODO: write unknown character (literal) in single quotes
 	sb := strings.Builder{}
@@ -569,11 +1267,7 @@ func layoutToPattern(locale Locale, layout string) string {
 			sb.WriteString("yyyy")
 			i += 4
 		} else if strings.HasPrefix(layout[i:], "15") {
-			if is12Hours(locale) {
-				sb.WriteString("hh")
-			} else {
-				sb.WriteString("HH")
-			}
+			sb.WriteString(strings.Repeat(locale.HourCycle, 2))
 			i += 2
 		} else if strings.HasPrefix(layout[i:], "1") {
 			sb.WriteString("M")
@@ -639,15 +1333,15 @@ func layoutToPattern(locale Locale, layout string) string {
 			sb.WriteString("aaaaa")
 			i += 5
 		} else if strings.HasPrefix(layout[i:], "3") {
-			if is12Hours(locale) {
-				sb.WriteString("H")
+			if locale.HourCycle == "K" {
+				sb.WriteString("K")
 			} else {
 				sb.WriteString("h")
 			}
 			i += 1
 		} else if strings.HasPrefix(layout[i:], "03") {
-			if is12Hours(locale) {
-				sb.WriteString("HH")
+			if locale.HourCycle == "K" {
+				sb.WriteString("KK")
 			} else {
 				sb.WriteString("hh")
 			}
@@ -664,6 +1358,10 @@ func layoutToPattern(locale Locale, layout string) string {
 		} else if strings.HasPrefix(layout[i:], "05") {
 			sb.WriteString("ss")
 			i += 2
+		} else if n := fractionalSecondsLen(layout[i:]); n != 0 {
+			sb.WriteByte(layout[i])
+			sb.WriteString(strings.Repeat("S", n))
+			i += 1 + n
 		} else if strings.HasPrefix(layout[i:], "-0700") {
 			sb.WriteString("Z")
 			i += 5
@@ -677,11 +1375,23 @@ func layoutToPattern(locale Locale, layout string) string {
 			sb.WriteString("OOOO")
 			i += 9
 		} else if strings.HasPrefix(layout[i:], "-07:00:00") {
-			sb.WriteString("ZZZZZ")
+			sb.WriteString("xxxxx")
 			i += 9
 		} else if strings.HasPrefix(layout[i:], "-07:00") {
-			sb.WriteString("ZZZZZ")
+			sb.WriteString("xxx")
 			i += 6
+		} else if strings.HasPrefix(layout[i:], "Z07:00:00") {
+			sb.WriteString("XXXXX")
+			i += 9
+		} else if strings.HasPrefix(layout[i:], "Z07:00") {
+			sb.WriteString("XXX")
+			i += 6
+		} else if strings.HasPrefix(layout[i:], "Z0700") {
+			sb.WriteString("XX")
+			i += 5
+		} else if strings.HasPrefix(layout[i:], "Z07") {
+			sb.WriteString("X")
+			i += 3
 		} else {
 			sb.WriteByte(layout[i])
 			i += 1
@@ -694,16 +1404,16 @@ func layoutToPatterns(locale Locale, layout string) (string, string, string) {
 	idxSep := len(layout)
 	var datePattern, timePattern string
 	if strings.HasPrefix(layout, DateFull) {
-		datePattern = locale.DateFormat.Full
+		datePattern = getDateFormat(locale).Full
 		idxSep = len(DateFull)
 	} else if strings.HasPrefix(layout, DateLong) {
-		datePattern = locale.DateFormat.Long
+		datePattern = getDateFormat(locale).Long
 		idxSep = len(DateLong)
 	} else if strings.HasPrefix(layout, DateMedium) {
-		datePattern = locale.DateFormat.Medium
+		datePattern = getDateFormat(locale).Medium
 		idxSep = len(DateMedium)
 	} else if strings.HasPrefix(layout, DateShort) {
-		datePattern = locale.DateFormat.Short
+		datePattern = getDateFormat(locale).Short
 		idxSep = len(DateShort)
 	} else {
 		pattern := layoutToPattern(locale, layout)
@@ -924,7 +1634,7 @@ func (f TimezoneFormatter) Format(state fmt.State, verb rune) {
 
 	var b []byte
 	b, _, _ = formatDatetimeItem(b, pattern, locale, time.Now().In(f.Location))
-	state.Write(b)
+	writeParts(state, locale, b, nil)
 }
 
 // from https://github.com/arp242/tz/blob/3c7bf612261228ea207792aef3a725c2fec518c6/alias.go
@@ -2,10 +2,12 @@ package locale
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/tdewolff/test"
+	"golang.org/x/text/language"
 )
 
 func TestScanTime(t *testing.T) {
@@ -83,3 +85,217 @@ func TestIntervalFormatter(t *testing.T) {
 		})
 	}
 }
+
+func TestSkeletonFormatter(t *testing.T) {
+	tm := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		p        *Printer
+		skeleton string
+		s        string
+	}{
+		{en, "yMMM", "Jan 2025"},
+		{en, "yMMMd", "Jan 2, 2025"},
+		{en, "yMMMMd", "January 2, 2025"},
+		{en, "MMMMEEEEd", "Thursday, January 2"},
+		{en, "yMd", "1/2/2025"},
+		{en, "hm", "3:04\u202FPM"},
+		{en, "Hms", "15:04:05"},
+		{en, "yMMMdhm", "Jan 2, 2025, 3:04\u202FPM"},
+		{es, "yMMM", "ene 2025"},
+		{es, "yMMMd", "2 ene 2025"},
+		{es, "yMMMMd", "2 de enero de 2025"},
+		{es, "Hm", "15:04"},
+		{nl, "yMMMd", "2 jan 2025"},
+		{nl, "MMMEd", "do 2 jan"},
+		{nl, "yMMMdHm", "2 jan 2025, 15:04"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.p.LanguageTag, "_", tt.skeleton), func(t *testing.T) {
+			test.T(t, tt.p.Sprintf("%v", SkeletonFormatter{tm, tt.skeleton}), tt.s)
+		})
+	}
+}
+
+func TestHourCycle(t *testing.T) {
+	tm := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	midnight := time.Date(2025, 1, 2, 0, 30, 0, 0, time.UTC)
+	tests := []struct {
+		tag    string
+		t      time.Time
+		layout string
+		s      string
+	}{
+		{"en", tm, "jm", "3:04\u202FPM"},
+		{"en", tm, "Jm", "3:04"},
+		{"en", tm, "jjjjm", "3:04 PM"},
+		{"en-GB", tm, "jm", "15:04"},
+		{"en-GB", tm, "Cm", "15:04"},
+		{"en-TW", tm, "Cm", "3:04 in the afternoon"},
+		{"nl", tm, "jms", "15:04:05"},
+		{"es-MX", tm, "jm", "3:04 p.m."},
+		{"en-u-hc-h23", tm, "jm", "15:04"},
+		{"en-u-hc-h23", tm, TimeMedium, "15:04:05"},
+		{"en-u-hc-h11", midnight, TimeShort, "0:30\u202FAM"},
+		{"nl-u-hc-h12", tm, TimeShort, "03:04 p.m."},
+		{"nl-u-hc-h24", midnight, TimeShort, "24:30"},
+		{"es-u-hc-h12", tm, TimeMedium, "3:04:05 p.\u202Fm."},
+		{"en-GB", tm, "15.04", "15.04"},
+		{"en-u-hc-h11", tm, "03.04", "03.04"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag+"_"+tt.layout, func(t *testing.T) {
+			p := NewPrinter(language.MustParse(tt.tag), time.UTC)
+			if strings.IndexAny(tt.layout, "jJC") != -1 {
+				test.T(t, p.Sprintf("%v", SkeletonFormatter{tt.t, tt.layout}), tt.s)
+			} else {
+				test.T(t, p.T(tt.t, tt.layout), tt.s)
+			}
+		})
+	}
+
+	p := NewPrinter(language.MustParse("en-US"), time.UTC)
+	test.Error(t, p.SetHourCycle("h23"))
+	test.T(t, p.LanguageTag.String(), "en-US-u-hc-h23")
+	test.T(t, p.T(tm, DateShort+" "+TimeShort), "1/2/25, 15:04")
+	test.That(t, p.SetHourCycle("h13") != nil)
+}
+
+func TestFormatTimeSymbols(t *testing.T) {
+	tm := time.Date(2025, 1, 2, 15, 4, 5, 123456789, tzPST)
+	tests := []struct {
+		locale  string
+		t       time.Time
+		pattern string
+		s       string
+	}{
+		{"en", tm, "G GGGG GGGGG", "AD Anno Domini A"},
+		{"en", tm, "y yy yyyyy u uuuuu", "2025 25 02025 2025 02025"},
+		{"en", time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), "G y u", "BC 44 -43"},
+		{"en", tm, "Q QQ QQQ QQQQ QQQQQ q", "1 01 Q1 1st quarter 1 1"},
+		{"en", tm, "L LL LLL LLLL LLLLL", "1 01 Jan January J"},
+		{"en", tm, "Y w ww W", "2025 1 01 1"},
+		{"en", time.Date(2008, 12, 29, 0, 0, 0, 0, time.UTC), "Y-'W'ww-e", "2009-W01-2"},
+		{"en", time.Date(2010, 1, 3, 0, 0, 0, 0, time.UTC), "Y-'W'ww-e", "2010-W02-1"},
+		{"en", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), "W", "1"},
+		{"nl", time.Date(2008, 12, 29, 0, 0, 0, 0, time.UTC), "Y-'W'ww-e", "2009-W01-1"},
+		{"nl", time.Date(2010, 1, 3, 0, 0, 0, 0, time.UTC), "Y-'W'ww-e", "2009-W53-7"},
+		{"nl", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), "W", "0"},
+		{"en", tm, "e ee eee eeee eeeee c cccc", "5 05 Thu Thursday T 5 Thursday"},
+		{"nl", tm, "e c", "4 4"},
+		{"en", tm, "E EEEEEE eeeeee cccccc ccccc", "Thu Th Th Th T"},
+		{"es_CL", tm, "EEEEEE LLLL qqqq", "ju enero 1.er trimestre"},
+		{"en", tm, "D DDD F g", "2 002 1 2460678"},
+		{"en", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), "D F", "365 5"},
+		{"en", tm, "K KK k kk", "3 03 15 15"},
+		{"en", time.Date(2025, 1, 2, 0, 30, 0, 0, time.UTC), "K k kk", "0 24 24"},
+		{"en", tm, "S SSS SSSSSSSSSS A", "1 123 1234567890 54245123"},
+		{"en", tm, "X XX XXX XXXX XXXXX", "-08 -0800 -08:00 -0800 -08:00"},
+		{"en", tm, "x xx xxx xxxx xxxxx Z ZZZZZ", "-08 -0800 -08:00 -0800 -08:00 -0800 -08:00"},
+		{"en", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), "X XXX x xxx ZZZZZ", "Z Z +00 +00:00 Z"},
+		{"en", time.Date(2025, 1, 2, 0, 0, 0, 0, time.FixedZone("", 5*3600+1800)), "X x", "+0530 +0530"},
+		{"en", tm, "O OOOO ZZZZ", "GMT-8 GMT-08:00 GMT-08:00"},
+		{"en", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), "O OOOO", "GMT GMT"},
+		{"en", time.Date(2025, 1, 2, 0, 0, 0, 0, time.FixedZone("", 5*3600+1800)), "O OOOO", "GMT+5:30 GMT+05:30"},
+		{"en", time.Date(2025, 1, 2, 0, 0, 0, 0, time.FixedZone("Etc/GMT-3", 3*3600)), "v VVVV", "GMT+3 GMT+03:00"},
+		{"en", time.Date(2025, 7, 2, 0, 0, 0, 0, time.FixedZone("America/Phoenix", -7*3600)), "vvvv VVV VVVV", "Mountain Time (Phoenix) Phoenix Phoenix Time"},
+		{"en", time.Date(2025, 1, 2, 0, 0, 0, 0, time.FixedZone("Europe/Amsterdam", 3600)), "vvvv VVVV", "Central European Time Netherlands Time"},
+		{"es", time.Date(2025, 1, 2, 0, 0, 0, 0, time.FixedZone("America/Buenos_Aires", -3*3600)), "vvvv", "hora de Argentina"},
+		{"es", time.Date(2025, 1, 2, 0, 0, 0, 0, time.FixedZone("America/Argentina/Buenos_Aires", -3*3600)), "vvvv VVVV", "hora de Argentina hora de Buenos Aires"},
+		{"es", time.Date(2025, 1, 2, 0, 0, 0, 0, time.FixedZone("Africa/Casablanca", 3600)), "vvvv zzzz", "hora de Marruecos horario estándar de Marruecos"},
+		{"nl", time.Date(2025, 1, 2, 0, 0, 0, 0, time.FixedZone("Europe/Amsterdam", 3600)), "VVVV", "Nederland-tijd"},
+		{"es", tm, "GGGG QQQQ", "después de Cristo 1.er trimestre"},
+		{"nl", tm, "G QQQ", "n.Chr. K1"},
+		{"nl", tm, "EEEEEE cccc LLL", "do donderdag jan"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale+"_"+tt.pattern, func(t *testing.T) {
+			b, _ := formatTime(nil, nil, tt.pattern, locales[tt.locale], tt.t)
+			test.String(t, string(b), tt.s)
+		})
+	}
+}
+
+func TestFormatTimeSymbolsReference(t *testing.T) {
+	// reference vectors formatted by ICU 73 (CLDR 43) with a proleptic Gregorian calendar
+	tests := []struct {
+		tag     string
+		t       time.Time
+		pattern string
+		s       string
+	}{
+		{"en-US", time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), "G y yy yyy yyyy u uuuu r", "BC 44 44 044 0044 -43 -0043 -43"},
+		{"nl-NL", time.Date(-43, 3, 15, 0, 0, 0, 0, time.UTC), "GGGG y", "voor Christus 44"},
+		{"en-US", time.Date(0, 12, 31, 0, 0, 0, 0, time.UTC), "G y yy yyy yyyy u uuuu r", "BC 1 01 001 0001 0 0000 0"},
+		{"nl-NL", time.Date(0, 12, 31, 0, 0, 0, 0, time.UTC), "GGGG y", "voor Christus 1"},
+		{"en-US", time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), "G y yy yyy yyyy u uuuu r", "AD 1 01 001 0001 1 0001 1"},
+		{"nl-NL", time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), "GGGG y", "na Christus 1"},
+		{"en-US", time.Date(999, 6, 1, 0, 0, 0, 0, time.UTC), "G y yy yyy yyyy u uuuu r", "AD 999 99 999 0999 999 0999 999"},
+		{"nl-NL", time.Date(999, 6, 1, 0, 0, 0, 0, time.UTC), "GGGG y", "na Christus 999"},
+		{"en-US", time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), "G y yy yyy yyyy u uuuu r", "AD 9999 99 9999 9999 9999 9999 9999"},
+		{"nl-NL", time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), "GGGG y", "na Christus 9999"},
+		{"en-US", time.Date(2008, 12, 28, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2009 09 2009 1 01 5 1"},
+		{"nl-NL", time.Date(2008, 12, 28, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2008 08 2008 52 52 4 7"},
+		{"en-US", time.Date(2008, 12, 29, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2009 09 2009 1 01 5 2"},
+		{"nl-NL", time.Date(2008, 12, 29, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2009 09 2009 1 01 5 1"},
+		{"en-US", time.Date(2010, 1, 3, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2010 10 2010 2 02 2 1"},
+		{"nl-NL", time.Date(2010, 1, 3, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2009 09 2009 53 53 0 7"},
+		{"en-US", time.Date(2010, 1, 4, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2010 10 2010 2 02 2 2"},
+		{"nl-NL", time.Date(2010, 1, 4, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2010 10 2010 1 01 1 1"},
+		{"en-US", time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2021 21 2021 1 01 5 5"},
+		{"nl-NL", time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2020 20 2020 53 53 5 4"},
+		{"en-US", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2021 21 2021 1 01 1 6"},
+		{"nl-NL", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2020 20 2020 53 53 0 5"},
+		{"en-US", time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2021 21 2021 2 02 2 1"},
+		{"nl-NL", time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2020 20 2020 53 53 0 7"},
+		{"en-US", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2022 22 2022 1 01 1 7"},
+		{"nl-NL", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2021 21 2021 52 52 0 6"},
+		{"en-US", time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2027 27 2027 1 01 5 5"},
+		{"nl-NL", time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2026 26 2026 53 53 5 4"},
+		{"en-US", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2027 27 2027 1 01 1 6"},
+		{"nl-NL", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), "Y YY YYYY w ww W e", "2026 26 2026 53 53 0 5"},
+		{"en-US", time.Date(-4713, 11, 24, 0, 0, 0, 0, time.UTC), "g ggggggg", "0 0000000"},
+		{"en-US", time.Date(1858, 11, 17, 0, 0, 0, 0, time.UTC), "g ggggggg", "2400001 2400001"},
+		{"en-US", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), "g ggggggg", "2440588 2440588"},
+		{"en-US", time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "g ggggggg", "2451545 2451545"},
+		{"en-US", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), "g ggggggg", "2460678 2460678"},
+		{"en-US", time.Date(1970, 1, 1, 23, 59, 0, 0, time.FixedZone("", -5*3600)), "g", "2440588"},
+		{"en-US", time.Date(1970, 1, 1, 0, 30, 0, 0, time.FixedZone("", 5*3600)), "g", "2440588"},
+		{"en-US", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), "A AAAAAAAA", "0 00000000"},
+		{"en-US", time.Date(2025, 1, 2, 0, 0, 0, 1000000, time.UTC), "A AAAAAAAA", "1 00000001"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC), "A AAAAAAAA", "43200000 43200000"},
+		{"en-US", time.Date(2025, 1, 2, 23, 59, 59, 999000000, time.UTC), "A AAAAAAAA", "86399999 86399999"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC), "X XX XXX XXXX XXXXX", "Z Z Z Z Z"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC), "x xx xxx xxxx xxxxx", "+00 +0000 +00:00 +0000 +00:00"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", 3600)), "X XX XXX XXXX XXXXX", "+01 +0100 +01:00 +0100 +01:00"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", 3600)), "x xx xxx xxxx xxxxx", "+01 +0100 +01:00 +0100 +01:00"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", -3*3600-1800)), "X XX XXX XXXX XXXXX", "-0330 -0330 -03:30 -0330 -03:30"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", -3*3600-1800)), "x xx xxx xxxx xxxxx", "-0330 -0330 -03:30 -0330 -03:30"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", 5*3600+2700)), "X XX XXX XXXX XXXXX", "+0545 +0545 +05:45 +0545 +05:45"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", 5*3600+2700)), "x xx xxx xxxx xxxxx", "+0545 +0545 +05:45 +0545 +05:45"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", 13*3600)), "X XX XXX XXXX XXXXX", "+13 +1300 +13:00 +1300 +13:00"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", 13*3600)), "x xx xxx xxxx xxxxx", "+13 +1300 +13:00 +1300 +13:00"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", -1800)), "X XX XXX XXXX XXXXX", "-0030 -0030 -00:30 -0030 -00:30"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", -1800)), "x xx xxx xxxx xxxxx", "-0030 -0030 -00:30 -0030 -00:30"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", -12*3600)), "X XX XXX XXXX XXXXX", "-12 -1200 -12:00 -1200 -12:00"},
+		{"en-US", time.Date(2025, 1, 2, 12, 0, 0, 0, time.FixedZone("", -12*3600)), "x xx xxx xxxx xxxxx", "-12 -1200 -12:00 -1200 -12:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag+"_"+tt.t.Format(time.RFC3339)+"_"+tt.pattern, func(t *testing.T) {
+			b, _ := formatTime(nil, nil, tt.pattern, GetLocale(language.MustParse(tt.tag)), tt.t)
+			test.String(t, string(b), tt.s)
+		})
+	}
+}
+
+func TestFormatTimeStandalone(t *testing.T) {
+	// stand-alone forms are nominative and format forms genitive in for example Russian
+	locale := locales["root"]
+	locale.MonthSymbol[0].Wide = "января"
+	locale.MonthStandaloneSymbol[0].Wide = "январь"
+
+	tm := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	b, _ := formatTime(nil, nil, "d MMMM", locale, tm)
+	test.String(t, string(b), "2 января")
+	b, _ = formatTime(nil, nil, "LLLL", locale, tm)
+	test.String(t, string(b), "январь")
+}
@@ -52,9 +52,11 @@ func (f DecimalFormatter) Format(state fmt.State, verb rune) {
 	}
 
 	var b []byte
+	var spans partSpans
 	num := f.Num
 	if num < 0.0 {
 		b = append(b, '-')
+		spans = spans.add(PartMinusSign, len(b))
 		num = -num
 	}
 	for i := 0; i < len(pattern); {
@@ -84,7 +86,9 @@ func (f DecimalFormatter) Format(state fmt.State, verb rune) {
 				groupSize = decimal - group - 1
 			}
 			amount := roundToInt64(num, dec)
+			start := len(b)
 			b = strconv.AppendNumber(b, amount, dec, groupSize, locale.GroupSymbol, locale.DecimalSymbol)
+			spans = spans.addNumber(b, start, locale.GroupSymbol, locale.DecimalSymbol)
 			i = j - 1
 		case ' ':
 			b = utf8.AppendRune(b, '\u00A0') // non-breaking space
@@ -112,12 +116,15 @@ func (f DecimalFormatter) Format(state fmt.State, verb rune) {
 					i -= n // decimal symbol
 				}
 				b = b[:i+1]
+				spans = spans.truncate(len(b))
 				break
 			}
 		}
 	}
 	if exp != 0 || verb == 'e' || verb == 'E' {
+		spans = spans.add(PartLiteral, len(b))
 		b = append(b, fmt.Sprintf("\u00A0×\u00A010")...)
+		spans = spans.add(PartExponentSeparator, len(b))
 		for _, c := range fmt.Sprintf("%d", exp) {
 			switch c {
 			case '0':
@@ -142,7 +149,9 @@ func (f DecimalFormatter) Format(state fmt.State, verb rune) {
 				b = append(b, "⁹"...)
 			case '-':
 				b = append(b, "⁻"...)
+				spans = spans.add(PartExponentMinusSign, len(b))
 			}
+			spans = spans.add(PartExponentInteger, len(b))
 		}
 	}
 	if width, ok := state.Width(); ok && len(b) < width {
@@ -156,5 +165,5 @@ func (f DecimalFormatter) Format(state fmt.State, verb rune) {
 		}
 		state.Write(pad)
 	}
-	state.Write(b)
+	writeParts(state, locale, b, spans)
 }
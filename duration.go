@@ -2,11 +2,15 @@ package locale
 
 import (
 	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"log"
 	"math"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	parseStrconv "github.com/tdewolff/parse/v2/strconv"
 )
@@ -171,6 +175,161 @@ func (d Duration) Value() (driver.Value, error) {
 	return int64(math.Round(time.Duration(d).Seconds())), nil
 }
 
+// ISO8601 formats the duration in the ISO 8601 format using hours, minutes and (fractional) seconds, such as "PT1H2M3.5S". Negative durations are prefixed by a minus sign and the zero duration is "PT0S".
+func (d Duration) ISO8601() string {
+	b := []byte{}
+	u := uint64(d)
+	if d < 0 {
+		b = append(b, '-')
+		u = -u
+	}
+	b = append(b, 'P', 'T')
+	if u == 0 {
+		return string(append(b, '0', 'S'))
+	}
+
+	hours := u / uint64(time.Hour)
+	u -= hours * uint64(time.Hour)
+	minutes := u / uint64(time.Minute)
+	u -= minutes * uint64(time.Minute)
+	seconds := u / uint64(time.Second)
+	fseconds := u - seconds*uint64(time.Second)
+	if hours != 0 {
+		b = strconv.AppendUint(b, hours, 10)
+		b = append(b, 'H')
+	}
+	if minutes != 0 {
+		b = strconv.AppendUint(b, minutes, 10)
+		b = append(b, 'M')
+	}
+	if seconds != 0 || fseconds != 0 {
+		b = strconv.AppendUint(b, seconds, 10)
+		if fseconds != 0 {
+			n := 9
+			for fseconds%10 == 0 {
+				fseconds /= 10
+				n--
+			}
+			b = append(b, '.')
+			b = append(b, fmt.Sprintf("%0*d", n, fseconds)...)
+		}
+		b = append(b, 'S')
+	}
+	return string(b)
+}
+
+// ParseISO8601 parses a duration in the ISO 8601 format as returned by ISO8601. Only the hours, minutes and seconds components are supported since days, weeks, months, and years do not have a fixed duration. Only the seconds may have a fraction of at most nine digits. Parsing is strict so that only the form returned by ISO8601 is accepted, without leading zeros, trailing zeros in the fraction, zero components, or a negative zero.
+func ParseISO8601(s string) (Duration, error) {
+	b := []byte(s)
+	neg := false
+	if 0 < len(b) && b[0] == '-' {
+		neg = true
+		b = b[1:]
+	}
+	if len(b) < 4 || b[0] != 'P' || b[1] != 'T' {
+		return 0, fmt.Errorf("invalid duration: %v", s)
+	}
+	b = b[2:]
+
+	var u uint64
+	limit := uint64(math.MaxInt64)
+	if neg {
+		limit++ // allow math.MinInt64
+	}
+	designators := "HMS"
+	scales := [...]uint64{uint64(time.Hour), uint64(time.Minute), uint64(time.Second)}
+	for 0 < len(b) {
+		v, n := parseStrconv.ParseUint(b)
+		if n == 0 || 1 < n && b[0] == '0' {
+			return 0, fmt.Errorf("invalid duration: %v", s)
+		}
+		b = b[n:]
+
+		var fraction uint64
+		hasFraction := 0 < len(b) && b[0] == '.'
+		if hasFraction {
+			b = b[1:]
+			fraction, n = parseStrconv.ParseUint(b)
+			if n == 0 || 9 < n || b[n-1] == '0' {
+				return 0, fmt.Errorf("invalid duration: %v", s)
+			}
+			fraction *= uint64(int64Scales[9-n])
+			b = b[n:]
+		}
+
+		i := -1
+		if 0 < len(b) {
+			i = strings.IndexByte(designators, b[0])
+		}
+		if i == -1 || hasFraction && designators[i] != 'S' {
+			return 0, fmt.Errorf("invalid duration: %v", s)
+		} else if v == 0 && !hasFraction && (neg || s != "PT0S") {
+			// zero components are omitted, except for the zero duration
+			return 0, fmt.Errorf("invalid duration: %v", s)
+		}
+		designators = designators[i+1:]
+		scale := scales[len(scales)-len(designators)-1]
+		b = b[1:]
+
+		if (limit-u)/scale < v {
+			return 0, fmt.Errorf("invalid duration: %v", ErrOverflow)
+		}
+		u += v * scale
+		if limit-u < fraction {
+			return 0, fmt.Errorf("invalid duration: %v", ErrOverflow)
+		}
+		u += fraction
+	}
+	if neg {
+		return Duration(-u), nil
+	}
+	return Duration(u), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface using the ISO 8601 format.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.ISO8601()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface using the ISO 8601 format.
+func (d *Duration) UnmarshalText(b []byte) error {
+	v, err := ParseISO8601(string(b))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface using an ISO 8601 string, such as "PT1H30M".
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.ISO8601())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface using an ISO 8601 string.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("invalid duration: %v", err)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface using the number of nanoseconds as a varint.
+func (d Duration) MarshalBinary() ([]byte, error) {
+	return binary.AppendVarint(nil, int64(d)), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (d *Duration) UnmarshalBinary(b []byte) error {
+	v, n := binary.Varint(b)
+	if n <= 0 || n != len(b) {
+		return fmt.Errorf("invalid duration: bad varint")
+	}
+	*d = Duration(v)
+	return nil
+}
+
 // Available duration layouts
 const (
 	DurationLong    string = "second"
@@ -192,11 +351,13 @@ func (f DurationFormatter) Format(state fmt.State, verb rune) {
 	}
 
 	var b []byte
+	var spans partSpans
 	if f.Duration == 0 {
 		log.Printf("INFO: locale: unsupported zero duration\n")
 		return
 	} else if f.Duration < 0 {
 		b = append(b, '-')
+		spans = spans.add(PartMinusSign, len(b))
 		f.Duration = -f.Duration
 	}
 
@@ -204,19 +365,33 @@ func (f DurationFormatter) Format(state fmt.State, verb rune) {
 	case DurationTime:
 		hours := int64(f.Duration.Hours())
 		minutes := int64(f.Duration.Minutes()) - hours*60
-		b = append(b, fmt.Sprintf("%02d:%02d", hours, minutes)...)
-		state.Write(b)
+		b = append(b, fmt.Sprintf("%02d", hours)...)
+		spans = spans.add(PartHour, len(b))
+		b = append(b, ':')
+		spans = spans.add(PartLiteral, len(b))
+		b = append(b, fmt.Sprintf("%02d", minutes)...)
+		spans = spans.add(PartMinute, len(b))
+		writeParts(state, locale, b, spans)
 		return
 	case DurationDigital:
 		hours := int64(f.Duration.Hours())
 		minutes := int64(f.Duration.Minutes()) - hours*60
 		seconds := int64(f.Duration.Seconds()) - hours*3600 - minutes*60
 		if 0 < hours {
-			b = append(b, fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)...)
+			b = append(b, fmt.Sprintf("%d", hours)...)
+			spans = spans.add(PartHour, len(b))
+			b = append(b, ':')
+			spans = spans.add(PartLiteral, len(b))
+			b = append(b, fmt.Sprintf("%02d", minutes)...)
 		} else {
-			b = append(b, fmt.Sprintf("%d:%02d", minutes, seconds)...)
-		}
-		state.Write(b)
+			b = append(b, fmt.Sprintf("%d", minutes)...)
+		}
+		spans = spans.add(PartMinute, len(b))
+		b = append(b, ':')
+		spans = spans.add(PartLiteral, len(b))
+		b = append(b, fmt.Sprintf("%02d", seconds)...)
+		spans = spans.add(PartSecond, len(b))
+		writeParts(state, locale, b, spans)
 		return
 	}
 
@@ -265,11 +440,10 @@ func (f DurationFormatter) Format(state fmt.State, verb rune) {
 				if n == 1 && count.One != "" {
 					pattern = count.One
 				}
-				pattern = strings.ReplaceAll(pattern, "{0}", fmt.Sprintf("%d", n))
 				if written {
 					b = append(b, ' ')
 				}
-				b = append(b, []byte(pattern)...)
+				b, spans = appendUnitPattern(b, spans, pattern, int64(n))
 				if approximate {
 					break
 				}
@@ -278,7 +452,31 @@ func (f DurationFormatter) Format(state fmt.State, verb rune) {
 			}
 		}
 	}
-	state.Write(b)
+	writeParts(state, locale, b, spans)
+}
+
+// appendUnitPattern appends a unit pattern such as "{0} hours", marking the number as integer and the text around it as unit.
+func appendUnitPattern(b []byte, spans partSpans, pattern string, n int64) ([]byte, partSpans) {
+	appendUnit := func(text string) {
+		unit := strings.TrimFunc(text, unicode.IsSpace)
+		i := strings.Index(text, unit)
+		b = append(b, text[:i]...)
+		spans = spans.add(PartLiteral, len(b))
+		b = append(b, unit...)
+		spans = spans.add(PartUnit, len(b))
+		b = append(b, text[i+len(unit):]...)
+		spans = spans.add(PartLiteral, len(b))
+	}
+
+	spans = spans.add(PartLiteral, len(b))
+	if i := strings.Index(pattern, "{0}"); i != -1 {
+		appendUnit(pattern[:i])
+		b = strconv.AppendInt(b, n, 10)
+		spans = spans.add(PartInteger, len(b))
+		pattern = pattern[i+3:]
+	}
+	appendUnit(pattern)
+	return b, spans
 }
 
 type DurationIntervalFormatter struct {
@@ -292,10 +490,13 @@ func (f DurationIntervalFormatter) Format(state fmt.State, verb rune) {
 	if languager, ok := state.(Languager); ok {
 		locale = GetLocale(languager.Language())
 	}
+	f.Time = inTimezone(locale, f.Time)
 
 	var b []byte
+	var spans partSpans
 	if f.Duration < 0 {
 		b = append(b, '-')
+		spans = spans.add(PartMinusSign, len(b))
 		f.Duration = -f.Duration
 	}
 
@@ -335,11 +536,10 @@ func (f DurationIntervalFormatter) Format(state fmt.State, verb rune) {
 		if n == 1 {
 			pattern = count.One
 		}
-		pattern = strings.ReplaceAll(pattern, "{0}", fmt.Sprintf("%d", n))
 		if 1 < len(b) {
 			b = append(b, ' ')
 		}
-		b = append(b, []byte(pattern)...)
+		b, spans = appendUnitPattern(b, spans, pattern, int64(n))
 	}
 
 	start, end := f.Time, f.Time.Add(f.Duration)
@@ -447,6 +647,6 @@ func (f DurationIntervalFormatter) Format(state fmt.State, verb rune) {
 			}
 		}
 	}
-	state.Write(b)
+	writeParts(state, locale, b, spans)
 	return
 }
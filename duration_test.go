@@ -1,6 +1,8 @@
 package locale
 
 import (
+	"encoding/json"
+	"math"
 	"testing"
 	"time"
 
@@ -57,3 +59,46 @@ func TestDurationIntervalFormatter(t *testing.T) {
 		})
 	}
 }
+
+func TestDurationMarshal(t *testing.T) {
+	tests := []struct {
+		d   time.Duration
+		str string
+	}{
+		{0, "PT0S"},
+		{5 * time.Second, "PT5S"},
+		{90 * time.Minute, "PT1H30M"},
+		{26*time.Hour + 3*time.Second + 500*time.Millisecond, "PT26H3.5S"},
+		{-time.Nanosecond, "-PT0.000000001S"},
+		{math.MinInt64, "-PT2562047H47M16.854775808S"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.str, func(t *testing.T) {
+			text, err := Duration(tt.d).MarshalText()
+			test.Error(t, err)
+			test.T(t, string(text), tt.str)
+
+			var d Duration
+			test.Error(t, d.UnmarshalText(text))
+			test.T(t, d, Duration(tt.d))
+
+			b, err := json.Marshal(Duration(tt.d))
+			test.Error(t, err)
+			test.T(t, string(b), `"`+tt.str+`"`)
+			test.Error(t, json.Unmarshal(b, &d))
+			test.T(t, d, Duration(tt.d))
+
+			b, err = Duration(tt.d).MarshalBinary()
+			test.Error(t, err)
+			test.Error(t, d.UnmarshalBinary(b))
+			test.T(t, d, Duration(tt.d))
+		})
+	}
+
+	for _, s := range []string{"", "PT", "P1D", "PT1.5H", "PT1S1M", "PT1H1H", "PT0.0000000001S", "PT-5S", "PT5", "1h", "PT2562048H", "PT1.0H", "PT1.5M", "PT1.50S", "PT0.0S", "PT01H", "PT00S", "PT1H0M", "-PT0S"} {
+		t.Run(s, func(t *testing.T) {
+			var d Duration
+			test.That(t, d.UnmarshalText([]byte(s)) != nil, "must return error")
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package locale
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/text/currency"
+)
+
+var ErrNoExchangeRate = fmt.Errorf("no exchange rate")
+
+// ExchangeRates provides exchange rates between currencies.
+type ExchangeRates interface {
+	// Rate returns the exchange rate such that 1 unit of from equals rate units of to.
+	Rate(from, to currency.Unit) (*big.Rat, error)
+}
+
+// MemoryExchangeRates holds exchange rates in memory relative to a base currency, so that 1 unit of Base equals Rates[unit] units of unit. Rates between two non-base currencies are calculated as cross rates through the base currency.
+type MemoryExchangeRates struct {
+	Base  currency.Unit
+	Date  time.Time
+	Rates map[currency.Unit]*big.Rat
+}
+
+func NewMemoryExchangeRates(base currency.Unit) *MemoryExchangeRates {
+	return &MemoryExchangeRates{
+		Base:  base,
+		Rates: map[currency.Unit]*big.Rat{},
+	}
+}
+
+// Set sets the exchange rate from the base currency to unit, given as a decimal string such as "1.0876".
+func (r *MemoryExchangeRates) Set(unit currency.Unit, rate string) error {
+	v, ok := new(big.Rat).SetString(rate)
+	if !ok {
+		return fmt.Errorf("invalid exchange rate: %v", rate)
+	} else if v.Sign() <= 0 {
+		return fmt.Errorf("exchange rate must be positive: %v", rate)
+	}
+	r.Rates[unit] = v
+	return nil
+}
+
+func (r *MemoryExchangeRates) rate(unit currency.Unit) (*big.Rat, error) {
+	if unit == r.Base {
+		return big.NewRat(1, 1), nil
+	} else if rate, ok := r.Rates[unit]; ok && rate.Sign() != 0 {
+		return rate, nil
+	}
+	return nil, fmt.Errorf("%w: %v to %v", ErrNoExchangeRate, r.Base, unit)
+}
+
+// Rate implements the ExchangeRates interface.
+func (r *MemoryExchangeRates) Rate(from, to currency.Unit) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+	fromRate, err := r.rate(from)
+	if err != nil {
+		return nil, err
+	}
+	toRate, err := r.rate(to)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Rat).Quo(toRate, fromRate), nil
+}
+
+// LoadECBExchangeRates loads the euro foreign exchange reference rates of the European Central Bank from a local eurofxref XML or CSV file. For historic files only the most recent rates are loaded.
+func LoadECBExchangeRates(filename string) (*MemoryExchangeRates, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".xml":
+		return ParseECBExchangeRatesXML(f)
+	case ".csv":
+		return ParseECBExchangeRatesCSV(f)
+	}
+	return nil, fmt.Errorf("unsupported exchange rate file: %v", filename)
+}
+
+// ParseECBExchangeRatesXML parses the eurofxref XML format of the European Central Bank.
+func ParseECBExchangeRatesXML(r io.Reader) (*MemoryExchangeRates, error) {
+	var envelope struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube>Cube"`
+	}
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, fmt.Errorf("invalid exchange rates: %v", err)
+	} else if len(envelope.Days) == 0 {
+		return nil, fmt.Errorf("invalid exchange rates: no rates found")
+	}
+
+	// days are ordered from most recent to oldest
+	day := envelope.Days[0]
+	date, err := time.Parse("2006-01-02", day.Time)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exchange rates: %v", err)
+	}
+
+	rates := NewMemoryExchangeRates(currency.EUR)
+	rates.Date = date
+	for _, rate := range day.Rates {
+		unit, err := currency.ParseISO(rate.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rates: %v", err)
+		} else if err := rates.Set(unit, rate.Rate); err != nil {
+			return nil, err
+		}
+	}
+	return rates, nil
+}
+
+// ParseECBExchangeRatesCSV parses the eurofxref CSV format of the European Central Bank.
+func ParseECBExchangeRatesCSV(r io.Reader) (*MemoryExchangeRates, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid exchange rates: %v", err)
+	}
+	record, err := cr.Read() // most recent day
+	if err != nil {
+		return nil, fmt.Errorf("invalid exchange rates: %v", err)
+	} else if len(header) == 0 || header[0] != "Date" || len(record) != len(header) {
+		return nil, fmt.Errorf("invalid exchange rates: unexpected header")
+	}
+
+	date, err := time.Parse("02 January 2006", record[0])
+	if err != nil {
+		if date, err = time.Parse("2006-01-02", record[0]); err != nil {
+			return nil, fmt.Errorf("invalid exchange rates: %v", err)
+		}
+	}
+
+	rates := NewMemoryExchangeRates(currency.EUR)
+	rates.Date = date
+	for i := 1; i < len(header); i++ {
+		if header[i] == "" || record[i] == "" || record[i] == "N/A" {
+			continue
+		}
+		unit, err := currency.ParseISO(header[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid exchange rates: %v", err)
+		} else if err := rates.Set(unit, record[i]); err != nil {
+			return nil, err
+		}
+	}
+	return rates, nil
+}
+
+func (a Amount) MustConvert(to currency.Unit, rates ExchangeRates) Amount {
+	b, err := a.Convert(to, rates)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Convert converts the amount to another currency using the exchange rates. The calculation is exact and the result is rounded to the target currency's increments.
+func (a Amount) Convert(to currency.Unit, rates ExchangeRates) (Amount, error) {
+	if a.Unit == to {
+		return a, nil
+	}
+	b, err := NewZeroAmount(to)
+	if err != nil {
+		return Amount{}, err
+	} else if a == ZeroAmount {
+		return b, nil
+	}
+
+	rate, err := rates.Rate(a.Unit, to)
+	if err != nil {
+		return Amount{}, err
+	}
+	r := new(big.Rat).SetFrac(big.NewInt(a.amount), big.NewInt(int64Scales[a.digits+AmountPrecision]))
+	r.Mul(r, rate)
+	r.Mul(r, new(big.Rat).SetInt64(int64Scales[b.digits+AmountPrecision]))
+	if b, err = b.setBig(bankersRoundingRat(r)); err != nil {
+		return Amount{}, err
+	}
+	return b.Round(), nil
+}
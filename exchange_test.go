@@ -0,0 +1,74 @@
+package locale
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/currency"
+
+	"github.com/tdewolff/test"
+)
+
+var ecbXML = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time="2024-01-02">
+			<Cube currency="USD" rate="1.0956"/>
+			<Cube currency="JPY" rate="155.86"/>
+			<Cube currency="GBP" rate="0.86518"/>
+		</Cube>
+		<Cube time="2023-12-29">
+			<Cube currency="USD" rate="1.1050"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+var ecbCSV = `Date, USD, JPY, GBP,
+02 January 2024, 1.0956, 155.86, 0.86518,
+`
+
+func TestExchangeRates(t *testing.T) {
+	ratesXML, err := ParseECBExchangeRatesXML(strings.NewReader(ecbXML))
+	test.Error(t, err)
+	test.T(t, ratesXML.Date, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	ratesCSV, err := ParseECBExchangeRatesCSV(strings.NewReader(ecbCSV))
+	test.Error(t, err)
+	test.T(t, ratesCSV.Date, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	ratesMemory := NewMemoryExchangeRates(currency.EUR)
+	test.Error(t, ratesMemory.Set(currency.USD, "1.0956"))
+	test.Error(t, ratesMemory.Set(currency.JPY, "155.86"))
+	test.Error(t, ratesMemory.Set(currency.GBP, "0.86518"))
+
+	var tests = []struct {
+		a  Amount
+		to currency.Unit
+		r  string
+	}{
+		{MustNewAmount(EUR, 100, 0), currency.EUR, "EUR 100.00"},
+		{MustNewAmount(EUR, 100, 0), currency.USD, "USD 109.56"},
+		{MustNewAmount(currency.USD, 100, 0), currency.EUR, "EUR 91.27"},
+		{MustNewAmount(currency.USD, 100, 0), currency.JPY, "JPY 14,226"},
+		{MustNewAmount(currency.GBP, 1234, 2), currency.USD, "USD 15.63"},
+		{MustNewAmount(currency.GBP, -1234, 2), currency.USD, "USD -15.63"},
+		{ZeroAmount, currency.USD, "USD 0.00"},
+	}
+	for _, rates := range []ExchangeRates{ratesXML, ratesCSV, ratesMemory} {
+		for _, tt := range tests {
+			t.Run(tt.r, func(t *testing.T) {
+				b, err := tt.a.Convert(tt.to, rates)
+				test.Error(t, err)
+				test.T(t, b.String(), tt.r)
+			})
+		}
+	}
+
+	_, err = MustNewAmount(EUR, 100, 0).Convert(currency.CHF, ratesMemory)
+	test.That(t, err != nil, "must return error for unknown exchange rate")
+}
@@ -39,6 +39,7 @@ type CurrencyFormat struct {
 	Standard string
 	Amount   string
 	ISO      string
+	Long     Count
 }
 
 type CalendarFormat struct {
@@ -52,6 +53,20 @@ type CalendarSymbol struct {
 	Wide        string
 	Abbreviated string
 	Narrow      string
+	Short       string
+}
+
+func (symbol *CalendarSymbol) Set(width, text string) {
+	switch width {
+	case "wide":
+		symbol.Wide = text
+	case "abbreviated":
+		symbol.Abbreviated = text
+	case "narrow":
+		symbol.Narrow = text
+	case "short":
+		symbol.Short = text
+	}
 }
 
 type DayPeriodRule struct {
@@ -64,9 +79,23 @@ type Count struct {
 }
 
 type Currency struct {
-	Name     string
-	Standard string
-	Narrow   string
+	Name       string
+	Standard   string
+	Narrow     string
+	PluralName Count
+	Decimal    rune // currency-specific decimal symbol, 0 if not set
+	Group      rune // currency-specific group symbol, 0 if not set
+}
+
+type CurrencySpacingRule struct {
+	CurrencyMatch    string
+	SurroundingMatch string
+	InsertBetween    string
+}
+
+type CurrencySpacing struct {
+	BeforeCurrency CurrencySpacingRule
+	AfterCurrency  CurrencySpacingRule
 }
 
 type Unit struct {
@@ -75,6 +104,13 @@ type Unit struct {
 	Narrow Count
 }
 
+type ListPattern struct {
+	Start  string
+	Middle string
+	End    string
+	Two    string
+}
+
 type MetazoneSymbol struct {
 	Long  string
 	Short string
@@ -86,6 +122,30 @@ type Metazone struct {
 	Daylight MetazoneSymbol
 }
 
+type RelativeTime struct {
+	Relative map[string]string // by offset, such as "-1" for yesterday
+	Future   Count
+	Past     Count
+}
+
+type WeekData struct {
+	FirstDay     int // 0 is Sunday as for time.Weekday
+	MinDays      int // minimal days in the first week of the year or month
+	WeekendStart int
+	WeekendEnd   int
+}
+
+type TimeData struct {
+	Preferred string   // preferred hour symbol: h, H, K or k
+	Allowed   []string // allowed hour symbols with their day period, such as hB for h with B
+}
+
+type Calendar struct {
+	DateFormat  CalendarFormat
+	MonthSymbol []CalendarSymbol // empty if equal to Gregorian, leap months follow the regular months
+	EraSymbol   []CalendarSymbol
+}
+
 type Locale struct {
 	DecimalFormat          string
 	CurrencyFormat         CurrencyFormat
@@ -112,7 +172,42 @@ type Locale struct {
 	Currency map[string]Currency
 	Unit     map[string]Unit
 
-	Territory map[string]string
+	Territory       map[string]string
+	ListPattern     ListPattern
+	CurrencySpacing CurrencySpacing
+	PercentFormat   string
+	PercentSymbol   rune
+	RelativeTime    map[string]RelativeTime
+
+	DatetimeAvailableFormat map[string]string
+	EraSymbol               [2]CalendarSymbol
+	QuarterSymbol           [4]CalendarSymbol
+
+	MonthStandaloneSymbol   [12]CalendarSymbol
+	DayStandaloneSymbol     [7]CalendarSymbol
+	QuarterStandaloneSymbol [4]CalendarSymbol
+
+	Calendar  string // preferred calendar type
+	Calendars map[string]Calendar
+
+	FirstDay int // first day of the week of the locale's region
+	MinDays  int
+
+	HourCycle    string // preferred hour symbol of the locale's region
+	AllowedHours []string
+
+	NumberingSystem   string // default numbering system, such as latn
+	PreferredCurrency string // set by the -u-cu- extension
+	MeasurementSystem string // metric, ussystem, or uksystem
+	Timezone          string // IANA time zone set by the -u-tz- extension
+
+	GMTFormat            string // localized GMT format, such as GMT{0}
+	GMTZeroFormat        string
+	HourFormat           string // positive and negative offset separated by a semicolon, such as +HH:mm;-HH:mm
+	RegionFormat         string // generic location format, such as {0} Time
+	RegionDaylightFormat string
+	RegionStandardFormat string
+	FallbackFormat       string // partial location format, such as {1} ({0})
 }
 
 type CurrencyInfo struct {
@@ -122,6 +217,45 @@ type CurrencyInfo struct {
 	CashRounding int
 }
 
+type RegionCurrency struct {
+	Currency string
+	From     string // YYYY-MM-DD, inclusive
+	To       string // YYYY-MM-DD, inclusive
+	Tender   bool
+}
+
+// normalizeDate pads partial dates of the form YYYY or YYYY-MM to the first or last day.
+func normalizeDate(s string, last bool) string {
+	if s == "" || len(s) == 10 {
+		return s
+	} else if len(s) == 4 {
+		s += "-01"
+		if last {
+			s = s[:4] + "-12"
+		}
+	}
+	if len(s) == 7 {
+		if last {
+			return s + "-31"
+		}
+		return s + "-01"
+	}
+	panic(fmt.Sprintf("bad date: %v", s))
+}
+
+// calendarTypes are the supported non-Gregorian calendars, the islamic-* variants use the data of islamic.
+var calendarTypes = []string{"buddhist", "hebrew", "islamic", "japanese", "persian"}
+
+// firstJapaneseEra is the Meiji era, earlier eras are not supported.
+const firstJapaneseEra = 232
+
+// measurementSystemTypes maps the CLDR measurement systems to their BCP 47 types.
+var measurementSystemTypes = map[string]string{
+	"metric": "metric",
+	"US":     "ussystem",
+	"UK":     "uksystem",
+}
+
 var dayMap = map[string]int{
 	"sun": 0,
 	"mon": 1,
@@ -178,14 +312,18 @@ func main() {
 		tag := language.MustParse(localeName)
 		base, _, _ := tag.Raw()
 		locale := Locale{
-			DatetimeIntervalFormat: map[string]map[string]string{},
-			DayPeriodRules:         map[string]DayPeriodRule{},
-			DayPeriodSymbol:        map[string]CalendarSymbol{},
-			TimezoneCity:           map[string]string{},
-			Metazones:              map[string]Metazone{},
-			Currency:               map[string]Currency{},
-			Unit:                   map[string]Unit{},
-			Territory:              map[string]string{},
+			DatetimeIntervalFormat:  map[string]map[string]string{},
+			DayPeriodRules:          map[string]DayPeriodRule{},
+			DayPeriodSymbol:         map[string]CalendarSymbol{},
+			TimezoneCity:            map[string]string{},
+			Metazones:               map[string]Metazone{},
+			Currency:                map[string]Currency{},
+			Unit:                    map[string]Unit{},
+			Territory:               map[string]string{},
+			RelativeTime:            map[string]RelativeTime{},
+			DatetimeAvailableFormat: map[string]string{},
+			Calendar:                "gregorian",
+			Calendars:               map[string]Calendar{},
 		}
 
 		var parentXML *XMLNode
@@ -202,7 +340,6 @@ func main() {
 			}
 		}
 
-		datetimeAvailableFormat := map[string]string{}
 		if xmlLocale, err := ParseXML("main/" + localeName + ".xml"); err != nil {
 			panic(err)
 		} else {
@@ -215,6 +352,12 @@ func main() {
 			if n, ok := xmlLocale.Find("/ldml/numbers/decimalFormats[numberSystem=latn]/decimalFormatLength[!type]/decimalFormat/pattern"); ok {
 				locale.DecimalFormat = n.Text
 			}
+			if n, ok := xmlLocale.Find("/ldml/numbers/percentFormats[numberSystem=latn]/percentFormatLength[!type]/percentFormat/pattern"); ok {
+				locale.PercentFormat = n.Text
+			}
+			if n, ok := xmlLocale.Find("/ldml/numbers/defaultNumberingSystem[!alt]"); ok {
+				locale.NumberingSystem = n.Text
+			}
 			for _, n := range xmlLocale.FindAll("/ldml/numbers/currencyFormats[numberSystem=latn]/currencyFormatLength[!type]/currencyFormat[type=standard]/pattern") {
 				if alt := n.Attr("alt"); alt == "" {
 					locale.CurrencyFormat.Standard = n.Text
@@ -224,6 +367,14 @@ func main() {
 					locale.CurrencyFormat.ISO = n.Text
 				}
 			}
+			for _, n := range xmlLocale.FindAll("/ldml/numbers/currencyFormats[numberSystem=latn]/unitPattern[count]") {
+				switch n.Attr("count") {
+				case "one":
+					locale.CurrencyFormat.Long.One = n.Text
+				case "other":
+					locale.CurrencyFormat.Long.Other = n.Text
+				}
+			}
 			for _, n := range xmlLocale.FindAll("/ldml/numbers/symbols[numberSystem=latn]/*") {
 				if r, _ := utf8.DecodeRuneInString(n.Text); r != utf8.RuneError {
 					switch n.Tag {
@@ -241,6 +392,8 @@ func main() {
 						locale.MinusSymbol = r
 					case "timeSeparator":
 						locale.TimeSeparatorSymbol = r
+					case "percentSign":
+						locale.PercentSymbol = r
 					}
 				}
 			}
@@ -248,8 +401,12 @@ func main() {
 				cur := n.Parent.Attr("type")
 				currency := locale.Currency[cur]
 				if n.Tag == "displayName" {
-					if _, ok := n.Attr2("count"); !ok {
+					if count, ok := n.Attr2("count"); !ok {
 						currency.Name = n.Text
+					} else if count == "one" {
+						currency.PluralName.One = n.Text
+					} else if count == "other" {
+						currency.PluralName.Other = n.Text
 					}
 				} else if n.Tag == "symbol" {
 					if n.Attr("alt") == "narrow" {
@@ -257,36 +414,74 @@ func main() {
 					} else {
 						currency.Standard = n.Text
 					}
+				} else if r, _ := utf8.DecodeRuneInString(n.Text); r != utf8.RuneError {
+					if n.Tag == "decimal" {
+						currency.Decimal = r
+					} else if n.Tag == "group" {
+						currency.Group = r
+					}
 				}
 				locale.Currency[cur] = currency
 			}
+			for _, n := range xmlLocale.FindAll("/ldml/numbers/currencyFormats[numberSystem=latn]/currencySpacing/*/*") {
+				var rule *CurrencySpacingRule
+				switch n.Parent.Tag {
+				case "beforeCurrency":
+					rule = &locale.CurrencySpacing.BeforeCurrency
+				case "afterCurrency":
+					rule = &locale.CurrencySpacing.AfterCurrency
+				default:
+					continue
+				}
+				switch n.Tag {
+				case "currencyMatch":
+					rule.CurrencyMatch = n.Text
+				case "surroundingMatch":
+					rule.SurroundingMatch = n.Text
+				case "insertBetween":
+					rule.InsertBetween = n.Text
+				}
+			}
 			if calendar, ok := xmlLocale.Find("/ldml/dates/calendars/calendar[type=gregorian]"); ok {
 				if generic, ok := xmlLocale.Find("/ldml/dates/calendars/calendar[type=generic]"); ok {
 					calendar.InheritFrom(generic)
 				}
 				for _, n := range calendar.FindAll("months/monthContext[type]/monthWidth[type]/month[type]") {
 					if month, _ := strconv.Atoi(n.Attr("type")); 1 <= month && month <= 12 {
-						width := n.Parent.Attr("type")
-						context := n.Parent.Parent.Attr("type")
-						if context == "format" && width == "wide" {
-							locale.MonthSymbol[month-1].Wide = n.Text
-						} else if context == "format" && width == "abbreviated" {
-							locale.MonthSymbol[month-1].Abbreviated = n.Text
-						} else if context == "stand-alone" && width == "narrow" {
-							locale.MonthSymbol[month-1].Narrow = n.Text
+						if n.Parent.Parent.Attr("type") == "stand-alone" {
+							locale.MonthStandaloneSymbol[month-1].Set(n.Parent.Attr("type"), n.Text)
+						} else {
+							locale.MonthSymbol[month-1].Set(n.Parent.Attr("type"), n.Text)
 						}
 					}
 				}
 				for _, n := range calendar.FindAll("days/dayContext[type]/dayWidth[type]/day[type]") {
 					if day, ok := dayMap[n.Attr("type")]; ok {
-						width := n.Parent.Attr("type")
-						context := n.Parent.Parent.Attr("type")
-						if context == "format" && width == "wide" {
-							locale.DaySymbol[day].Wide = n.Text
-						} else if context == "format" && width == "abbreviated" {
-							locale.DaySymbol[day].Abbreviated = n.Text
-						} else if context == "stand-alone" && width == "narrow" {
-							locale.DaySymbol[day].Narrow = n.Text
+						if n.Parent.Parent.Attr("type") == "stand-alone" {
+							locale.DayStandaloneSymbol[day].Set(n.Parent.Attr("type"), n.Text)
+						} else {
+							locale.DaySymbol[day].Set(n.Parent.Attr("type"), n.Text)
+						}
+					}
+				}
+				for _, n := range calendar.FindAll("quarters/quarterContext[type]/quarterWidth[type]/quarter[type]") {
+					if quarter, _ := strconv.Atoi(n.Attr("type")); 1 <= quarter && quarter <= 4 {
+						if n.Parent.Parent.Attr("type") == "stand-alone" {
+							locale.QuarterStandaloneSymbol[quarter-1].Set(n.Parent.Attr("type"), n.Text)
+						} else {
+							locale.QuarterSymbol[quarter-1].Set(n.Parent.Attr("type"), n.Text)
+						}
+					}
+				}
+				for _, n := range calendar.FindAll("eras/*/era[type][!alt]") {
+					if era, err := strconv.Atoi(n.Attr("type")); err == nil && (era == 0 || era == 1) {
+						switch n.Parent.Tag {
+						case "eraNames":
+							locale.EraSymbol[era].Wide = n.Text
+						case "eraAbbr":
+							locale.EraSymbol[era].Abbreviated = n.Text
+						case "eraNarrow":
+							locale.EraSymbol[era].Narrow = n.Text
 						}
 					}
 				}
@@ -341,7 +536,7 @@ func main() {
 					}
 				}
 				for _, n := range calendar.FindAll("dateTimeFormats/availableFormats/dateFormatItem[id]") {
-					datetimeAvailableFormat[n.Attr("id")] = n.Text
+					locale.DatetimeAvailableFormat[n.Attr("id")] = n.Text
 				}
 				if n, ok := calendar.Find("dateTimeFormats/intervalFormats/intervalFormatFallback"); ok {
 					if _, ok := locale.DatetimeIntervalFormat[""]; !ok {
@@ -361,6 +556,85 @@ func main() {
 					locale.TimezoneFormat = n.Text
 				}
 			}
+			for _, calendarType := range calendarTypes {
+				calendar, ok := xmlLocale.Find("/ldml/dates/calendars/calendar[type=" + calendarType + "]")
+				if !ok {
+					continue
+				}
+				if generic, ok := xmlLocale.Find("/ldml/dates/calendars/calendar[type=generic]"); ok {
+					calendar.InheritFrom(generic)
+				}
+
+				cal := Calendar{}
+				for _, n := range calendar.FindAll("dateFormats/dateFormatLength[type]/dateFormat/pattern[!alt]") {
+					if length := n.Parent.Parent.Attr("type"); length == "full" {
+						cal.DateFormat.Full = n.Text
+					} else if length == "long" {
+						cal.DateFormat.Long = n.Text
+					} else if length == "medium" {
+						cal.DateFormat.Medium = n.Text
+					} else if length == "short" {
+						cal.DateFormat.Short = n.Text
+					}
+				}
+				months := []CalendarSymbol{}
+				for _, n := range calendar.FindAll("months/monthContext[type=format]/monthWidth[type]/month[type]") {
+					if month, _ := strconv.Atoi(n.Attr("type")); 1 <= month {
+						if n.Attr("yeartype") == "leap" {
+							month = 14 // Hebrew Adar II
+						}
+						for len(months) < month {
+							months = append(months, CalendarSymbol{})
+						}
+						months[month-1].Set(n.Parent.Attr("type"), n.Text)
+					}
+				}
+				if !reflect.DeepEqual(months, locale.MonthSymbol[:]) {
+					cal.MonthSymbol = months
+				}
+				for _, n := range calendar.FindAll("eras/*/era[type][!alt]") {
+					era, err := strconv.Atoi(n.Attr("type"))
+					if calendarType == "japanese" {
+						era -= firstJapaneseEra
+					}
+					if err != nil || era < 0 {
+						continue
+					}
+					for len(cal.EraSymbol) <= era {
+						cal.EraSymbol = append(cal.EraSymbol, CalendarSymbol{})
+					}
+					switch n.Parent.Tag {
+					case "eraNames":
+						cal.EraSymbol[era].Wide = n.Text
+					case "eraAbbr":
+						cal.EraSymbol[era].Abbreviated = n.Text
+					case "eraNarrow":
+						cal.EraSymbol[era].Narrow = n.Text
+					}
+				}
+				locale.Calendars[calendarType] = cal
+			}
+			for _, n := range xmlLocale.FindAll("/ldml/dates/timeZoneNames/*") {
+				switch n.Tag {
+				case "gmtFormat":
+					locale.GMTFormat = n.Text
+				case "gmtZeroFormat":
+					locale.GMTZeroFormat = n.Text
+				case "hourFormat":
+					locale.HourFormat = n.Text
+				case "regionFormat":
+					switch n.Attr("type") {
+					case "":
+						locale.RegionFormat = n.Text
+					case "daylight":
+						locale.RegionDaylightFormat = n.Text
+					case "standard":
+						locale.RegionStandardFormat = n.Text
+					}
+				case "fallbackFormat":
+					locale.FallbackFormat = n.Text
+				}
+			}
 			for _, n := range xmlLocale.FindAll("/ldml/dates/timeZoneNames/zone[type]/exemplarCity") {
 				locale.TimezoneCity[n.Parent.Attr("type")] = n.Text
 			}
@@ -414,9 +688,53 @@ func main() {
 					locale.Unit[unitName] = unit
 				}
 			}
+			for _, n := range xmlLocale.FindAll("/ldml/dates/fields/field[type]/*") {
+				field := n.Parent.Attr("type")
+				switch strings.TrimSuffix(strings.TrimSuffix(field, "-short"), "-narrow") {
+				case "year", "quarter", "month", "week", "day", "hour", "minute", "second":
+				default:
+					continue
+				}
+				relativeTime := locale.RelativeTime[field]
+				if n.Tag == "relative" {
+					if relativeTime.Relative == nil {
+						relativeTime.Relative = map[string]string{}
+					}
+					relativeTime.Relative[n.Attr("type")] = n.Text
+				} else if n.Tag == "relativeTime" {
+					count := &relativeTime.Future
+					if n.Attr("type") == "past" {
+						count = &relativeTime.Past
+					}
+					for _, m := range n.Nodes {
+						if m.Tag != "relativeTimePattern" {
+							continue
+						} else if m.Attr("count") == "one" {
+							count.One = m.Text
+						} else if m.Attr("count") == "other" {
+							count.Other = m.Text
+						}
+					}
+				} else {
+					continue
+				}
+				locale.RelativeTime[field] = relativeTime
+			}
 			for _, n := range xmlLocale.FindAll("/ldml/localeDisplayNames/territories/territory[type]") {
 				locale.Territory[n.Attr("type")] = n.Text
 			}
+			for _, n := range xmlLocale.FindAll("/ldml/listPatterns/listPattern[!type]/listPatternPart[type]") {
+				switch n.Attr("type") {
+				case "start":
+					locale.ListPattern.Start = n.Text
+				case "middle":
+					locale.ListPattern.Middle = n.Text
+				case "end":
+					locale.ListPattern.End = n.Text
+				case "2":
+					locale.ListPattern.Two = n.Text
+				}
+			}
 		}
 
 		// custom changes
@@ -442,6 +760,12 @@ func main() {
 	}
 
 	currencyInfos := map[string]CurrencyInfo{}
+	regionCurrencies := map[string][]RegionCurrency{}
+	japaneseEras := []string{}
+	calendarPreferences := map[string]string{}
+	weekData := map[string]WeekData{}
+	timeData := map[string]TimeData{}
+	measurementSystems := map[string]string{}
 	if xmlSupplementalData, err := ParseXML("supplemental/supplementalData.xml"); err != nil {
 		panic(err)
 	} else {
@@ -484,9 +808,156 @@ func main() {
 				currencyInfos[iso4217] = currencyInfo
 			}
 		}
+		for _, n := range xmlSupplementalData.FindAll("/supplementalData/calendarData/calendar[type=japanese]/eras/era[type][start]") {
+			if era, err := strconv.Atoi(n.Attr("type")); err == nil && firstJapaneseEra <= era {
+				var year, month, day int
+				fmt.Sscanf(n.Attr("start"), "%d-%d-%d", &year, &month, &day)
+				japaneseEras = append(japaneseEras, fmt.Sprintf("%04d-%02d-%02d", year, month, day))
+			}
+		}
+		for _, n := range xmlSupplementalData.FindAll("/supplementalData/weekData/*[territories][!alt]") {
+			var value int
+			if n.Tag == "minDays" {
+				value, _ = strconv.Atoi(n.Attr("count"))
+			} else if day, ok := dayMap[n.Attr("day")]; ok {
+				value = day
+			} else {
+				continue
+			}
+			for _, territory := range strings.Fields(n.Attr("territories")) {
+				data, ok := weekData[territory]
+				if !ok {
+					data = WeekData{-1, -1, -1, -1}
+				}
+				switch n.Tag {
+				case "firstDay":
+					data.FirstDay = value
+				case "minDays":
+					data.MinDays = value
+				case "weekendStart":
+					data.WeekendStart = value
+				case "weekendEnd":
+					data.WeekendEnd = value
+				}
+				weekData[territory] = data
+			}
+		}
+		world := weekData["001"]
+		for territory, data := range weekData {
+			if data.FirstDay == -1 {
+				data.FirstDay = world.FirstDay
+			}
+			if data.MinDays == -1 {
+				data.MinDays = world.MinDays
+			}
+			if data.WeekendStart == -1 {
+				data.WeekendStart = world.WeekendStart
+			}
+			if data.WeekendEnd == -1 {
+				data.WeekendEnd = world.WeekendEnd
+			}
+			weekData[territory] = data
+		}
+		for _, n := range xmlSupplementalData.FindAll("/supplementalData/timeData/hours[preferred][allowed][regions]") {
+			data := TimeData{
+				Preferred: n.Attr("preferred"),
+				Allowed:   strings.Fields(n.Attr("allowed")),
+			}
+			for _, region := range strings.Fields(n.Attr("regions")) {
+				timeData[region] = data // regions may be of a language only, such as en_001
+			}
+		}
+		for _, n := range xmlSupplementalData.FindAll("/supplementalData/measurementData/measurementSystem[type][territories][!category]") {
+			system, ok := measurementSystemTypes[n.Attr("type")]
+			if !ok {
+				continue
+			}
+			for _, territory := range strings.Fields(n.Attr("territories")) {
+				measurementSystems[territory] = system
+			}
+		}
+		for _, n := range xmlSupplementalData.FindAll("/supplementalData/calendarPreferenceData/calendarPreference[territories][ordering]") {
+			for _, territory := range strings.Fields(n.Attr("territories")) {
+				calendarPreferences[territory] = strings.Fields(n.Attr("ordering"))[0]
+			}
+		}
+		for _, n := range xmlSupplementalData.FindAll("/supplementalData/currencyData/region[iso3166]/currency[iso4217]") {
+			region := n.Parent.Attr("iso3166")
+			regionCurrencies[region] = append(regionCurrencies[region], RegionCurrency{
+				Currency: n.Attr("iso4217"),
+				From:     normalizeDate(n.Attr("from"), false),
+				To:       normalizeDate(n.Attr("to"), true),
+				Tender:   n.Attr("tender") != "false",
+			})
+		}
+	}
+
+	for localeName, locale := range locales {
+		data, hours, system := weekData["001"], timeData["001"], measurementSystems["001"]
+		tag := language.MustParse(localeName)
+		if region, confidence := tag.Region(); localeName != "root" && confidence != language.No {
+			if calendar, ok := calendarPreferences[region.String()]; ok {
+				locale.Calendar = calendar
+			}
+			if regionData, ok := weekData[region.String()]; ok {
+				data = regionData
+			}
+			if regionSystem, ok := measurementSystems[region.String()]; ok {
+				system = regionSystem
+			}
+			base, _ := tag.Base()
+			if regionHours, ok := timeData[base.String()+"_"+region.String()]; ok {
+				hours = regionHours
+			} else if regionHours, ok := timeData[region.String()]; ok {
+				hours = regionHours
+			}
+		}
+		locale.FirstDay, locale.MinDays = data.FirstDay, data.MinDays
+		locale.HourCycle, locale.AllowedHours = hours.Preferred, hours.Allowed
+		locale.MeasurementSystem = system
+		if locale.NumberingSystem == "" {
+			locale.NumberingSystem = "latn"
+		}
+		if _, ok := locale.Calendars[locale.Calendar]; !ok && !strings.HasPrefix(locale.Calendar, "islamic") {
+			locale.Calendar = "gregorian"
+		}
+		locales[localeName] = locale
+	}
+
+	numberingSystems := map[string]string{}
+	if xmlNumberingSystems, err := ParseXML("supplemental/numberingSystems.xml"); err != nil {
+		panic(err)
+	} else {
+		for _, n := range xmlNumberingSystems.FindAll("/supplementalData/numberingSystems/numberingSystem[type=numeric][digits]") {
+			numberingSystems[n.Attr("id")] = n.Attr("digits")
+		}
+	}
+
+	bcp47Timezones := map[string]string{}
+	timezoneIDs := map[string][]string{} // time zone to its IDs and aliases
+	timezoneNames := map[string]string{} // time zone to its BCP 47 ID
+	if xmlTimezones, err := ParseXML("bcp47/timezone.xml"); err != nil {
+		panic(err)
+	} else {
+		for _, n := range xmlTimezones.FindAll("/ldmlBCP47/keyword/key[name=tz]/type[name][alias][!deprecated]") {
+			aliases := strings.Fields(n.Attr("alias"))
+			timezone := n.Attr("iana")
+			if timezone == "" {
+				timezone = aliases[0]
+			} else if !slices.Contains(aliases, timezone) {
+				aliases = append(aliases, timezone)
+			}
+			bcp47Timezones[n.Attr("name")] = timezone
+			timezoneNames[aliases[0]] = n.Attr("name")
+			for _, alias := range aliases {
+				timezoneIDs[alias] = aliases
+			}
+		}
 	}
 
 	metazones := map[string]string{}
+	metazoneZones := map[string]string{}     // golden zone of the metazone
+	regionTimezones := map[string][]string{} // region to time zone IDs and aliases
 	if xmlMetaZones, err := ParseXML("supplemental/metaZones.xml"); err != nil {
 		panic(err)
 	} else {
@@ -494,6 +965,47 @@ func main() {
 			timezone := n.Parent.Attr("type")
 			metazone := n.Attr("mzone")
 			metazones[timezone] = metazone
+			for _, alias := range timezoneIDs[timezone] {
+				metazones[alias] = metazone
+			}
+		}
+		timezoneRegions := map[string]string{} // time zone to region, or empty if used in several regions
+		for _, n := range xmlMetaZones.FindAll("/supplementalData/metaZones/mapTimezones[type=metazones]/mapZone[other][territory][type]") {
+			if territory := n.Attr("territory"); territory == "001" {
+				metazoneZones[n.Attr("other")] = n.Attr("type")
+			} else if _, ok := timezoneRegions[n.Attr("type")]; ok {
+				timezoneRegions[n.Attr("type")] = ""
+			} else {
+				timezoneRegions[n.Attr("type")] = territory
+			}
+		}
+
+		// BCP 47 time zone IDs start with the region, except for UTC offsets, unknown, and some longer IDs
+		for timezone, name := range timezoneNames {
+			region, ok := timezoneRegions[timezone]
+			if (!ok || region == "") && len(name) == 5 && !strings.HasPrefix(name, "utc") {
+				region = strings.ToUpper(name[:2])
+			}
+			if region != "" {
+				regionTimezones[region] = append(regionTimezones[region], strings.Join(timezoneIDs[timezone], " "))
+			}
+		}
+		for _, n := range xmlMetaZones.FindAll("/supplementalData/primaryZones/primaryZone[iso3166]") {
+			for _, timezones := range regionTimezones[n.Attr("iso3166")] {
+				if slices.Contains(strings.Fields(timezones), n.Text) {
+					regionTimezones[n.Attr("iso3166")] = []string{timezones}
+				}
+			}
+		}
+	}
+
+	// time zones that are the only or primary one of their region use the region name for the generic location format
+	primaryZones := map[string]string{}
+	for region, timezones := range regionTimezones {
+		if len(timezones) == 1 {
+			for _, timezone := range strings.Fields(timezones[0]) {
+				primaryZones[timezone] = region
+			}
 		}
 	}
 
@@ -509,7 +1021,7 @@ func main() {
 	w.Write([]byte("// Automatically generated by gen_cldr.go\n"))
 	w.Write([]byte("package locale\n"))
 
-	types := []interface{}{CurrencyFormat{}, CalendarFormat{}, CalendarSymbol{}, DayPeriodRule{}, Count{}, Currency{}, Unit{}, Locale{}, CurrencyInfo{}, MetazoneSymbol{}, Metazone{}}
+	types := []interface{}{CurrencyFormat{}, CalendarFormat{}, CalendarSymbol{}, DayPeriodRule{}, Count{}, Currency{}, CurrencySpacingRule{}, CurrencySpacing{}, Unit{}, ListPattern{}, Locale{}, CurrencyInfo{}, RegionCurrency{}, MetazoneSymbol{}, Metazone{}, RelativeTime{}, Calendar{}, WeekData{}, TimeData{}}
 	for _, v := range types {
 		t := reflect.TypeOf(v)
 		fmt.Fprintf(w, "\ntype %v ", t.Name())
@@ -531,11 +1043,65 @@ func main() {
 	}
 	fmt.Fprintf(w, "\n")
 
+	fmt.Fprintf(w, "\nvar regionCurrencies = map[string][]RegionCurrency")
+	if err := printValue(w, reflect.ValueOf(regionCurrencies), 0); err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, "\n")
+
 	fmt.Fprintf(w, "\nvar metazones = map[string]string")
 	if err := printValue(w, reflect.ValueOf(metazones), 0); err != nil {
 		panic(err)
 	}
 	fmt.Fprintf(w, "\n")
+
+	fmt.Fprintf(w, "\nvar metazoneZones = map[string]string")
+	if err := printValue(w, reflect.ValueOf(metazoneZones), 0); err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, "\n")
+
+	fmt.Fprintf(w, "\nvar primaryZones = map[string]string")
+	if err := printValue(w, reflect.ValueOf(primaryZones), 0); err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, "\n")
+
+	fmt.Fprintf(w, "\nvar weekData = map[string]WeekData")
+	if err := printValue(w, reflect.ValueOf(weekData), 0); err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, "\n")
+
+	fmt.Fprintf(w, "\nvar timeData = map[string]TimeData")
+	if err := printValue(w, reflect.ValueOf(timeData), 0); err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, "\n")
+
+	fmt.Fprintf(w, "\nvar measurementSystems = map[string]string")
+	if err := printValue(w, reflect.ValueOf(measurementSystems), 0); err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, "\n")
+
+	fmt.Fprintf(w, "\nvar numberingSystems = map[string]string")
+	if err := printValue(w, reflect.ValueOf(numberingSystems), 0); err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, "\n")
+
+	fmt.Fprintf(w, "\nvar bcp47Timezones = map[string]string")
+	if err := printValue(w, reflect.ValueOf(bcp47Timezones), 0); err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, "\n")
+
+	fmt.Fprintf(w, "\nvar japaneseEras = []string")
+	if err := printValue(w, reflect.ValueOf(japaneseEras), 0); err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(w, "\n")
 }
 
 type XMLNode struct {
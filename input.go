@@ -0,0 +1,455 @@
+package locale
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	parseStrconv "github.com/tdewolff/parse/v2/strconv"
+)
+
+// The input patterns are compatible with the HTML pattern attribute, which is compiled as a JavaScript regular expression with the v flag and must match the entire value, as well as with Go's regexp package when anchored as ^(?:pattern)$. Each pattern has a matching parser that accepts exactly the values matched by the pattern, so that client-side and server-side validation agree. Only the date parser is stricter as it rejects non-existing dates such as February 30.
+
+// inputSpace matches an optional space, where users may type a regular space for a (narrow) non-breaking space.
+const inputSpace = "[ \u00A0\u202F]?"
+
+var inputRegexps sync.Map
+
+// matchInput returns true if the entire value matches the input pattern.
+func matchInput(pattern, s string) bool {
+	re, ok := inputRegexps.Load(pattern)
+	if !ok {
+		re, _ = inputRegexps.LoadOrStore(pattern, regexp.MustCompile("^(?:"+pattern+")$"))
+	}
+	return re.(*regexp.Regexp).MatchString(s)
+}
+
+func isInputSpace(r rune) bool {
+	return r == ' ' || r == '\u00A0' || r == '\u202F'
+}
+
+// quoteInput escapes the literal for use in a pattern, spaces are optional.
+func quoteInput(literal string) string {
+	sb := strings.Builder{}
+	for _, r := range literal {
+		if isInputSpace(r) {
+			sb.WriteString(inputSpace)
+		} else {
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return sb.String()
+}
+
+// inputAlternatives returns a pattern matching any of the non-empty literals.
+func inputAlternatives(literals ...string) string {
+	alts := []string{}
+	seen := map[string]bool{}
+	for _, literal := range literals {
+		if literal != "" && !seen[literal] {
+			alts = append(alts, quoteInput(literal))
+			seen[literal] = true
+		}
+	}
+	if len(alts) == 0 {
+		return ""
+	}
+	return "(?:" + strings.Join(alts, "|") + ")"
+}
+
+func minusInputPattern(locale Locale) string {
+	return inputAlternatives("-", string(locale.MinusSymbol)) + "?"
+}
+
+func integerInputPattern(groupSym rune) string {
+	group := regexp.QuoteMeta(string(groupSym))
+	if isInputSpace(groupSym) {
+		group = "[ \u00A0\u202F]"
+	}
+	return fmt.Sprintf("(?:[0-9]+%s)*[0-9]+", group)
+}
+
+func decimalsInputPattern(decSym rune, decimals int) string {
+	if decimals == 0 {
+		return ""
+	} else if decimals < 0 {
+		return fmt.Sprintf("(?:%s[0-9]+)?", regexp.QuoteMeta(string(decSym)))
+	}
+	return fmt.Sprintf("(?:%s[0-9]{1,%d})?", regexp.QuoteMeta(string(decSym)), decimals)
+}
+
+// parseNumberInput parses a number that matches the pattern, which may have any number of digits. The result is the float64 nearest to the exact decimal value, and numbers out of the float64 range return an error.
+func parseNumberInput(locale Locale, groupSym, decSym rune, s string) (float64, error) {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r == locale.MinusSymbol || r == '-' {
+			b = append(b, '-')
+		} else if r == decSym {
+			b = append(b, '.')
+		} else if '0' <= r && r <= '9' {
+			b = append(b, byte(r))
+		} else if r != groupSym && !isInputSpace(r) {
+			return 0.0, fmt.Errorf("invalid number: %v", s)
+		}
+	}
+	f, err := strconv.ParseFloat(string(b), 64)
+	if err != nil {
+		return 0.0, fmt.Errorf("invalid number: %v", s)
+	}
+	return f, nil
+}
+
+// DecimalInputPattern returns a pattern for decimal numbers with an optional minus sign, optional grouping, and at most the given number of decimals, or any number of decimals if decimals is negative.
+func DecimalInputPattern(tag language.Tag, decimals int) string {
+	locale := GetLocale(tag)
+	return minusInputPattern(locale) + integerInputPattern(locale.GroupSymbol) + decimalsInputPattern(locale.DecimalSymbol, decimals)
+}
+
+// ParseDecimalInput parses a decimal number matching DecimalInputPattern.
+func ParseDecimalInput(tag language.Tag, s string, decimals int) (float64, error) {
+	locale := GetLocale(tag)
+	if !matchInput(DecimalInputPattern(tag, decimals), s) {
+		return 0.0, fmt.Errorf("invalid number: %v", s)
+	}
+	return parseNumberInput(locale, locale.GroupSymbol, locale.DecimalSymbol, s)
+}
+
+// percentAffixes returns the prefix and suffix of the locale's percent format, such as "" and " %".
+func percentAffixes(locale Locale) (string, string) {
+	pattern := locale.PercentFormat
+	if idx := strings.IndexByte(pattern, ';'); idx != -1 {
+		pattern = pattern[:idx]
+	}
+	start := strings.IndexAny(pattern, "#0")
+	end := strings.LastIndexAny(pattern, "#0")
+	if start == -1 {
+		return "", "%"
+	}
+	return strings.ReplaceAll(pattern[:start], "%", string(locale.PercentSymbol)), strings.ReplaceAll(pattern[end+1:], "%", string(locale.PercentSymbol))
+}
+
+// PercentInputPattern returns a pattern for percentages such as "12.5%" with an optional percent sign, minus sign, and grouping, and at most the given number of decimals, or any number of decimals if decimals is negative.
+func PercentInputPattern(tag language.Tag, decimals int) string {
+	locale := GetLocale(tag)
+	prefix, suffix := percentAffixes(locale)
+	if prefix != "" {
+		prefix = "(?:" + quoteInput(prefix) + ")?"
+	}
+	if suffix != "" {
+		suffix = "(?:" + quoteInput(suffix) + ")?"
+	}
+	return prefix + DecimalInputPattern(tag, decimals) + suffix
+}
+
+// ParsePercentInput parses a percentage matching PercentInputPattern and returns it as a fraction, such as 0.125 for "12.5%".
+func ParsePercentInput(tag language.Tag, s string, decimals int) (float64, error) {
+	locale := GetLocale(tag)
+	if !matchInput(PercentInputPattern(tag, decimals), s) {
+		return 0.0, fmt.Errorf("invalid percentage: %v", s)
+	}
+	s = strings.TrimFunc(s, func(r rune) bool {
+		return r == locale.PercentSymbol || isInputSpace(r)
+	})
+	f, err := parseNumberInput(locale, locale.GroupSymbol, locale.DecimalSymbol, s)
+	if err != nil {
+		return 0.0, fmt.Errorf("invalid percentage: %v", s)
+	}
+	return f / 100.0, nil
+}
+
+// amountDecimalsPattern returns the pattern for the decimals of a currency, allowing only multiples of the rounding increment.
+func amountDecimalsPattern(cur CurrencyInfo, decSym rune) string {
+	if cur.Digits == 0 {
+		return ""
+	}
+	decimals := fmt.Sprintf("(?:%s", regexp.QuoteMeta(string(decSym)))
+	switch cur.Rounding {
+	case 0, 1:
+		decimals += fmt.Sprintf("[0-9]{1,%d}", cur.Digits)
+	case 10:
+		if 1 < cur.Digits {
+			decimals += fmt.Sprintf("(?:[0-9]{1,%d}|[0-9]{%d}0)", cur.Digits-1, cur.Digits-1)
+		} else {
+			decimals += "0"
+		}
+	case 100:
+		if 2 < cur.Digits {
+			decimals += fmt.Sprintf("(?:[0-9]{1,%d}|[0-9]{%d}00)", cur.Digits-2, cur.Digits-2)
+		} else {
+			decimals += "00"
+		}
+	default:
+		panic(fmt.Sprintf("unexpected increment: %v", cur.Rounding))
+	}
+	return decimals + ")?"
+}
+
+// AmountInputPattern returns a pattern for amounts of the given currency with an optional minus sign, optional grouping, the currency's digits, and an optional currency symbol or ISO code before or after the number.
+func AmountInputPattern(tag language.Tag, unit currency.Unit) string {
+	locale := GetLocale(tag)
+	names := getCurrencyNames(tag, locale, unit.String())
+	decSym, groupSym := currencySymbols(locale, names)
+
+	minus := minusInputPattern(locale)
+	number := integerInputPattern(groupSym) + amountDecimalsPattern(GetCurrency(unit), decSym)
+	symbol := inputAlternatives(unit.String(), names.Standard, names.Narrow)
+	return fmt.Sprintf("%s%s%s%s|%s%s%s%s|%s%s(?:%s%s)?", minus, symbol, inputSpace, number, symbol, inputSpace, minus, number, minus, number, inputSpace, symbol)
+}
+
+// ParseAmountInput parses an amount matching AmountInputPattern.
+func ParseAmountInput(tag language.Tag, unit currency.Unit, s string) (Amount, error) {
+	locale := GetLocale(tag)
+	if !matchInput(AmountInputPattern(tag, unit), s) {
+		return Amount{}, fmt.Errorf("invalid amount: %v", s)
+	}
+
+	_, groupSym := currencySymbols(locale, getCurrencyNames(tag, locale, unit.String()))
+	if isInputSpace(groupSym) {
+		// use the group symbol for spaces between digits
+		b := []byte{}
+		prev := rune(0)
+		for i, r := range s {
+			if isInputSpace(r) && '0' <= prev && prev <= '9' {
+				if next, _ := utf8.DecodeRuneInString(s[i+utf8.RuneLen(r):]); '0' <= next && next <= '9' {
+					r = groupSym
+				}
+			}
+			b = utf8.AppendRune(b, r)
+			prev = r
+		}
+		s = string(b)
+	}
+	return ParseAmountFormat(tag, s, unit)
+}
+
+// inputField is a date/time pattern symbol, such as "dd", or a literal.
+type inputField struct {
+	symbol  byte
+	n       int
+	literal string
+}
+
+func parseInputFields(pattern string) []inputField {
+	fields := []inputField{}
+	for i := 0; i < len(pattern); {
+		if c := pattern[i]; 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' {
+			n := 1
+			for i+n < len(pattern) && pattern[i+n] == c {
+				n++
+			}
+			fields = append(fields, inputField{symbol: c, n: n})
+			i += n
+		} else if c == '\'' {
+			j := strings.IndexByte(pattern[i+1:], '\'')
+			if j == -1 {
+				j = len(pattern) - i - 1
+			}
+			fields = append(fields, inputField{literal: pattern[i+1 : i+1+j]})
+			i += j + 2
+		} else {
+			_, n := utf8.DecodeRuneInString(pattern[i:])
+			fields = append(fields, inputField{literal: pattern[i : i+n]})
+			i += n
+		}
+	}
+	return fields
+}
+
+// inputFields returns the fields of a date/time pattern that can be entered as input. Unsupported fields, such as weekdays or eras, are dropped together with the literal that separates them from the other fields.
+func inputFields(pattern string) []inputField {
+	fields := parseInputFields(pattern)
+	for i := 0; i < len(fields); i++ {
+		if fields[i].symbol == 0 || strings.IndexByte("dMLyhHKkmsa", fields[i].symbol) != -1 {
+			continue
+		}
+		start, end := i, i+1
+		if end < len(fields) && fields[end].symbol == 0 {
+			for end < len(fields) && fields[end].symbol == 0 {
+				end++
+			}
+		} else {
+			for 0 < start && fields[start-1].symbol == 0 {
+				start--
+			}
+		}
+		fields = append(fields[:start], fields[end:]...)
+		i = start - 1
+	}
+	return fields
+}
+
+func inputFieldsPattern(locale Locale, fields []inputField) string {
+	sb := strings.Builder{}
+	for _, field := range fields {
+		switch field.symbol {
+		case 0:
+			sb.WriteString(quoteInput(field.literal))
+		case 'd':
+			sb.WriteString("(?:0?[1-9]|[12][0-9]|3[01])")
+		case 'M', 'L':
+			sb.WriteString("(?:0?[1-9]|1[0-2])")
+		case 'y':
+			if field.n == 2 {
+				sb.WriteString("[0-9]{2}")
+			} else {
+				sb.WriteString("[0-9]{4}")
+			}
+		case 'h':
+			sb.WriteString("(?:0?[1-9]|1[0-2])")
+		case 'H':
+			sb.WriteString("(?:[01]?[0-9]|2[0-3])")
+		case 'K':
+			sb.WriteString("(?:0?[0-9]|1[01])")
+		case 'k':
+			sb.WriteString("(?:0?[1-9]|1[0-9]|2[0-4])")
+		case 'm', 's':
+			sb.WriteString("[0-5][0-9]")
+		case 'a':
+			am, pm := locale.DayPeriodSymbol["am"], locale.DayPeriodSymbol["pm"]
+			sb.WriteString(inputAlternatives(am.Abbreviated, am.Wide, am.Narrow, pm.Abbreviated, pm.Wide, pm.Narrow))
+		}
+	}
+	return sb.String()
+}
+
+// DateInputPattern returns a pattern for dates in the locale's short date format, such as "1/2/06" for English, where leading zeros are optional.
+func DateInputPattern(tag language.Tag) string {
+	locale := GetLocale(tag)
+	return inputFieldsPattern(locale, inputFields(locale.DateFormat.Short))
+}
+
+// ParseDateInput parses a date matching DateInputPattern at midnight in the given location, or UTC if nil. Two-digit years are in 1969-2068 like for time.Parse.
+func ParseDateInput(tag language.Tag, s string, loc *time.Location) (time.Time, error) {
+	locale := GetLocale(tag)
+	if !matchInput(DateInputPattern(tag), s) {
+		return time.Time{}, fmt.Errorf("invalid date: %v", s)
+	} else if loc == nil {
+		loc = time.UTC
+	}
+
+	year, month, day := 0, 0, 0
+	values, err := parseInputFieldValues(locale, inputFields(locale.DateFormat.Short), s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date: %v", s)
+	}
+	for symbol, v := range values {
+		switch symbol {
+		case 'y':
+			year = v.num
+			if v.n == 2 {
+				year += 1900
+				if year < 1969 {
+					year += 100
+				}
+			}
+		case 'M', 'L':
+			month = v.num
+		case 'd':
+			day = v.num
+		}
+	}
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+	if t.Day() != day {
+		return time.Time{}, fmt.Errorf("invalid date: day out of range: %v", s)
+	}
+	return t, nil
+}
+
+// TimeInputPattern returns a pattern for times of day in the locale's short time format, such as "3:04 PM" for English, where leading zeros of hours are optional.
+func TimeInputPattern(tag language.Tag) string {
+	locale := GetLocale(tag)
+	return inputFieldsPattern(locale, inputFields(locale.TimeFormat.Short))
+}
+
+// ParseTimeInput parses a time of day matching TimeInputPattern and returns the duration since midnight.
+func ParseTimeInput(tag language.Tag, s string) (time.Duration, error) {
+	locale := GetLocale(tag)
+	if !matchInput(TimeInputPattern(tag), s) {
+		return 0, fmt.Errorf("invalid time: %v", s)
+	}
+
+	values, err := parseInputFieldValues(locale, inputFields(locale.TimeFormat.Short), s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time: %v", s)
+	}
+	hour := 0
+	for symbol, v := range values {
+		switch symbol {
+		case 'h', 'K':
+			hour = v.num % 12
+		case 'H':
+			hour = v.num
+		case 'k':
+			hour = v.num % 24
+		}
+	}
+	if period, ok := values['a']; ok && period.num == 1 {
+		hour += 12
+	}
+	return time.Duration(hour)*time.Hour + time.Duration(values['m'].num)*time.Minute + time.Duration(values['s'].num)*time.Second, nil
+}
+
+type inputFieldValue struct {
+	num int // value of a numeric field, or 0 for AM and 1 for PM
+	n   int // number of digits
+}
+
+// parseInputFieldValues returns the values of the fields for a value that matches the pattern of the fields.
+func parseInputFieldValues(locale Locale, fields []inputField, s string) (map[byte]inputFieldValue, error) {
+	values := map[byte]inputFieldValue{}
+	for _, field := range fields {
+		switch field.symbol {
+		case 0:
+			// skip literal, which may have optional spaces
+			for _, r := range field.literal {
+				if strings.HasPrefix(s, string(r)) {
+					s = s[utf8.RuneLen(r):]
+				} else if !isInputSpace(r) {
+					return nil, fmt.Errorf("expected %v", field.literal)
+				} else if c, n := utf8.DecodeRuneInString(s); isInputSpace(c) {
+					s = s[n:]
+				}
+			}
+		case 'a':
+			// longest matching day period
+			am, pm := locale.DayPeriodSymbol["am"], locale.DayPeriodSymbol["pm"]
+			best, num := "", 0
+			for j, symbol := range []string{am.Abbreviated, am.Wide, am.Narrow, pm.Abbreviated, pm.Wide, pm.Narrow} {
+				if symbol != "" && len(best) < len(symbol) && strings.HasPrefix(s, symbol) {
+					best, num = symbol, j/3
+				}
+			}
+			if best == "" {
+				return nil, fmt.Errorf("expected day period")
+			}
+			values['a'] = inputFieldValue{num, 0}
+			s = s[len(best):]
+		default:
+			max := 2
+			if field.symbol == 'y' && field.n != 2 {
+				max = 4
+			}
+			n := 0
+			for n < len(s) && n < max && '0' <= s[n] && s[n] <= '9' {
+				n++
+			}
+			if n == 0 {
+				return nil, fmt.Errorf("expected number")
+			}
+			num, _ := parseStrconv.ParseUint([]byte(s[:n]))
+			values[field.symbol] = inputFieldValue{int(num), n}
+			s = s[n:]
+		}
+	}
+	if s != "" {
+		return nil, fmt.Errorf("unexpected %v", s)
+	}
+	return values, nil
+}
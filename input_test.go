@@ -0,0 +1,181 @@
+package locale
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/tdewolff/test"
+)
+
+func TestDecimalInput(t *testing.T) {
+	var tests = []struct {
+		tag language.Tag
+		s   string
+		f   float64
+		ok  bool
+	}{
+		{language.English, "1,234.5", 1234.5, true},
+		{language.English, "-1234.56", -1234.56, true},
+		{language.English, "1234.567", 0.0, false},
+		{language.English, "1.234,5", 0.0, false},
+		{language.English, "1.", 0.0, false},
+		{language.English, "12,345,678,901,234,567,890.12", 12345678901234567890.12, true},
+		{language.Spanish, "1.234,5", 1234.5, true},
+		{language.Spanish, "1,5", 1.5, true},
+		{language.Dutch, "abc", 0.0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			pattern := regexp.MustCompile("^(?:" + DecimalInputPattern(tt.tag, 2) + ")$")
+			test.T(t, pattern.MatchString(tt.s), tt.ok)
+
+			f, err := ParseDecimalInput(tt.tag, tt.s, 2)
+			test.T(t, err == nil, tt.ok)
+			test.Float(t, f, tt.f)
+		})
+	}
+}
+
+func TestPercentInput(t *testing.T) {
+	var tests = []struct {
+		tag language.Tag
+		s   string
+		f   float64
+		ok  bool
+	}{
+		{language.English, "12.5%", 0.125, true},
+		{language.English, "12.5", 0.125, true},
+		{language.English, "%12.5", 0.0, false},
+		{language.Spanish, "12,5\u00A0%", 0.125, true},
+		{language.Spanish, "12,5 %", 0.125, true},
+		{language.Spanish, "-5%", -0.05, true},
+		{language.Dutch, "12,55%", 0.0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			pattern := regexp.MustCompile("^(?:" + PercentInputPattern(tt.tag, 1) + ")$")
+			test.T(t, pattern.MatchString(tt.s), tt.ok)
+
+			f, err := ParsePercentInput(tt.tag, tt.s, 1)
+			test.T(t, err == nil, tt.ok)
+			test.Float(t, f, tt.f)
+		})
+	}
+}
+
+func TestAmountInput(t *testing.T) {
+	test.T(t, AmountInputPattern(language.English, currency.JPY), "(?:-)?(?:JPY|¥)[ \u00A0\u202F]?(?:[0-9]+,)*[0-9]+|(?:JPY|¥)[ \u00A0\u202F]?(?:-)?(?:[0-9]+,)*[0-9]+|(?:-)?(?:[0-9]+,)*[0-9]+(?:[ \u00A0\u202F]?(?:JPY|¥))?")
+
+	var tests = []struct {
+		tag  language.Tag
+		unit currency.Unit
+		s    string
+		r    string
+	}{
+		{language.English, EUR, "€1,234.50", "EUR 1,234.50"},
+		{language.English, EUR, "-€5", "EUR -5.00"},
+		{language.English, EUR, "€-5", "EUR -5.00"},
+		{language.English, EUR, "5 EUR", "EUR 5.00"},
+		{language.English, EUR, "5", "EUR 5.00"},
+		{language.English, EUR, "$5", ""},
+		{language.English, EUR, "€5.001", ""},
+		{language.English, EUR, "€5.", ""},
+		{language.English, currency.JPY, "¥5", "JPY 5"},
+		{language.English, currency.JPY, "¥5.5", ""},
+		{language.Spanish, EUR, "1.234,5 €", "EUR 1,234.50"},
+		{language.Dutch, currency.USD, "US$ -5,00", "USD -5.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			pattern := regexp.MustCompile("^(?:" + AmountInputPattern(tt.tag, tt.unit) + ")$")
+			test.T(t, pattern.MatchString(tt.s), tt.r != "")
+
+			a, err := ParseAmountInput(tt.tag, tt.unit, tt.s)
+			if tt.r == "" {
+				test.That(t, err != nil, "must return error")
+			} else {
+				test.Error(t, err)
+				test.T(t, a.String(), tt.r)
+			}
+		})
+	}
+}
+
+func TestDateInput(t *testing.T) {
+	var tests = []struct {
+		tag language.Tag
+		s   string
+		r   string
+	}{
+		{language.English, "1/2/06", "2006-01-02"},
+		{language.English, "01/02/70", "1970-01-02"},
+		{language.English, "12/31/68", "2068-12-31"},
+		{language.English, "13/1/06", ""},
+		{language.English, "1/2/2006", ""},
+		{language.English, "2/30/06", ""},
+		{language.Spanish, "2/1/06", "2006-01-02"},
+		{language.Dutch, "02-01-2006", "2006-01-02"},
+		{language.Dutch, "2-1-2006", "2006-01-02"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			date, err := ParseDateInput(tt.tag, tt.s, nil)
+			if tt.r == "" {
+				test.That(t, err != nil, "must return error")
+			} else {
+				test.Error(t, err)
+				test.T(t, date.Format("2006-01-02"), tt.r)
+			}
+		})
+	}
+	test.T(t, regexp.MustCompile("^(?:"+DateInputPattern(language.English)+")$").MatchString("2/30/06"), true)
+}
+
+func TestInputFieldsUnsupported(t *testing.T) {
+	// weekdays and eras are dropped together with their separator
+	locale := locales["en"]
+	test.T(t, inputFieldsPattern(locale, inputFields("EEE, M/d/y")), inputFieldsPattern(locale, inputFields("M/d/y")))
+	test.T(t, inputFieldsPattern(locale, inputFields("d-M-y G")), inputFieldsPattern(locale, inputFields("d-M-y")))
+
+	fields := inputFields("EEEE d-M")
+	test.T(t, len(fields), 3)
+	pattern := regexp.MustCompile("^(?:" + inputFieldsPattern(locale, fields) + ")$")
+	test.That(t, pattern.MatchString("2-1"), "must match without weekday")
+	values, err := parseInputFieldValues(locale, fields, "2-1")
+	test.Error(t, err)
+	test.T(t, values['d'].num, 2)
+	test.T(t, values['M'].num, 1)
+}
+
+func TestTimeInput(t *testing.T) {
+	var tests = []struct {
+		tag language.Tag
+		s   string
+		d   time.Duration
+		ok  bool
+	}{
+		{language.English, "3:04\u202FPM", 15*time.Hour + 4*time.Minute, true},
+		{language.English, "3:04 PM", 15*time.Hour + 4*time.Minute, true},
+		{language.English, "12:30AM", 30 * time.Minute, true},
+		{language.English, "12:30 PM", 12*time.Hour + 30*time.Minute, true},
+		{language.English, "15:04", 0, false},
+		{language.Spanish, "9:05", 9*time.Hour + 5*time.Minute, true},
+		{language.Dutch, "23:59", 23*time.Hour + 59*time.Minute, true},
+		{language.Dutch, "24:00", 0, false},
+		{language.Dutch, "9:5", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			pattern := regexp.MustCompile("^(?:" + TimeInputPattern(tt.tag) + ")$")
+			test.T(t, pattern.MatchString(tt.s), tt.ok)
+
+			d, err := ParseTimeInput(tt.tag, tt.s)
+			test.T(t, err == nil, tt.ok)
+			test.T(t, d, tt.d)
+		})
+	}
+}
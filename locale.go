@@ -3,6 +3,7 @@ package locale
 //go:generate go run gen_cldr.go
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"time"
@@ -25,7 +26,13 @@ type Translator interface {
 	Translate(*Printer) string
 }
 
+// NewPrinter returns a printer for the language tag, which formats times in the time zone of the tag's -u-tz- extension if present, like all time formatters do. Otherwise, times are formatted in the given location, or in UTC if it is nil.
 func NewPrinter(t language.Tag, loc *time.Location) *Printer {
+	if tz := getLocation(GetLocale(t)); tz != nil {
+		loc = tz
+	} else if loc == nil {
+		loc = time.UTC
+	}
 	return &Printer{
 		Printer:     message.NewPrinter(t),
 		LanguageTag: t,
@@ -33,6 +40,43 @@ func NewPrinter(t language.Tag, loc *time.Location) *Printer {
 	}
 }
 
+// SetCalendar sets the calendar used for dates by its BCP 47 type, such as "islamic-umalqura" or "japanese". This is equivalent to adding the -u-ca- extension to the language tag. Unsupported calendars, such as the observational "islamic" calendar, fall back to the Gregorian calendar when formatting, and the quarter, day of the year, and week fields stay Gregorian.
+func (p *Printer) SetCalendar(calendar string) error {
+	tag, err := p.LanguageTag.SetTypeForKey("ca", "")
+	if err != nil {
+		return err
+	}
+
+	// SetTypeForKey does not accept types of multiple subtags such as islamic-civil
+	s := "u-ca-" + calendar
+	if ext, ok := tag.Extension('u'); ok {
+		s = ext.String() + "-ca-" + calendar
+	}
+	ext, err := language.ParseExtension(s)
+	if err != nil {
+		return err
+	} else if tag, err = language.Compose(tag, ext); err != nil {
+		return err
+	}
+	p.LanguageTag = tag
+	p.Printer = message.NewPrinter(tag)
+	return nil
+}
+
+// SetHourCycle sets the hour cycle used for times by its BCP 47 type, which is "h11" (0-11), "h12" (1-12), "h23" (0-23), or "h24" (1-24). This is equivalent to adding the -u-hc- extension to the language tag.
+func (p *Printer) SetHourCycle(hourCycle string) error {
+	if _, ok := hourCycles[hourCycle]; !ok {
+		return fmt.Errorf("unsupported hour cycle: %v", hourCycle)
+	}
+	tag, err := p.LanguageTag.SetTypeForKey("hc", hourCycle)
+	if err != nil {
+		return err
+	}
+	p.LanguageTag = tag
+	p.Printer = message.NewPrinter(tag)
+	return nil
+}
+
 func (p *Printer) T(a ...any) string {
 	if len(a) == 0 {
 		return ""
@@ -72,6 +116,10 @@ func (p *Printer) T(a ...any) string {
 				return p.Sprintf("%v", DurationFormatter{time.Duration(v), layout})
 			case Amount:
 				return p.Sprintf("%v", AmountFormatter{v, layout})
+			case BigAmount:
+				return p.Sprintf("%v", BigAmountFormatter{v, layout})
+			case Amounts:
+				return p.Sprintf("%v", AmountsFormatter{v, layout})
 			case currency.Unit:
 				return p.Sprintf("%v", CurrencyFormatter{v, layout})
 			}
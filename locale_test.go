@@ -1,8 +1,11 @@
 package locale
 
 import (
+	"testing"
 	"time"
 
+	"github.com/tdewolff/test"
+	"golang.org/x/text/currency"
 	"golang.org/x/text/language"
 )
 
@@ -11,3 +14,37 @@ var tzPST = time.FixedZone("America/Los_Angeles", -8*3600)
 
 var en = NewPrinter(language.English, tzPST)
 var es = NewPrinter(language.Spanish, tzCET)
+var nl = NewPrinter(language.Dutch, tzCET)
+
+func TestExtensions(t *testing.T) {
+	tag := language.MustParse("nl-NL-u-nu-arab-ca-islamic-umalqura-hc-h12-fw-sun-cu-usd-tz-usnyc")
+	tm := time.Date(2025, 1, 2, 15, 4, 0, 0, time.UTC)
+
+	p := NewPrinter(tag, nil)
+	test.T(t, p.Location.String(), "America/New_York")
+	test.T(t, p.T(tm, DateShort+" "+TimeShort), "٠٢-٠٧-١٤٤٦ AH, ١٠:٠٤ a.m.")
+	test.T(t, p.T(MustNewAmount(EUR, 123456, 2), CurrencyStandard), "€\u00A0١.٢٣٤,٥٦")
+	test.T(t, NewPrinter(tag, tzCET).Location.String(), "America/New_York")
+	test.T(t, NewPrinter(tag, tzCET).Sprintf("%v", TimeFormatter{tm, TimeShort}), "١٠:٠٤ a.m.")
+	test.T(t, NewPrinter(language.MustParse("en-u-tz-xxxxx"), nil).T(tm, TimeShort), "3:04\u202FPM")
+	test.T(t, NewPrinter(language.MustParse("en-u-tz-gmbjl"), nil).Location.String(), "Africa/Banjul")
+	test.T(t, NewPrinter(language.MustParse("en-u-tz-pgpom"), nil).T(tm, TimeShort), "1:04\u202FAM")
+	test.T(t, NewPrinter(language.English, nil).T(tm, TimeShort), "3:04\u202FPM")
+	test.T(t, NewPrinter(language.English, tzCET).T(tm, TimeShort), "5:04\u202FPM")
+
+	parts := FormatToParts(tag, SkeletonFormatter{tm, "Hm"})
+	test.T(t, parts[0], Part{PartHour, "١٠", ""})
+	test.T(t, parts[2], Part{PartMinute, "٠٤", ""})
+
+	test.T(t, FirstDayOfWeek(tag), time.Sunday)
+	unit, _ := DefaultCurrency(tag)
+	test.T(t, unit, currency.USD)
+	amount, err := ParseAmountFormat(tag, "5,00")
+	test.Error(t, err)
+	test.T(t, amount, MustNewAmount(currency.USD, 500, 2))
+
+	test.T(t, MeasurementSystem(tag), "metric")
+	test.T(t, MeasurementSystem(language.MustParse("en-US")), "ussystem")
+	test.T(t, MeasurementSystem(language.MustParse("en-GB")), "uksystem")
+	test.T(t, MeasurementSystem(language.MustParse("en-u-ms-metric")), "metric")
+}
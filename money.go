@@ -0,0 +1,103 @@
+package locale
+
+import (
+	"fmt"
+
+	"golang.org/x/text/currency"
+)
+
+var ErrPrecision = fmt.Errorf("loss of precision")
+
+// Money is the google.type.Money message, with the ISO 4217 currency code, the whole units of the amount, and the number of nano (10^-9) units of the amount. Nanos must be between -999,999,999 and +999,999,999 and must have the same sign as Units. The fields correspond to those of the generated protobuf type.
+type Money struct {
+	CurrencyCode string
+	Units        int64
+	Nanos        int32
+}
+
+// ToMoney returns the amount as google.type.Money. It returns ErrPrecision if the amount has more than nine decimals. ZeroAmount returns an empty Money.
+func (a Amount) ToMoney() (Money, error) {
+	if a == ZeroAmount {
+		return Money{}, nil
+	}
+	prec := a.digits + AmountPrecision
+	units, frac := a.amount/int64Scales[prec], a.amount%int64Scales[prec]
+	if prec <= 9 {
+		frac *= int64Scales[9-prec]
+	} else if frac%int64Scales[prec-9] != 0 {
+		return Money{}, ErrPrecision
+	} else {
+		frac /= int64Scales[prec-9]
+	}
+	return Money{a.Unit.String(), units, int32(frac)}, nil
+}
+
+func MustNewAmountFromMoney(m Money) Amount {
+	a, err := NewAmountFromMoney(m)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// NewAmountFromMoney returns the amount of a google.type.Money. It returns ErrPrecision if the nanos have more decimals than the currency's digits plus AmountPrecision. An empty Money returns ZeroAmount.
+func NewAmountFromMoney(m Money) (Amount, error) {
+	if m == (Money{}) {
+		return ZeroAmount, nil
+	} else if m.Nanos <= -1000000000 || 1000000000 <= m.Nanos {
+		return Amount{}, fmt.Errorf("invalid money: nanos out of range: %v", m.Nanos)
+	} else if m.Units < 0 && 0 < m.Nanos || 0 < m.Units && m.Nanos < 0 {
+		return Amount{}, fmt.Errorf("invalid money: units and nanos have different signs")
+	}
+
+	unit, err := currency.ParseISO(m.CurrencyCode)
+	if err != nil {
+		return Amount{}, err
+	}
+	a, err := NewZeroAmount(unit)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	prec := a.digits + AmountPrecision
+	frac := int64(m.Nanos)
+	if prec < 9 {
+		if frac%int64Scales[9-prec] != 0 {
+			return Amount{}, ErrPrecision
+		}
+		frac /= int64Scales[9-prec]
+	} else {
+		frac *= int64Scales[prec-9]
+	}
+
+	scale := int64Scales[prec]
+	if MaxAmount/scale < m.Units || m.Units == MaxAmount/scale && MaxAmount%scale < frac {
+		return Amount{}, ErrOverflow
+	} else if m.Units < -MaxAmount/scale || m.Units == -MaxAmount/scale && frac < -(MaxAmount%scale) {
+		return Amount{}, ErrUnderflow
+	}
+	a.amount = m.Units*scale + frac
+	return a, nil
+}
+
+// MinorUnits returns the amount as an integer in the currency's minor units using the currency's digits, such as 1234 for EUR 12.34 and 1234 for JPY 1234. It returns ErrPrecision if the amount has more decimals than the currency's digits.
+func (a Amount) MinorUnits() (int64, error) {
+	scale := int64Scales[AmountPrecision]
+	if a.amount%scale != 0 {
+		return 0, ErrPrecision
+	}
+	return a.amount / scale, nil
+}
+
+func MustNewAmountFromMinorUnits(unit currency.Unit, minor int64) Amount {
+	a, err := NewAmountFromMinorUnits(unit, minor)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// NewAmountFromMinorUnits returns the amount of an integer in the currency's minor units using the currency's digits, such as EUR 12.34 for 1234 and JPY 1234 for 1234.
+func NewAmountFromMinorUnits(unit currency.Unit, minor int64) (Amount, error) {
+	return NewAmount(unit, minor, GetCurrency(unit).Digits)
+}
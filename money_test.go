@@ -0,0 +1,75 @@
+package locale
+
+import (
+	"testing"
+
+	"golang.org/x/text/currency"
+
+	"github.com/tdewolff/test"
+)
+
+func TestMoney(t *testing.T) {
+	var tests = []struct {
+		a Amount
+		m Money
+	}{
+		{ZeroAmount, Money{}},
+		{MustNewAmount(EUR, 1234, 2), Money{"EUR", 12, 340000000}},
+		{MustNewAmount(EUR, -12345, 3), Money{"EUR", -12, -345000000}},
+		{MustNewAmount(EUR, -5, 1), Money{"EUR", 0, -500000000}},
+		{MustNewAmount(currency.JPY, 1234, 0), Money{"JPY", 1234, 0}},
+		{MustNewAmount(currency.MustParseISO("KWD"), 1234567, 6), Money{"KWD", 1, 234567000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.String(), func(t *testing.T) {
+			m, err := tt.a.ToMoney()
+			test.Error(t, err)
+			test.T(t, m, tt.m)
+
+			a, err := NewAmountFromMoney(tt.m)
+			test.Error(t, err)
+			test.T(t, a, tt.a)
+		})
+	}
+
+	_, err := NewAmountFromMoney(Money{"EUR", 1, 123456})
+	test.T(t, err, ErrPrecision)
+	_, err = NewAmountFromMoney(Money{"EUR", MaxAmount / 100000, 999990000})
+	test.T(t, err, ErrOverflow)
+	_, err = NewAmountFromMoney(Money{"EUR", -MaxAmount / 100000, -999990000})
+	test.T(t, err, ErrUnderflow)
+	for _, m := range []Money{{"EUR", 1, -5}, {"EUR", 0, 1000000000}, {"EU", 1, 0}} {
+		_, err = NewAmountFromMoney(m)
+		test.That(t, err != nil, "must return error for invalid money")
+	}
+}
+
+func TestMinorUnits(t *testing.T) {
+	var tests = []struct {
+		a     Amount
+		minor int64
+	}{
+		{MustNewAmount(EUR, 1234, 2), 1234},
+		{MustNewAmount(EUR, -5, 0), -500},
+		{MustNewAmount(currency.JPY, 1234, 0), 1234},
+		{MustNewAmount(currency.MustParseISO("KWD"), 1234, 3), 1234},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a.String(), func(t *testing.T) {
+			minor, err := tt.a.MinorUnits()
+			test.Error(t, err)
+			test.T(t, minor, tt.minor)
+
+			a, err := NewAmountFromMinorUnits(tt.a.Unit, tt.minor)
+			test.Error(t, err)
+			test.T(t, a, tt.a)
+		})
+	}
+
+	_, err := MustNewAmount(EUR, 12345, 3).MinorUnits()
+	test.T(t, err, ErrPrecision)
+	_, err = MustNewAmount(currency.JPY, 5, 1).MinorUnits()
+	test.T(t, err, ErrPrecision)
+	_, err = NewAmountFromMinorUnits(EUR, MaxAmount/100)
+	test.T(t, err, ErrOverflow)
+}
@@ -0,0 +1,288 @@
+package locale
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// PartType is the type of a formatted part, similar to the part types of Intl's formatToParts in JavaScript.
+type PartType string
+
+const (
+	PartLiteral           PartType = "literal"
+	PartInteger           PartType = "integer"
+	PartGroup             PartType = "group"
+	PartDecimal           PartType = "decimal"
+	PartFraction          PartType = "fraction"
+	PartMinusSign         PartType = "minusSign"
+	PartPlusSign          PartType = "plusSign"
+	PartExponentSeparator PartType = "exponentSeparator"
+	PartExponentMinusSign PartType = "exponentMinusSign"
+	PartExponentInteger   PartType = "exponentInteger"
+	PartCurrency          PartType = "currency"
+	PartUnit              PartType = "unit"
+	PartEra               PartType = "era"
+	PartYear              PartType = "year"
+	PartYearName          PartType = "yearName"
+	PartRelatedYear       PartType = "relatedYear"
+	PartQuarter           PartType = "quarter"
+	PartMonth             PartType = "month"
+	PartWeek              PartType = "week"
+	PartDay               PartType = "day"
+	PartWeekday           PartType = "weekday"
+	PartDayPeriod         PartType = "dayPeriod"
+	PartHour              PartType = "hour"
+	PartMinute            PartType = "minute"
+	PartSecond            PartType = "second"
+	PartFractionalSecond  PartType = "fractionalSecond"
+	PartTimeZoneName      PartType = "timeZoneName"
+)
+
+// Part sources of intervals, the parts of the start and end times and the parts they share.
+const (
+	SourceShared     = "shared"
+	SourceStartRange = "startRange"
+	SourceEndRange   = "endRange"
+)
+
+// Part is a typed segment of a formatted value. Source is set only for intervals.
+type Part struct {
+	Type   PartType
+	Value  string
+	Source string
+}
+
+type Parts []Part
+
+// String returns the concatenation of all parts, which is equal to the formatted value.
+func (p Parts) String() string {
+	sb := strings.Builder{}
+	for _, part := range p {
+		sb.WriteString(part.Value)
+	}
+	return sb.String()
+}
+
+// FormatToParts formats using DecimalFormatter, AmountFormatter, BigAmountFormatter, TimeFormatter, SkeletonFormatter, IntervalFormatter, DurationFormatter, DurationIntervalFormatter, or RelativeTimeFormatter for the given language and returns the typed parts, such as to style the currency symbol or the fraction differently. Output of other formatters is returned as a single literal part.
+func FormatToParts(tag language.Tag, f fmt.Formatter) Parts {
+	state := &partsState{tag: tag}
+	f.Format(state, 'v')
+	return state.parts
+}
+
+// FormatToParts is like FormatToParts using the printer's language.
+func (p *Printer) FormatToParts(f fmt.Formatter) Parts {
+	return FormatToParts(p.LanguageTag, f)
+}
+
+// partsState is a fmt.State that collects the typed parts written by formatters.
+type partsState struct {
+	tag   language.Tag
+	parts Parts
+}
+
+func (s *partsState) Language() language.Tag {
+	return s.tag
+}
+
+func (s *partsState) Write(b []byte) (int, error) {
+	if 0 < len(b) {
+		s.parts = append(s.parts, Part{Type: PartLiteral, Value: string(b)})
+	}
+	return len(b), nil
+}
+
+func (s *partsState) Width() (int, bool) {
+	return 0, false
+}
+
+func (s *partsState) Precision() (int, bool) {
+	return 0, false
+}
+
+func (s *partsState) Flag(c int) bool {
+	return false
+}
+
+// writeParts writes the formatted value to the state, or its parts if the state collects parts. Digits are written in the locale's numbering system.
+func writeParts(state fmt.State, locale Locale, b []byte, spans partSpans) {
+	if digits, ok := numberingSystems[locale.NumberingSystem]; ok && digits != "0123456789" {
+		b, spans = translateDigits(b, spans, digits)
+	}
+	if s, ok := state.(*partsState); ok {
+		s.parts = append(s.parts, spans.parts(b)...)
+		return
+	}
+	state.Write(b)
+}
+
+// translateDigits replaces the ASCII digits by those of a numbering system, such as "٠١٢٣٤٥٦٧٨٩" for Arabic-Indic digits, and moves the spans accordingly.
+func translateDigits(b []byte, spans partSpans, digits string) ([]byte, partSpans) {
+	var symbols [10]string
+	for i, r := range []rune(digits) {
+		if i < len(symbols) {
+			symbols[i] = string(r)
+		}
+	}
+
+	pos := make([]int, len(b)+1) // new position for each old position
+	c := make([]byte, 0, len(b))
+	for i := 0; i < len(b); i++ {
+		pos[i] = len(c)
+		if '0' <= b[i] && b[i] <= '9' {
+			c = append(c, symbols[b[i]-'0']...)
+		} else {
+			c = append(c, b[i])
+		}
+	}
+	pos[len(b)] = len(c)
+	for i := range spans {
+		spans[i].end = pos[spans[i].end]
+	}
+	return c, spans
+}
+
+// partSpan is the type of a formatted value up to its end position, starting at the end of the previous span.
+type partSpan struct {
+	typ    PartType
+	source string
+	end    int
+}
+
+type partSpans []partSpan
+
+func (s partSpans) last() int {
+	if len(s) == 0 {
+		return 0
+	}
+	return s[len(s)-1].end
+}
+
+// add marks the formatted value up to end with the given type, adjacent spans of the same type are merged.
+func (s partSpans) add(typ PartType, end int) partSpans {
+	if end <= s.last() {
+		return s
+	} else if 0 < len(s) && s[len(s)-1].typ == typ && s[len(s)-1].source == "" {
+		s[len(s)-1].end = end
+		return s
+	}
+	return append(s, partSpan{typ, "", end})
+}
+
+// addNumber marks a formatted number starting at start, splitting it into signs, integer, group, decimal, and fraction parts.
+func (s partSpans) addNumber(b []byte, start int, groupSym, decSym rune) partSpans {
+	s = s.add(PartLiteral, start)
+	fraction := false
+	for i := start; i < len(b); {
+		r, n := utf8.DecodeRune(b[i:])
+		typ := PartLiteral
+		if '0' <= r && r <= '9' {
+			typ = PartInteger
+			if fraction {
+				typ = PartFraction
+			}
+		} else if r == decSym {
+			typ = PartDecimal
+			fraction = true
+		} else if r == groupSym {
+			typ = PartGroup
+		} else if r == '-' {
+			typ = PartMinusSign
+		} else if r == '+' {
+			typ = PartPlusSign
+		}
+		if typ == PartGroup || 0 == len(s) || s[len(s)-1].typ != typ || s.last() != i {
+			s = append(s, partSpan{typ, "", i + n})
+		} else {
+			s[len(s)-1].end = i + n
+		}
+		i += n
+	}
+	return s
+}
+
+// insert marks n bytes inserted at pos with the given type, shifting the following spans.
+func (s partSpans) insert(typ PartType, pos, n int) partSpans {
+	i := 0
+	for i < len(s) && s[i].end <= pos {
+		i++
+	}
+	for j := i; j < len(s); j++ {
+		s[j].end += n
+	}
+	return append(s[:i], append(partSpans{{typ, "", pos + n}}, s[i:]...)...)
+}
+
+// truncate removes the spans after end.
+func (s partSpans) truncate(end int) partSpans {
+	for i := range s {
+		if end <= s[i].end {
+			s[i].end = end
+			if i == 0 || s[i-1].end < end {
+				return s[:i+1]
+			}
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// setSource sets the source of the spans starting at index i that have no source.
+func (s partSpans) setSource(i int, source string) {
+	for ; i < len(s); i++ {
+		if s[i].source == "" {
+			s[i].source = source
+		}
+	}
+}
+
+// appendSpans appends the formatted value c with its spans, which are relative to c.
+func (s partSpans) appendSpans(b, c []byte, spans partSpans) ([]byte, partSpans) {
+	start := len(b)
+	s = s.add(PartLiteral, start)
+	for _, span := range spans {
+		s = append(s, partSpan{span.typ, span.source, start + span.end})
+	}
+	return append(b, c...), s.add(PartLiteral, start+len(c))
+}
+
+func (s partSpans) parts(b []byte) Parts {
+	s = s.add(PartLiteral, len(b))
+	parts := make(Parts, 0, len(s))
+	start := 0
+	for _, span := range s {
+		if start < span.end {
+			parts = append(parts, Part{span.typ, string(b[start:span.end]), span.source})
+		}
+		start = span.end
+	}
+	return parts
+}
+
+// appendPlaceholders appends a pattern such as "{0} – {1}" where each placeholder is replaced by the formatted value and spans of the corresponding argument.
+func appendPlaceholders(b []byte, spans partSpans, pattern string, args ...func() ([]byte, partSpans)) ([]byte, partSpans) {
+	for {
+		i := strings.IndexByte(pattern, '{')
+		if i == -1 || i+2 >= len(pattern) || pattern[i+2] != '}' || pattern[i+1] < '0' || '0'+len(args) <= int(pattern[i+1]) {
+			if i == -1 {
+				i = len(pattern)
+			} else {
+				i++
+			}
+			b = append(b, pattern[:i]...)
+			spans = spans.add(PartLiteral, len(b))
+			if pattern = pattern[i:]; pattern == "" {
+				return b, spans
+			}
+			continue
+		}
+		b = append(b, pattern[:i]...)
+		spans = spans.add(PartLiteral, len(b))
+		c, cSpans := args[pattern[i+1]-'0']()
+		b, spans = spans.appendSpans(b, c, cSpans)
+		pattern = pattern[i+3:]
+	}
+}
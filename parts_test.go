@@ -0,0 +1,39 @@
+package locale
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/tdewolff/test"
+)
+
+func TestFormatToParts(t *testing.T) {
+	tm := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+	var tests = []struct {
+		tag   language.Tag
+		f     fmt.Formatter
+		parts Parts
+	}{
+		{language.English, DecimalFormatter{-1234.5}, Parts{{PartMinusSign, "-", ""}, {PartInteger, "1", ""}, {PartGroup, ",", ""}, {PartInteger, "234", ""}, {PartDecimal, ".", ""}, {PartFraction, "5", ""}}},
+		{language.English, DecimalFormatter{1.5e10}, Parts{{PartInteger, "1", ""}, {PartDecimal, ".", ""}, {PartFraction, "5", ""}, {PartExponentSeparator, "\u00A0×\u00A010", ""}, {PartExponentInteger, "¹⁰", ""}}},
+		{language.Spanish, AmountFormatter{MustNewAmount(EUR, 123456, 2), "$100.00"}, Parts{{PartInteger, "1", ""}, {PartGroup, ".", ""}, {PartInteger, "234", ""}, {PartDecimal, ",", ""}, {PartFraction, "56", ""}, {PartLiteral, "\u00A0", ""}, {PartCurrency, "€", ""}}},
+		{language.English, AmountFormatter{MustNewAmount(EUR, 5, 0), "USD 100"}, Parts{{PartCurrency, "EUR", ""}, {PartLiteral, "\u00A0", ""}, {PartInteger, "5", ""}}},
+		{language.English, AmountFormatter{MustNewAmount(EUR, 2, 0), "100 US dollars"}, Parts{{PartInteger, "2", ""}, {PartLiteral, " ", ""}, {PartCurrency, "euros", ""}}},
+		{language.English, TimeFormatter{tm, DateFull}, Parts{{PartWeekday, "Tuesday", ""}, {PartLiteral, ", ", ""}, {PartMonth, "March", ""}, {PartLiteral, " ", ""}, {PartDay, "5", ""}, {PartLiteral, ", ", ""}, {PartYear, "2024", ""}}},
+		{language.English, IntervalFormatter{tm, tm.AddDate(0, 0, 2), DateLong}, Parts{{PartMonth, "Mar", SourceShared}, {PartLiteral, " ", SourceShared}, {PartDay, "5", SourceStartRange}, {PartLiteral, "\u2009–\u2009", SourceShared}, {PartDay, "7", SourceEndRange}, {PartLiteral, ", ", SourceShared}, {PartYear, "2024", SourceShared}}},
+		{language.English, DurationFormatter{-(90 * time.Minute), DurationShort}, Parts{{PartMinusSign, "-", ""}, {PartInteger, "1", ""}, {PartLiteral, " ", ""}, {PartUnit, "hr", ""}, {PartLiteral, " ", ""}, {PartInteger, "30", ""}, {PartLiteral, " ", ""}, {PartUnit, "min", ""}}},
+		{language.English, DurationFormatter{90*time.Minute + 5*time.Second, DurationDigital}, Parts{{PartHour, "1", ""}, {PartLiteral, ":", ""}, {PartMinute, "30", ""}, {PartLiteral, ":", ""}, {PartSecond, "05", ""}}},
+		{language.English, DurationIntervalFormatter{tm, 5 * 24 * time.Hour, DurationLong}, Parts{{PartInteger, "5", ""}, {PartLiteral, " ", ""}, {PartUnit, "days", ""}}},
+		{language.English, RelativeTimeFormatter{tm.Add(2 * time.Hour), tm, RelativeLong, false}, Parts{{PartLiteral, "in ", ""}, {PartInteger, "2", ""}, {PartLiteral, " hours", ""}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.parts.String(), func(t *testing.T) {
+			parts := FormatToParts(tt.tag, tt.f)
+			test.T(t, parts, tt.parts)
+			test.T(t, parts.String(), NewPrinter(tt.tag, time.UTC).Sprintf("%v", tt.f))
+		})
+	}
+}
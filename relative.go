@@ -0,0 +1,118 @@
+package locale
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// Available relative time layouts
+const (
+	RelativeLong   string = "in 2 hours"
+	RelativeShort         = "in 2 hr."
+	RelativeNarrow        = "in 2h"
+)
+
+// RelativeTimeFormatter formats a time relative to now, such as "3 days ago" or "in 2 hours", using the largest unit from seconds up to years that fits the difference. Unless Numeric is set, names such as "yesterday" or "next week" are used where available.
+type RelativeTimeFormatter struct {
+	Time    time.Time
+	Now     time.Time // reference time, time.Now() if zero
+	Layout  string
+	Numeric bool // always use a number, such as "in 1 day" instead of "tomorrow"
+}
+
+func (f RelativeTimeFormatter) Format(state fmt.State, verb rune) {
+	locale, tag := locales["root"], language.Und
+	if languager, ok := state.(Languager); ok {
+		tag = languager.Language()
+		locale = GetLocale(tag)
+	}
+
+	var suffix string
+	switch f.Layout {
+	case RelativeLong:
+	case RelativeShort:
+		suffix = "-short"
+	case RelativeNarrow:
+		suffix = "-narrow"
+	default:
+		log.Printf("INFO: locale: unsupported relative time format: %v\n", f.Layout)
+		return
+	}
+
+	now := f.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	now = inTimezone(locale, now)
+	unit, n := relativeTimeUnit(f.Time, now)
+
+	relativeTime, ok := locale.RelativeTime[unit+suffix]
+	if !ok {
+		relativeTime = locale.RelativeTime[unit]
+	}
+
+	var b []byte
+	var spans partSpans
+	if name, ok := relativeTime.Relative[strconv.FormatInt(n, 10)]; ok && !f.Numeric {
+		b = append(b, name...)
+		writeParts(state, locale, b, spans)
+		return
+	}
+
+	count := relativeTime.Future
+	if n < 0 || n == 0 && f.Time.Before(now) {
+		count = relativeTime.Past
+		n = -n
+	}
+	b, spans = appendPlaceholders(b, spans, count.Select(pluralForm(tag, n, 0)), func() ([]byte, partSpans) {
+		c := strconv.AppendInt(nil, n, 10)
+		return c, partSpans{{PartInteger, "", len(c)}}
+	})
+	writeParts(state, locale, b, spans)
+}
+
+// relativeTimeUnit returns the field type, such as "day", and the number of units between now and t. Differences of a day or more are counted in calendar days, months, and years in the location of now.
+func relativeTimeUnit(t, now time.Time) (string, int64) {
+	// round before comparing so that 59.6 seconds is a minute instead of 60 seconds
+	d := t.Sub(now)
+	if seconds := int64(math.Round(d.Seconds())); -60 < seconds && seconds < 60 {
+		return "second", seconds
+	} else if minutes := int64(math.Round(d.Minutes())); -60 < minutes && minutes < 60 {
+		return "minute", minutes
+	} else if hours := int64(math.Round(d.Hours())); -24 < hours && hours < 24 {
+		return "hour", hours
+	}
+
+	t = t.In(now.Location())
+	y0, m0, d0 := now.Date()
+	y1, m1, d1 := t.Date()
+	days := int64(math.Round(time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC).Sub(time.Date(y0, m0, d0, 0, 0, 0, 0, time.UTC)).Hours() / 24))
+	months := int64(y1-y0)*12 + int64(m1-m0)
+	if -7 < days && days < 7 {
+		if days == 0 {
+			// almost a day within the same calendar day
+			days = 1
+			if d < 0 {
+				days = -1
+			}
+		}
+		return "day", days
+	} else if -28 < days && days < 28 {
+		return "week", int64(math.Round(float64(days) / 7.0))
+	} else if -12 < months && months < 12 {
+		if months == 0 {
+			// at least four weeks within the same month
+			months = 1
+			if days < 0 {
+				months = -1
+			}
+		}
+		return "month", months
+	}
+	return "year", months / 12
+}
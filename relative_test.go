@@ -0,0 +1,59 @@
+package locale
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/tdewolff/test"
+)
+
+func TestRelativeTimeFormatter(t *testing.T) {
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		tag     language.Tag
+		t       time.Time
+		layout  string
+		numeric bool
+		s       string
+	}{
+		{language.English, now, RelativeLong, false, "now"},
+		{language.English, now, RelativeLong, true, "in 0 seconds"},
+		{language.English, now.Add(-30 * time.Second), RelativeLong, false, "30 seconds ago"},
+		{language.English, now.Add(-time.Minute), RelativeLong, false, "1 minute ago"},
+		{language.English, now.Add(59*time.Second + 600*time.Millisecond), RelativeLong, false, "in 1 minute"},
+		{language.English, now.Add(-59*time.Second - 400*time.Millisecond), RelativeLong, false, "59 seconds ago"},
+		{language.English, now.Add(59*time.Minute + 36*time.Second), RelativeLong, false, "in 1 hour"},
+		{language.English, now.Add(23*time.Hour + 36*time.Minute), RelativeLong, false, "tomorrow"},
+		{language.English, now.Add(-23*time.Hour - 36*time.Minute), RelativeLong, true, "1 day ago"},
+		{language.English, now.Add(-11*time.Hour - 36*time.Minute), RelativeLong, true, "12 hours ago"},
+		{language.English, now.Add(2 * time.Hour), RelativeLong, false, "in 2 hours"},
+		{language.English, now.Add(2 * time.Hour), RelativeShort, false, "in 2 hr."},
+		{language.English, now.Add(2 * time.Hour), RelativeNarrow, false, "in 2h"},
+		{language.English, now.AddDate(0, 0, -1), RelativeLong, false, "yesterday"},
+		{language.English, now.AddDate(0, 0, -1), RelativeLong, true, "1 day ago"},
+		{language.English, now.AddDate(0, 0, 3), RelativeLong, false, "in 3 days"},
+		{language.English, now.AddDate(0, 0, 8), RelativeLong, false, "next week"},
+		{language.English, now.AddDate(0, 0, -15), RelativeLong, false, "2 weeks ago"},
+		{language.English, now.AddDate(0, 2, 0), RelativeLong, false, "in 2 months"},
+		{language.English, now.AddDate(0, -11, 0), RelativeShort, false, "11 mo. ago"},
+		{language.English, now.AddDate(1, 0, 0), RelativeLong, false, "next year"},
+		{language.English, now.AddDate(-3, 0, 0), RelativeLong, false, "3 years ago"},
+		{language.Spanish, now.AddDate(0, 0, -2), RelativeLong, false, "anteayer"},
+		{language.Spanish, now.AddDate(0, 0, -3), RelativeLong, false, "hace 3 días"},
+		{language.Spanish, now.Add(time.Hour), RelativeLong, false, "dentro de 1 hora"},
+		{language.Dutch, now.AddDate(0, 0, 2), RelativeLong, false, "overmorgen"},
+		{language.Dutch, now.AddDate(0, 0, -5), RelativeShort, false, "5 dgn geleden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			f := RelativeTimeFormatter{tt.t, now, tt.layout, tt.numeric}
+			test.T(t, NewPrinter(tt.tag, time.UTC).Sprintf("%v", f), tt.s)
+		})
+	}
+
+	midnight := time.Date(2025, 3, 15, 0, 10, 0, 0, time.UTC)
+	f := RelativeTimeFormatter{midnight.Add(23*time.Hour + 36*time.Minute), midnight, RelativeLong, true}
+	test.T(t, NewPrinter(language.English, time.UTC).Sprintf("%v", f), "in 1 day")
+}
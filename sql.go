@@ -0,0 +1,332 @@
+package locale
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	parseStrconv "github.com/tdewolff/parse/v2/strconv"
+)
+
+// AmountColumns maps an amount to two database columns, a NUMERIC (or DECIMAL) column with the amount and a CHAR(3) column with the ISO 4217 currency code, so that amounts can be summed and indexed by the database. Pass Numeric and Currency as arguments to Exec or as destinations to Scan, in any order.
+//
+//	cols := locale.NewAmountColumns(&price)
+//	db.Exec("INSERT INTO products (price, currency) VALUES ($1, $2)", cols.Numeric(), cols.Currency())
+//	db.QueryRow("SELECT price, currency FROM products").Scan(cols.Numeric(), cols.Currency())
+type AmountColumns struct {
+	amount *Amount
+	null   *NullAmount
+
+	number  []byte
+	unit    currency.Unit
+	scanned int // bitmask of scanned columns
+	nulls   int // bitmask of NULL columns
+}
+
+const (
+	numericColumn = 1 << iota
+	currencyColumn
+)
+
+// NewAmountColumns returns the column adapters for the given amount.
+func NewAmountColumns(a *Amount) *AmountColumns {
+	return &AmountColumns{amount: a}
+}
+
+// NewNullAmountColumns is like NewAmountColumns but both columns may be NULL.
+func NewNullAmountColumns(a *NullAmount) *AmountColumns {
+	return &AmountColumns{null: a}
+}
+
+// Numeric returns the adapter for the NUMERIC column.
+func (c *AmountColumns) Numeric() NumericColumn {
+	return NumericColumn{c}
+}
+
+// Currency returns the adapter for the currency code column.
+func (c *AmountColumns) Currency() CurrencyColumn {
+	return CurrencyColumn{c}
+}
+
+func (c *AmountColumns) get() (Amount, bool) {
+	if c.null != nil {
+		return c.null.Amount, c.null.Valid
+	}
+	return *c.amount, true
+}
+
+// scan sets the amount once both columns have been scanned.
+func (c *AmountColumns) scan(column int, isNull bool) error {
+	if c.scanned&column != 0 {
+		// start a new row
+		c.scanned, c.nulls = 0, 0
+	}
+	c.scanned |= column
+	if isNull {
+		c.nulls |= column
+	}
+	if c.scanned != numericColumn|currencyColumn {
+		return nil
+	}
+
+	if c.nulls == numericColumn|currencyColumn {
+		if c.null == nil {
+			return fmt.Errorf("unexpected NULL amount")
+		}
+		c.null.Amount, c.null.Valid = Amount{}, false
+		return nil
+	} else if c.nulls != 0 {
+		return fmt.Errorf("invalid amount: either both or neither of the amount and currency must be NULL")
+	}
+
+	amount, err := parseNumeric(c.unit, c.number)
+	if err != nil {
+		return err
+	}
+	if c.null != nil {
+		c.null.Amount, c.null.Valid = amount, true
+	} else {
+		*c.amount = amount
+	}
+	return nil
+}
+
+// NumericColumn is the NUMERIC column of AmountColumns.
+type NumericColumn struct {
+	*AmountColumns
+}
+
+// Scan implements the Scanner interface.
+func (c NumericColumn) Scan(isrc interface{}) error {
+	switch src := isrc.(type) {
+	case nil:
+		c.number = nil
+		return c.scan(numericColumn, true)
+	case []byte:
+		c.number = append(c.number[:0], src...)
+	case string:
+		c.number = append(c.number[:0], src...)
+	case int64:
+		c.number = strconv.AppendInt(c.number[:0], src, 10)
+	case float64:
+		c.number = strconv.AppendFloat(c.number[:0], src, 'f', -1, 64)
+	default:
+		return fmt.Errorf("unexpected type for numeric amount: %T", isrc)
+	}
+	return c.scan(numericColumn, false)
+}
+
+// Value implements the driver Valuer interface, it returns the amount as a decimal string such as "12.34".
+func (c NumericColumn) Value() (driver.Value, error) {
+	amount, ok := c.get()
+	if !ok {
+		return nil, nil
+	}
+	return amount.StringAmount(), nil
+}
+
+// CurrencyColumn is the currency code column of AmountColumns.
+type CurrencyColumn struct {
+	*AmountColumns
+}
+
+// Scan implements the Scanner interface.
+func (c CurrencyColumn) Scan(isrc interface{}) error {
+	var s string
+	switch src := isrc.(type) {
+	case nil:
+		c.unit = currency.Unit{}
+		return c.scan(currencyColumn, true)
+	case []byte:
+		s = string(src)
+	case string:
+		s = src
+	default:
+		return fmt.Errorf("unexpected type for currency: %T", isrc)
+	}
+
+	unit, err := currency.ParseISO(string(bytes.TrimSpace([]byte(s))))
+	if err != nil {
+		return fmt.Errorf("%v: %v", err, s)
+	}
+	c.unit = unit
+	return c.scan(currencyColumn, false)
+}
+
+// Value implements the driver Valuer interface, it returns the ISO 4217 currency code.
+func (c CurrencyColumn) Value() (driver.Value, error) {
+	amount, ok := c.get()
+	if !ok {
+		return nil, nil
+	}
+	return amount.Unit.String(), nil
+}
+
+// parseNumeric parses a NUMERIC value, such as "12.3400" or "-5", and rounds to the amount's precision.
+func parseNumeric(unit currency.Unit, b []byte) (Amount, error) {
+	b = bytes.TrimSpace(b)
+	if dot := bytes.IndexByte(b, '.'); dot != -1 {
+		// remove trailing zeros that exceed the precision
+		for dot+1 < len(b) && b[len(b)-1] == '0' {
+			b = b[:len(b)-1]
+		}
+		if dot+1 == len(b) {
+			b = b[:dot]
+		}
+	}
+	amount, dec, n := parseStrconv.ParseNumber(b, 0, '.')
+	if n == 0 || n != len(b) {
+		return Amount{}, fmt.Errorf("invalid numeric amount: %v", string(b))
+	}
+	return NewAmount(unit, amount, dec)
+}
+
+// PostgresMoney maps an amount to a PostgreSQL money column. The currency and the number of digits of a money column are fixed and determined by the database's lc_monetary setting, which uses the conventions of Tag.
+type PostgresMoney struct {
+	Amount *Amount
+	Unit   currency.Unit // currency of the column
+	Tag    language.Tag  // language of lc_monetary, such as en-US
+}
+
+// Scan implements the Scanner interface, it accepts the database's output such as "-$1,234.56".
+func (m PostgresMoney) Scan(isrc interface{}) error {
+	var s string
+	switch src := isrc.(type) {
+	case []byte:
+		s = string(src)
+	case string:
+		s = src
+	case int64:
+		s = strconv.FormatInt(src, 10)
+	case float64:
+		s = strconv.FormatFloat(src, 'f', -1, 64)
+	default:
+		return fmt.Errorf("unexpected type for money: %T", isrc)
+	}
+
+	amount, err := parseNumeric(m.Unit, []byte(s))
+	if err != nil {
+		// formatted using lc_monetary
+		if amount, err = ParseAmountFormat(m.Tag, s, m.Unit); err != nil {
+			return err
+		}
+	}
+	*m.Amount = amount
+	return nil
+}
+
+// Value implements the driver Valuer interface, it returns the amount rounded to the currency's digits as a decimal string such as "1234.56", which PostgreSQL accepts for money columns.
+func (m PostgresMoney) Value() (driver.Value, error) {
+	if m.Amount.Unit != m.Unit && *m.Amount != ZeroAmount {
+		return nil, fmt.Errorf("currencies don't match: %v != %v", m.Amount.Unit, m.Unit)
+	}
+	amount, dec, err := m.Amount.AmountRounded()
+	if err != nil {
+		return nil, err
+	}
+	return string(parseStrconv.AppendNumber(nil, amount, dec, 0, 0, '.')), nil
+}
+
+// NullPostgresMoney is like PostgresMoney but for nullable columns.
+type NullPostgresMoney struct {
+	Amount *NullAmount
+	Unit   currency.Unit
+	Tag    language.Tag
+}
+
+// Scan implements the Scanner interface.
+func (m NullPostgresMoney) Scan(isrc interface{}) error {
+	if isrc == nil {
+		m.Amount.Amount, m.Amount.Valid = Amount{}, false
+		return nil
+	} else if err := (PostgresMoney{&m.Amount.Amount, m.Unit, m.Tag}).Scan(isrc); err != nil {
+		return err
+	}
+	m.Amount.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (m NullPostgresMoney) Value() (driver.Value, error) {
+	if !m.Amount.Valid {
+		return nil, nil
+	}
+	return PostgresMoney{&m.Amount.Amount, m.Unit, m.Tag}.Value()
+}
+
+// PostgresComposite maps an amount to a PostgreSQL composite type consisting of a numeric amount and a currency code, such as
+//
+//	CREATE TYPE amount AS (amount numeric, currency char(3));
+type PostgresComposite struct {
+	Amount *Amount
+}
+
+// Scan implements the Scanner interface, it accepts the composite's text representation such as "(12.34,EUR)".
+func (c PostgresComposite) Scan(isrc interface{}) error {
+	var b []byte
+	switch src := isrc.(type) {
+	case []byte:
+		b = src
+	case string:
+		b = []byte(src)
+	default:
+		return fmt.Errorf("unexpected type for composite amount: %T", isrc)
+	}
+
+	b = bytes.TrimSpace(b)
+	if len(b) < 2 || b[0] != '(' || b[len(b)-1] != ')' {
+		return fmt.Errorf("invalid composite amount: %v", string(b))
+	}
+	fields := bytes.Split(b[1:len(b)-1], []byte(","))
+	if len(fields) != 2 {
+		return fmt.Errorf("invalid composite amount: %v", string(b))
+	}
+	for i, field := range fields {
+		if 2 <= len(field) && field[0] == '"' && field[len(field)-1] == '"' {
+			fields[i] = field[1 : len(field)-1]
+		}
+	}
+
+	cols := NewAmountColumns(c.Amount)
+	if err := cols.Currency().Scan(fields[1]); err != nil {
+		return err
+	}
+	return cols.Numeric().Scan(fields[0])
+}
+
+// Value implements the driver Valuer interface, it returns the composite's text representation such as "(12.34,EUR)". ZeroAmount returns an error since it has no currency and would not scan back.
+func (c PostgresComposite) Value() (driver.Value, error) {
+	if *c.Amount == ZeroAmount {
+		return nil, fmt.Errorf("composite amount requires a currency")
+	}
+	return "(" + c.Amount.StringAmount() + "," + c.Amount.Unit.String() + ")", nil
+}
+
+// NullPostgresComposite is like PostgresComposite but for nullable columns.
+type NullPostgresComposite struct {
+	Amount *NullAmount
+}
+
+// Scan implements the Scanner interface.
+func (c NullPostgresComposite) Scan(isrc interface{}) error {
+	if isrc == nil {
+		c.Amount.Amount, c.Amount.Valid = Amount{}, false
+		return nil
+	} else if err := (PostgresComposite{&c.Amount.Amount}).Scan(isrc); err != nil {
+		return err
+	}
+	c.Amount.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (c NullPostgresComposite) Value() (driver.Value, error) {
+	if !c.Amount.Valid {
+		return nil, nil
+	}
+	return PostgresComposite{&c.Amount.Amount}.Value()
+}
@@ -0,0 +1,208 @@
+package locale
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+
+	"github.com/tdewolff/test"
+)
+
+// memoryDriver is an in-memory database/sql driver with a single table, INSERT appends the arguments as a row and SELECT returns all rows.
+type memoryDriver struct {
+	rows [][]driver.Value
+}
+
+func (d *memoryDriver) Open(name string) (driver.Conn, error)        { return memoryConn{d}, nil }
+func (d *memoryDriver) Connect(context.Context) (driver.Conn, error) { return memoryConn{d}, nil }
+func (d *memoryDriver) Driver() driver.Driver                        { return d }
+
+type memoryConn struct{ d *memoryDriver }
+
+func (c memoryConn) Prepare(query string) (driver.Stmt, error) { return memoryStmt{c.d, query}, nil }
+func (c memoryConn) Close() error                              { return nil }
+func (c memoryConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+type memoryStmt struct {
+	d     *memoryDriver
+	query string
+}
+
+func (s memoryStmt) Close() error  { return nil }
+func (s memoryStmt) NumInput() int { return -1 }
+
+func (s memoryStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.rows = append(s.d.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s memoryStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &memoryRows{rows: s.d.rows}, nil
+}
+
+type memoryRows struct {
+	rows [][]driver.Value
+	i    int
+}
+
+func (r *memoryRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *memoryRows) Close() error { return nil }
+
+func (r *memoryRows) Next(dest []driver.Value) error {
+	if len(r.rows) <= r.i {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.i])
+	r.i++
+	return nil
+}
+
+func openMemoryDB() (*sql.DB, *memoryDriver) {
+	d := &memoryDriver{}
+	return sql.OpenDB(d), d
+}
+
+func TestAmountColumns(t *testing.T) {
+	db, d := openMemoryDB()
+	defer db.Close()
+
+	amounts := []Amount{MustNewAmount(EUR, 1234, 2), MustNewAmount(currency.JPY, -5, 0), MustNewAmount(EUR, 10005, 4)}
+	for _, amount := range amounts {
+		cols := NewAmountColumns(&amount)
+		_, err := db.Exec("INSERT", cols.Numeric(), cols.Currency())
+		test.Error(t, err)
+	}
+	test.T(t, d.rows[0], []driver.Value{"12.34", "EUR"})
+	test.T(t, d.rows[2], []driver.Value{"1.0005", "EUR"})
+
+	rows, err := db.Query("SELECT")
+	test.Error(t, err)
+	var amount Amount
+	cols := NewAmountColumns(&amount)
+	for i := 0; rows.Next(); i++ {
+		test.Error(t, rows.Scan(cols.Numeric(), cols.Currency()))
+		test.T(t, amount, amounts[i])
+	}
+	test.Error(t, rows.Err())
+
+	// columns in reverse order and as returned by DECIMAL columns
+	test.Error(t, cols.Currency().Scan([]byte("USD")))
+	test.Error(t, cols.Numeric().Scan([]byte("-7.500000")))
+	test.T(t, amount.String(), "USD -7.50")
+	test.Error(t, cols.Numeric().Scan(int64(3)))
+	test.Error(t, cols.Currency().Scan("EUR"))
+	test.T(t, amount.String(), "EUR 3.00")
+
+	test.Error(t, cols.Numeric().Scan("1,00"))
+	test.That(t, cols.Currency().Scan("EUR") != nil, "must return error for invalid number")
+	test.Error(t, cols.Currency().Scan(nil))
+	test.That(t, cols.Numeric().Scan(nil) != nil, "must return error for NULL amount")
+}
+
+func TestNullAmountColumns(t *testing.T) {
+	db, d := openMemoryDB()
+	defer db.Close()
+
+	amounts := []NullAmount{{MustNewAmount(EUR, 1234, 2), true}, {}}
+	for _, amount := range amounts {
+		cols := NewNullAmountColumns(&amount)
+		_, err := db.Exec("INSERT", cols.Numeric(), cols.Currency())
+		test.Error(t, err)
+	}
+	test.T(t, d.rows[1], []driver.Value{nil, nil})
+
+	rows, err := db.Query("SELECT")
+	test.Error(t, err)
+	amount := NullAmount{MustNewAmount(currency.USD, 1, 0), true}
+	cols := NewNullAmountColumns(&amount)
+	for i := 0; rows.Next(); i++ {
+		test.Error(t, rows.Scan(cols.Numeric(), cols.Currency()))
+		test.T(t, amount, amounts[i])
+	}
+	test.Error(t, rows.Err())
+
+	test.Error(t, cols.Numeric().Scan("5"))
+	test.That(t, cols.Currency().Scan(nil) != nil, "must return error when only one column is NULL")
+}
+
+func TestPostgresMoney(t *testing.T) {
+	var tests = []struct {
+		tag language.Tag
+		s   string
+		r   string
+	}{
+		{language.AmericanEnglish, "$1,234.56", "USD 1,234.56"},
+		{language.AmericanEnglish, "-$1,234.56", "USD -1,234.56"},
+		{language.AmericanEnglish, "1234.5", "USD 1,234.50"},
+		{language.Dutch, "$ 1.234,56", "USD 1,234.56"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			var amount Amount
+			test.Error(t, PostgresMoney{&amount, currency.USD, tt.tag}.Scan(tt.s))
+			test.T(t, amount.String(), tt.r)
+		})
+	}
+
+	amount := MustNewAmount(currency.USD, 123455, 3)
+	v, err := PostgresMoney{&amount, currency.USD, language.AmericanEnglish}.Value()
+	test.Error(t, err)
+	test.T(t, v, "123.46")
+	_, err = PostgresMoney{&amount, EUR, language.AmericanEnglish}.Value()
+	test.That(t, err != nil, "must return error for different currency")
+
+	null := NullAmount{amount, true}
+	test.Error(t, NullPostgresMoney{&null, currency.USD, language.AmericanEnglish}.Scan(nil))
+	test.T(t, null.Valid, false)
+	v, err = NullPostgresMoney{&null, currency.USD, language.AmericanEnglish}.Value()
+	test.Error(t, err)
+	test.T(t, v, nil)
+	test.Error(t, NullPostgresMoney{&null, currency.USD, language.AmericanEnglish}.Scan([]byte("$5.00")))
+	test.T(t, null.Valid, true)
+	test.T(t, null.Amount.String(), "USD 5.00")
+}
+
+func TestPostgresComposite(t *testing.T) {
+	db, _ := openMemoryDB()
+	defer db.Close()
+
+	amounts := []NullAmount{{MustNewAmount(EUR, 1234, 2), true}, {}, {MustNewAmount(currency.JPY, -5, 0), true}}
+	for _, amount := range amounts {
+		_, err := db.Exec("INSERT", NullPostgresComposite{&amount})
+		test.Error(t, err)
+	}
+
+	rows, err := db.Query("SELECT")
+	test.Error(t, err)
+	var amount NullAmount
+	for i := 0; rows.Next(); i++ {
+		test.Error(t, rows.Scan(NullPostgresComposite{&amount}))
+		test.T(t, amount, amounts[i])
+	}
+	test.Error(t, rows.Err())
+
+	var a Amount
+	v, err := PostgresComposite{&amounts[0].Amount}.Value()
+	test.Error(t, err)
+	test.T(t, v, "(12.34,EUR)")
+	_, err = PostgresComposite{&a}.Value()
+	test.That(t, err != nil, "must return error for ZeroAmount")
+	_, err = NullPostgresComposite{&NullAmount{ZeroAmount, true}}.Value()
+	test.That(t, err != nil, "must return error for ZeroAmount")
+	test.Error(t, PostgresComposite{&a}.Scan([]byte(`(12.3400,"EUR")`)))
+	test.T(t, a.String(), "EUR 12.34")
+	for _, s := range []string{"", "()", "(,)", "(12.34)", "(12.34,EUR,USD)", "12.34,EUR"} {
+		test.That(t, PostgresComposite{&a}.Scan(s) != nil, "must return error for "+s)
+	}
+}
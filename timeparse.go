@@ -0,0 +1,685 @@
+package locale
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// ParseTimeError describes a problem parsing a localized time, similar to time.ParseError.
+type ParseTimeError struct {
+	Pattern     string // CLDR pattern of the layout for the locale
+	Value       string
+	PatternElem string // field or literal of the pattern that could not be parsed
+	ValueElem   string // remainder of the value that could not be parsed
+	Offset      int    // position in the value
+	Message     string
+}
+
+func (e *ParseTimeError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("parsing time %q as %q: %s at offset %d", e.Value, e.Pattern, e.Message, e.Offset)
+	}
+	return fmt.Sprintf("parsing time %q as %q: cannot parse %q as %q", e.Value, e.Pattern, e.ValueElem, e.PatternElem)
+}
+
+// ParseTime parses a time formatted by TimeFormatter for the given language and layout, such as DateLong or DateShort + " " + TimeShort, or a Go reference layout. Month and day names, day periods, and time zone names are parsed in the language of the tag, where case and the kind of spaces are ignored. Values without a time zone are in the given location, or UTC if nil. Missing date fields default to January 1 of year 0 like for time.Parse.
+func ParseTime(tag language.Tag, layout, s string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	locale := withCalendar(GetLocale(tag), "gregorian")
+	pattern, datePattern, timePattern := layoutToPatterns(locale, layout)
+	pattern = strings.ReplaceAll(pattern, "{0}", timePattern)
+	pattern = strings.ReplaceAll(pattern, "{1}", datePattern)
+
+	p := timeParser{
+		locale:  locale,
+		pattern: pattern,
+		value:   s,
+		loc:     loc,
+		month:   1,
+		day:     1,
+		weekday: -1,
+		pm:      -1,
+		dst:     -1,
+	}
+	return p.parse()
+}
+
+// timeName is a localized name, such as of a month, and its value.
+type timeName struct {
+	name  string
+	value int
+}
+
+// timeParser holds the state of parsing a value with a CLDR pattern.
+type timeParser struct {
+	locale  Locale
+	pattern string
+	value   string
+	pos     int
+	loc     *time.Location
+
+	year, month, day     int
+	hour, minute, second int
+	nanosecond           int
+	hour12               bool
+	weekday              int    // -1 if absent
+	pm                   int    // -1 if absent, 0 for AM and 1 for PM
+	period               string // day period such as "noon" or "afternoon1"
+	periodPos            int
+	weekdayPos           int
+	dayPos               int
+
+	zones     []*time.Location // candidate locations in order of preference
+	zoneIDs   []string         // candidate locations that are loaded when needed
+	zoneAbbr  string           // required abbreviation of the location
+	offset    int              // required offset in seconds if hasOffset is set
+	hasOffset bool
+	dst       int // -1 if any, 0 for standard time and 1 for daylight time
+	zonePos   int
+}
+
+func (p *timeParser) errorf(pos int, format string, args ...interface{}) error {
+	return &ParseTimeError{
+		Pattern: p.pattern,
+		Value:   p.value,
+		Offset:  pos,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *timeParser) cannotParse(elem string) error {
+	return &ParseTimeError{
+		Pattern:     p.pattern,
+		Value:       p.value,
+		PatternElem: elem,
+		ValueElem:   p.value[p.pos:],
+		Offset:      p.pos,
+	}
+}
+
+func (p *timeParser) parse() (time.Time, error) {
+	fields := parseInputFields(p.pattern)
+	for i, field := range fields {
+		if field.symbol != 0 && strings.IndexByte("GyYuUrQqMLwWEecdDFgabBhHKkmsSAzZOvVXx", field.symbol) == -1 {
+			// other letters are written literally by TimeFormatter
+			fields[i] = inputField{literal: strings.Repeat(string(field.symbol), field.n)}
+		} else if field.symbol != 0 && strings.IndexByte("yMLEdabBhHKkmsSzvVOZXx", field.symbol) == -1 {
+			return time.Time{}, p.errorf(0, "unsupported pattern field %q", strings.Repeat(string(field.symbol), field.n))
+		}
+	}
+	for i, field := range fields {
+		if field.symbol == 0 {
+			if err := p.parseLiteral(field.literal); err != nil {
+				return time.Time{}, err
+			}
+			continue
+		}
+
+		adjacent := i+1 < len(fields) && strings.IndexByte("yMLdhHKkmsS", fields[i+1].symbol) != -1
+		if err := p.parseField(field, adjacent); err != nil {
+			return time.Time{}, err
+		}
+	}
+	if p.pos < len(p.value) {
+		return time.Time{}, p.errorf(p.pos, "extra text %q", p.value[p.pos:])
+	}
+	return p.time()
+}
+
+// prefixLen returns the length of the prefix of s that matches name, ignoring case and the kind of spaces, or -1 if it doesn't match.
+func prefixLen(s, name string) int {
+	n := 0
+	for _, r := range name {
+		c, m := utf8.DecodeRuneInString(s[n:])
+		if m == 0 || c != r && !(isInputSpace(c) && isInputSpace(r)) && !strings.EqualFold(string(c), string(r)) {
+			return -1
+		}
+		n += m
+	}
+	return n
+}
+
+// parseLiteral skips a literal where spaces are optional and may be any kind of space.
+func (p *timeParser) parseLiteral(literal string) error {
+	for _, r := range literal {
+		c, n := utf8.DecodeRuneInString(p.value[p.pos:])
+		if c == r && n != 0 || isInputSpace(c) && isInputSpace(r) {
+			p.pos += n
+		} else if !isInputSpace(r) {
+			return p.cannotParse(literal)
+		}
+	}
+	return nil
+}
+
+// parseNumber parses a number of at least one and at most maxDigits digits.
+func (p *timeParser) parseNumber(elem string, maxDigits int) (int, int, error) {
+	num, n := 0, 0
+	for n < maxDigits && p.pos+n < len(p.value) && '0' <= p.value[p.pos+n] && p.value[p.pos+n] <= '9' {
+		num = num*10 + int(p.value[p.pos+n]-'0')
+		n++
+	}
+	if n == 0 {
+		return 0, 0, p.cannotParse(elem)
+	}
+	p.pos += n
+	return num, n, nil
+}
+
+// parseName parses the longest matching name, names of equal length with different values are ambiguous.
+func (p *timeParser) parseName(elem, kind string, names []timeName) (int, error) {
+	value, n, ambiguous := -1, 0, false
+	for _, name := range names {
+		if name.name == "" || len(name.name) < n {
+			continue
+		} else if m := prefixLen(p.value[p.pos:], name.name); m == -1 || m < n {
+			continue
+		} else if m == n && name.value != value {
+			ambiguous = true
+		} else if n < m {
+			value, n, ambiguous = name.value, m, false
+		}
+	}
+	if n == 0 {
+		return 0, p.cannotParse(elem)
+	} else if ambiguous {
+		return 0, p.errorf(p.pos, "ambiguous %s %q", kind, p.value[p.pos:p.pos+n])
+	}
+	p.pos += n
+	return value, nil
+}
+
+func (p *timeParser) parseField(field inputField, adjacent bool) error {
+	elem := strings.Repeat(string(field.symbol), field.n)
+	maxDigits := 2
+	if adjacent && 2 < field.n {
+		maxDigits = field.n
+	}
+	pos := p.pos
+	switch field.symbol {
+	case 'y':
+		if field.n == 2 {
+			year, n, err := p.parseNumber(elem, 2)
+			if err != nil {
+				return err
+			} else if n != 2 {
+				return p.errorf(pos, "two-digit year expected")
+			}
+			// two-digit years are in 1969-2068 like for time.Parse
+			p.year = 1900 + year
+			if p.year < 1969 {
+				p.year += 100
+			}
+			return nil
+		}
+		if !adjacent {
+			maxDigits = 9
+		} else if maxDigits < 4 {
+			maxDigits = 4
+		}
+		year, _, err := p.parseNumber(elem, maxDigits)
+		if err != nil {
+			return err
+		}
+		p.year = year
+	case 'M', 'L':
+		if field.n < 3 {
+			month, _, err := p.parseNumber(elem, maxDigits)
+			if err != nil {
+				return err
+			} else if month < 1 || 12 < month {
+				return p.errorf(pos, "month out of range")
+			}
+			p.month = month
+			return nil
+		}
+		symbols := p.locale.MonthSymbol
+		if field.symbol == 'L' {
+			symbols = p.locale.MonthStandaloneSymbol
+		}
+		names := make([]timeName, 0, 24)
+		for i, symbol := range symbols {
+			if field.n == 5 {
+				names = append(names, timeName{symbol.Narrow, i + 1})
+			} else {
+				names = append(names, timeName{symbol.Wide, i + 1}, timeName{symbol.Abbreviated, i + 1})
+			}
+		}
+		month, err := p.parseName(elem, "month name", names)
+		if err != nil {
+			return err
+		}
+		p.month = month
+	case 'E':
+		names := make([]timeName, 0, 14)
+		for i, symbol := range p.locale.DaySymbol {
+			if field.n == 5 {
+				names = append(names, timeName{symbol.Narrow, i})
+			} else {
+				names = append(names, timeName{symbol.Wide, i}, timeName{symbol.Abbreviated, i})
+			}
+		}
+		weekday, err := p.parseName(elem, "weekday name", names)
+		if err != nil {
+			return err
+		}
+		p.weekday, p.weekdayPos = weekday, pos
+	case 'd':
+		day, _, err := p.parseNumber(elem, maxDigits)
+		if err != nil {
+			return err
+		} else if day < 1 || 31 < day {
+			return p.errorf(pos, "day out of range")
+		}
+		p.day, p.dayPos = day, pos
+	case 'a', 'b', 'B':
+		periods := make([]string, 0, len(p.locale.DayPeriodSymbol))
+		for period := range p.locale.DayPeriodSymbol {
+			if field.symbol == 'B' || period == "am" || period == "pm" || field.symbol == 'b' && (period == "noon" || period == "midnight") {
+				periods = append(periods, period)
+			}
+		}
+		sort.Strings(periods)
+		names := make([]timeName, 0, 3*len(periods))
+		for i, period := range periods {
+			symbol := p.locale.DayPeriodSymbol[period]
+			names = append(names, timeName{symbol.Wide, i}, timeName{symbol.Abbreviated, i}, timeName{symbol.Narrow, i})
+		}
+		i, err := p.parseName(elem, "day period", names)
+		if err != nil {
+			return err
+		}
+		if periods[i] == "am" {
+			p.pm = 0
+		} else if periods[i] == "pm" {
+			p.pm = 1
+		} else {
+			p.period = periods[i]
+		}
+		p.periodPos = pos
+	case 'h', 'H', 'K', 'k':
+		hour, _, err := p.parseNumber(elem, maxDigits)
+		if err != nil {
+			return err
+		}
+		switch field.symbol {
+		case 'h':
+			if hour < 1 || 12 < hour {
+				return p.errorf(pos, "hour out of range")
+			}
+			p.hour, p.hour12 = hour%12, true
+		case 'K':
+			if 11 < hour {
+				return p.errorf(pos, "hour out of range")
+			}
+			p.hour, p.hour12 = hour, true
+		case 'H':
+			if 23 < hour {
+				return p.errorf(pos, "hour out of range")
+			}
+			p.hour = hour
+		case 'k':
+			if hour < 1 || 24 < hour {
+				return p.errorf(pos, "hour out of range")
+			}
+			p.hour = hour % 24
+		}
+	case 'm':
+		minute, _, err := p.parseNumber(elem, maxDigits)
+		if err != nil {
+			return err
+		} else if 59 < minute {
+			return p.errorf(pos, "minute out of range")
+		}
+		p.minute = minute
+	case 's':
+		second, _, err := p.parseNumber(elem, maxDigits)
+		if err != nil {
+			return err
+		} else if 59 < second {
+			return p.errorf(pos, "second out of range")
+		}
+		p.second = second
+	case 'S':
+		// fractional seconds have exactly as many digits as the field, digits beyond nanoseconds are ignored
+		n := 0
+		for n < field.n && p.pos+n < len(p.value) && '0' <= p.value[p.pos+n] && p.value[p.pos+n] <= '9' {
+			if n < 9 {
+				p.nanosecond = p.nanosecond*10 + int(p.value[p.pos+n]-'0')
+			}
+			n++
+		}
+		if n != field.n {
+			return p.cannotParse(elem)
+		}
+		for ; n < 9; n++ {
+			p.nanosecond *= 10
+		}
+		p.pos += field.n
+	case 'z', 'v':
+		p.zonePos = pos
+		if p.parseZoneName(field.symbol == 'v', field.n == 4) {
+			return nil
+		} else if !p.parseZoneOffset(true) {
+			return p.cannotParse(elem)
+		}
+	case 'V':
+		p.zonePos = pos
+		switch field.n {
+		case 1:
+			abbr := p.parseZoneAbbr()
+			if abbr == "" {
+				return p.cannotParse(elem)
+			} else if abbr == "GMT" || abbr == "UTC" {
+				p.zones = []*time.Location{time.UTC}
+			} else {
+				p.zoneAbbr = abbr
+			}
+		case 2:
+			n := 0
+			for p.pos+n < len(p.value) {
+				if c := p.value[p.pos+n]; c != '/' && c != '_' && c != '-' && c != '+' && !('0' <= c && c <= '9') && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') {
+					break
+				}
+				n++
+			}
+			if n == 0 {
+				return p.cannotParse(elem)
+			}
+			zone, err := p.loadLocation(p.value[p.pos : p.pos+n])
+			if err != nil {
+				return p.errorf(pos, "unknown time zone %q", p.value[p.pos:p.pos+n])
+			}
+			p.pos += n
+			p.zones = []*time.Location{zone}
+		case 3:
+			if !p.parseZoneCity() {
+				return p.cannotParse(elem)
+			}
+		default:
+			if !p.parseZoneOffset(true) {
+				return p.cannotParse(elem)
+			}
+		}
+	case 'O', 'Z', 'X', 'x':
+		p.zonePos = pos
+		gmt := field.symbol == 'O' || field.symbol == 'Z' && field.n == 4
+		if !p.parseZoneOffset(gmt) {
+			return p.cannotParse(elem)
+		}
+	default:
+		return p.errorf(pos, "unsupported pattern field %q", elem)
+	}
+	return nil
+}
+
+// loadLocation returns the location for an IANA or CLDR time zone identifier, where the given location is preferred.
+func (p *timeParser) loadLocation(id string) (*time.Location, error) {
+	if id == p.loc.String() || id == getTimezone(p.locale, time.Time{}.In(p.loc)) {
+		return p.loc, nil
+	}
+	return time.LoadLocation(id)
+}
+
+// parseZoneName parses a generic or specific metazone name, such as "Pacific Time" or "PST", and sets the locations of the metazone as candidates.
+func (p *timeParser) parseZoneName(generic, long bool) bool {
+	ids := make([]string, 0, len(p.locale.Metazones))
+	for id := range p.locale.Metazones {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	names := make([]timeName, 0, 2*len(ids))
+	for i, id := range ids {
+		metazone := p.locale.Metazones[id]
+		if generic && long {
+			names = append(names, timeName{metazone.Generic.Long, 3 * i})
+		} else if generic {
+			names = append(names, timeName{metazone.Generic.Short, 3 * i})
+		} else if long {
+			names = append(names, timeName{metazone.Standard.Long, 3*i + 1}, timeName{metazone.Daylight.Long, 3*i + 2})
+		} else {
+			names = append(names, timeName{metazone.Standard.Short, 3*i + 1}, timeName{metazone.Daylight.Short, 3*i + 2})
+		}
+	}
+	for i := 0; i < len(names); i++ {
+		if names[i].name == "∅∅∅" {
+			names[i].name = ""
+		}
+	}
+	value, err := p.parseName("", "", names)
+	if err != nil {
+		return false
+	}
+
+	metazone := ids[value/3]
+	p.dst = value%3 - 1
+	p.zones, p.zoneIDs = nil, nil
+	if metazones[getTimezone(p.locale, time.Time{}.In(p.loc))] == metazone {
+		p.zones = append(p.zones, p.loc)
+	}
+	// the golden zone of the metazone is preferred over the other zones and aliases
+	golden := metazoneZones[metazone]
+	for id, mz := range metazones {
+		if mz == metazone && id != golden {
+			p.zoneIDs = append(p.zoneIDs, id)
+		}
+	}
+	sort.Strings(p.zoneIDs)
+	if golden != "" {
+		p.zoneIDs = append([]string{golden}, p.zoneIDs...)
+	}
+	return true
+}
+
+// parseZoneCity parses the exemplar city of a time zone, such as "Los Angeles".
+func (p *timeParser) parseZoneCity() bool {
+	ids := make([]string, 0, len(IANATimezones)+len(p.locale.TimezoneCity))
+	ids = append(ids, IANATimezones...)
+	for id := range p.locale.TimezoneCity {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	names := make([]timeName, 0, len(ids))
+	for i, id := range ids {
+		city, ok := p.locale.TimezoneCity[id]
+		if !ok {
+			city = strings.ReplaceAll(id[strings.LastIndexByte(id, '/')+1:], "_", " ")
+		}
+		names = append(names, timeName{city, i})
+	}
+	pos := p.pos
+	i, err := p.parseName("", "", names)
+	if err != nil {
+		return false
+	}
+	zone, err := p.loadLocation(ids[i])
+	if err != nil {
+		p.pos = pos
+		return false
+	}
+	p.zones = []*time.Location{zone}
+	return true
+}
+
+// parseZoneAbbr parses a time zone abbreviation such as "PST".
+func (p *timeParser) parseZoneAbbr() string {
+	n := 0
+	for p.pos+n < len(p.value) && 'A' <= p.value[p.pos+n] && p.value[p.pos+n] <= 'Z' {
+		n++
+	}
+	abbr := p.value[p.pos : p.pos+n]
+	p.pos += n
+	return abbr
+}
+
+// parseZoneOffset parses a time zone offset such as "-0700", "-07:00", or "Z", or if gmt is set as "GMT-7", "GMT-07:00", "GMT", or "PDT-07:00".
+func (p *timeParser) parseZoneOffset(gmt bool) bool {
+	pos := p.pos
+	abbr := ""
+	if gmt {
+		if abbr = p.parseZoneAbbr(); abbr == "" {
+			return false
+		} else if abbr == "UTC" {
+			abbr = "GMT"
+		}
+	} else if strings.HasPrefix(p.value[p.pos:], "Z") {
+		p.pos++
+		p.zones, p.zoneIDs = []*time.Location{p.loc, time.UTC}, nil
+		p.offset, p.hasOffset = 0, true
+		return true
+	}
+
+	sign := 0
+	if strings.HasPrefix(p.value[p.pos:], "+") {
+		sign = 1
+		p.pos++
+	} else if strings.HasPrefix(p.value[p.pos:], "-") {
+		sign = -1
+		p.pos++
+	} else if strings.HasPrefix(p.value[p.pos:], "−") {
+		sign = -1
+		p.pos += len("−")
+	}
+
+	hours, minutes, seconds := 0, 0, 0
+	if sign == 0 {
+		if !gmt {
+			p.pos = pos
+			return false
+		} else if abbr != "GMT" {
+			// abbreviation only, the location must have it
+			p.zones, p.zoneIDs, p.zoneAbbr = nil, nil, abbr
+			return true
+		}
+	} else {
+		num, n, err := p.parseNumber("", 6)
+		if err != nil {
+			p.pos = pos
+			return false
+		}
+		switch n {
+		case 1, 2:
+			hours = num
+			if strings.HasPrefix(p.value[p.pos:], ":") {
+				p.pos++
+				if minutes, n, err = p.parseNumber("", 2); err != nil || n != 2 {
+					p.pos = pos
+					return false
+				}
+				if strings.HasPrefix(p.value[p.pos:], ":") {
+					p.pos++
+					if seconds, n, err = p.parseNumber("", 2); err != nil || n != 2 {
+						p.pos = pos
+						return false
+					}
+				}
+			}
+		case 4:
+			hours, minutes = num/100, num%100
+		case 6:
+			hours, minutes, seconds = num/10000, num/100%100, num%100
+		default:
+			p.pos = pos
+			return false
+		}
+		if 23 < hours || 59 < minutes || 59 < seconds {
+			p.pos = pos
+			return false
+		}
+	}
+
+	offset := sign * (hours*3600 + minutes*60 + seconds)
+	name := abbr
+	if abbr == "GMT" {
+		name = ""
+	}
+	fixed := time.FixedZone(name, offset)
+	if offset == 0 && name == "" {
+		fixed = time.UTC
+	}
+	p.zones, p.zoneIDs = []*time.Location{p.loc, fixed}, nil
+	p.offset, p.hasOffset = offset, true
+	if name != "" {
+		p.zoneAbbr = name
+	}
+	return true
+}
+
+// time returns the parsed time in the first candidate location that agrees with the parsed time zone.
+func (p *timeParser) time() (time.Time, error) {
+	hour := p.hour
+	if p.hour12 {
+		if p.pm == 1 {
+			hour += 12
+		} else if p.period != "" {
+			// select the hour in the range of the day period, such as 3 in the afternoon
+			found := false
+			for _, h := range []int{hour, hour + 12} {
+				if inDayPeriod(p.locale, p.period, h*60+p.minute) {
+					hour, found = h, true
+					break
+				}
+			}
+			if !found {
+				return time.Time{}, p.errorf(p.periodPos, "day period does not match hour")
+			}
+		}
+	}
+
+	zones, ids := p.zones, p.zoneIDs
+	if zones == nil && ids == nil {
+		zones = []*time.Location{p.loc}
+	}
+	for i := 0; i < len(zones)+len(ids); i++ {
+		var zone *time.Location
+		if i < len(zones) {
+			zone = zones[i]
+		} else if loc, err := time.LoadLocation(ids[i-len(zones)]); err == nil {
+			zone = loc
+		} else {
+			continue
+		}
+
+		t := time.Date(p.year, time.Month(p.month), p.day, hour, p.minute, p.second, p.nanosecond, zone)
+		abbr, offset := t.Zone()
+		if p.hasOffset && offset != p.offset || p.zoneAbbr != "" && abbr != p.zoneAbbr || p.dst != -1 && t.IsDST() != (p.dst == 1) {
+			continue
+		} else if t.Day() != p.day {
+			return time.Time{}, p.errorf(p.dayPos, "day out of range")
+		} else if p.weekday != -1 && int(t.Weekday()) != p.weekday {
+			return time.Time{}, p.errorf(p.weekdayPos, "weekday does not match date")
+		}
+		return t, nil
+	}
+	return time.Time{}, p.errorf(p.zonePos, "time zone does not match date")
+}
+
+// inDayPeriod returns true if the minutes since midnight are in the day period.
+func inDayPeriod(locale Locale, period string, d int) bool {
+	rule, ok := locale.DayPeriodRules[period]
+	if !ok {
+		switch period {
+		case "midnight":
+			rule = DayPeriodRule{0, -1}
+		case "noon":
+			rule = DayPeriodRule{12 * 60, -1}
+		default:
+			return false
+		}
+	}
+	if rule.To == -1 {
+		return d == rule.From
+	} else if rule.To < rule.From {
+		return rule.From <= d || d < rule.To
+	}
+	return rule.From <= d && d < rule.To
+}
@@ -0,0 +1,127 @@
+package locale
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/tdewolff/test"
+)
+
+func TestParseTime(t *testing.T) {
+	losAngeles, err := time.LoadLocation("America/Los_Angeles")
+	test.Error(t, err)
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	test.Error(t, err)
+
+	tests := []struct {
+		tag    language.Tag
+		layout string
+		s      string
+		loc    *time.Location
+		t      time.Time
+	}{
+		{language.English, DateFull + " " + TimeFull, "Thursday, January 2, 2025, 12:30:00 PM Pacific Standard Time", tzPST, time.Date(2025, 1, 2, 12, 30, 0, 0, tzPST)},
+		{language.English, DateLong + " " + TimeLong, "January 2, 2025, 12:30:00 PM PST", tzPST, time.Date(2025, 1, 2, 12, 30, 0, 0, tzPST)},
+		{language.English, DateMedium + " " + TimeMedium, "jan 2, 2025, 12:30:00 am", nil, time.Date(2025, 1, 2, 0, 30, 0, 0, time.UTC)},
+		{language.English, DateShort + " " + TimeShort, "1/2/25, 3:04 PM", tzPST, time.Date(2025, 1, 2, 15, 4, 0, 0, tzPST)},
+		{language.English, DateShort, "12/31/68", nil, time.Date(2068, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{language.English, TimeShort, "3:04 AM", nil, time.Date(0, 1, 1, 3, 4, 0, 0, time.UTC)},
+		{language.English, DateLong + " " + TimeLong, "July 4, 2025, 9:00:00 AM PDT", nil, time.Date(2025, 7, 4, 9, 0, 0, 0, losAngeles)},
+		{language.English, DateLong + " " + TimeFull, "July 4, 2025, 9:00:00 AM Pacific Daylight Time", nil, time.Date(2025, 7, 4, 9, 0, 0, 0, losAngeles)},
+		{language.English, "January 2, 2006 15:04 Mountain Time", "January 2, 2025, 09:00 Pacific Time", nil, time.Date(2025, 1, 2, 9, 0, 0, 0, losAngeles)},
+		{language.English, "2006-01-02 15:04 -07:00", "2025-01-02, 09:00 +01:00", nil, time.Date(2025, 1, 2, 9, 0, 0, 0, time.FixedZone("", 3600))},
+		{language.English, "2006-01-02 15:04 -0700", "2025-01-02, 09:00 -0800", tzPST, time.Date(2025, 1, 2, 9, 0, 0, 0, tzPST)},
+		{language.English, "2006-01-02 15:04 GMT-07:00", "2025-01-02, 09:00 GMT+05:30", nil, time.Date(2025, 1, 2, 9, 0, 0, 0, time.FixedZone("", 5*3600+1800))},
+		{language.English, "January 2, 2006 15:04 Mountain Standard Time", "January 2, 2025, 09:00 Indochina Time", nil, time.Date(2025, 1, 2, 9, 0, 0, 0, bangkok)},
+		{language.English, "2006-01-02 15:04 America/Phoenix", "2025-01-02, 09:00 America/Los_Angeles", nil, time.Date(2025, 1, 2, 9, 0, 0, 0, losAngeles)},
+		{language.English, "2006-01-02 15:04 Phoenix", "2025-01-02, 09:00 Los Angeles", nil, time.Date(2025, 1, 2, 9, 0, 0, 0, losAngeles)},
+		{language.English, "2006-01-02 03:04:05.000 PM", "2025-01-02, 09:00:05.123 AM", nil, time.Date(2025, 1, 2, 9, 0, 5, 123000000, time.UTC)},
+		{language.Spanish, DateFull + " " + TimeFull, "jueves, 2 de enero de 2025, 12:30:00 (hora estándar de Europa central)", tzCET, time.Date(2025, 1, 2, 12, 30, 0, 0, tzCET)},
+		{language.Spanish, DateLong, "2 de Enero de 2025", nil, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{language.Spanish, DateMedium + " " + TimeMedium, "2 ene 2025, 12:30:00", tzCET, time.Date(2025, 1, 2, 12, 30, 0, 0, tzCET)},
+		{language.Dutch, DateFull, "donderdag 2 januari 2025", nil, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{language.Dutch, DateShort + " " + TimeShort, "02-01-2025, 23:59", nil, time.Date(2025, 1, 2, 23, 59, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			tm, err := ParseTime(tt.tag, tt.layout, tt.s, tt.loc)
+			test.Error(t, err)
+			test.T(t, tm.Format(time.RFC3339Nano), tt.t.Format(time.RFC3339Nano))
+			test.T(t, tm.Location().String(), tt.t.Location().String())
+		})
+	}
+}
+
+func TestParseTimeRoundtrip(t *testing.T) {
+	tm := time.Date(2025, 3, 5, 14, 30, 15, 0, tzCET)
+	for _, p := range []*Printer{en, es, nl} {
+		for _, layout := range []string{DateFull + " " + TimeFull, DateLong + " " + TimeLong, DateMedium + " " + TimeMedium, DateShort + " " + TimeShort} {
+			s := p.T(tm, layout)
+			t.Run(s, func(t *testing.T) {
+				r, err := ParseTime(p.LanguageTag, layout, s, p.Location)
+				test.Error(t, err)
+				if layout == DateShort+" "+TimeShort {
+					test.That(t, r.Equal(tm.Truncate(time.Minute)), r, "!=", tm)
+				} else {
+					test.That(t, r.Equal(tm), r, "!=", tm)
+				}
+			})
+		}
+	}
+}
+
+func TestParseTimeLayout(t *testing.T) {
+	tm := time.Date(2025, 3, 5, 14, 30, 15, 123000000, time.UTC)
+	tests := []struct {
+		tag    string
+		layout string
+		s      string
+	}{
+		{"en-GB", "2006-01-02 15:04:05.000", "2025-03-05, 14:30:15.123"},
+		{"en-u-hc-h23", "2006-01-02 15:04:05.000", "2025-03-05, 14:30:15.123"},
+		{"nl", "2006-01-02 15:04:05.000", "2025-03-05, 14:30:15.123"},
+		{"en", "2006-01-02 3:04:05.000 PM", "2025-03-05, 2:30:15.123 PM"},
+		{"en-GB-u-hc-h12", "2006-01-02 3:04:05.000 PM", "2025-03-05, 2:30:15.123 PM"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag+"_"+tt.layout, func(t *testing.T) {
+			p := NewPrinter(language.MustParse(tt.tag), time.UTC)
+			s := p.T(tm, tt.layout)
+			test.T(t, s, tt.s)
+
+			r, err := ParseTime(p.LanguageTag, tt.layout, s, p.Location)
+			test.Error(t, err)
+			test.That(t, r.Equal(tm), r, "!=", tm)
+		})
+	}
+}
+
+func TestParseTimeError(t *testing.T) {
+	tests := []struct {
+		tag    language.Tag
+		layout string
+		s      string
+		err    string
+	}{
+		{language.English, DateLong, "Enero 2, 2025", `parsing time "Enero 2, 2025" as "MMMM d, y": cannot parse "Enero 2, 2025" as "MMMM"`},
+		{language.English, DateLong, "January 32, 2025", `parsing time "January 32, 2025" as "MMMM d, y": day out of range at offset 8`},
+		{language.English, DateLong, "February 29, 2025", `parsing time "February 29, 2025" as "MMMM d, y": day out of range at offset 9`},
+		{language.English, DateFull, "Friday, January 2, 2025", `parsing time "Friday, January 2, 2025" as "EEEE, MMMM d, y": weekday does not match date at offset 0`},
+		{language.English, DateShort, "1/2/2025", `parsing time "1/2/2025" as "M/d/yy": extra text "25" at offset 6`},
+		{language.English, TimeShort, "13:04 PM", `parsing time "13:04 PM" as "h:mm\u202fa": hour out of range at offset 0`},
+		{language.English, TimeShort, "3:04 PN", `parsing time "3:04 PN" as "h:mm\u202fa": cannot parse "PN" as "a"`},
+		{language.English, TimeLong, "3:04:05 PM XYZ", `parsing time "3:04:05 PM XYZ" as "h:mm:ss\u202fa z": time zone does not match date at offset 11`},
+		{language.English, "2006-01-02 03:04:05.000 PM", "2025-01-02, 09:00:05.12 AM", `parsing time "2025-01-02, 09:00:05.12 AM" as "yyyy-MM-dd, hh:mm:ss.SSS a": cannot parse "12 AM" as "SSS"`},
+		{language.English, "Q1 2006", "Q1 2025", `parsing time "Q1 2025" as "QM yyyy": unsupported pattern field "Q" at offset 0`},
+		{language.Spanish, DateMedium, "2 enr 2025", `parsing time "2 enr 2025" as "d MMM y": cannot parse "enr 2025" as "MMM"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			_, err := ParseTime(tt.tag, tt.layout, tt.s, nil)
+			test.That(t, err != nil, "must return error")
+			test.T(t, err.Error(), tt.err)
+		})
+	}
+}
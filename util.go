@@ -1,9 +1,16 @@
 package locale
 
 import (
+	"fmt"
+	"log"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/currency"
+	"golang.org/x/text/feature/plural"
 	"golang.org/x/text/language"
 )
 
@@ -26,16 +33,332 @@ func GetSupportedTag(tag language.Tag) language.Tag {
 	return language.Make(loc)
 }
 
+// GetLocale returns the locale data for the tag or its closest supported parent, with the preferences of the tag's region and Unicode locale extensions applied.
 func GetLocale(tag language.Tag) Locale {
-	tag = GetSupportedTag(tag)
-	loc := strings.ReplaceAll(tag.String(), "-", "_")
-	return locales[loc]
+	loc := strings.ReplaceAll(GetSupportedTag(tag).String(), "-", "_")
+	return withExtensions(locales[loc], tag)
+}
+
+// calendarType returns the calendar of the -u-ca- extension including all its subtags, such as "islamic-civil", whereas TypeForKey only returns the first subtag.
+func calendarType(tag language.Tag) string {
+	ext, ok := tag.Extension('u')
+	if !ok {
+		return ""
+	}
+	subtags := strings.Split(ext.String(), "-")
+	for i, subtag := range subtags {
+		if subtag != "ca" {
+			continue
+		}
+		j := i + 1
+		for j < len(subtags) && len(subtags[j]) != 2 {
+			j++
+		}
+		return strings.Join(subtags[i+1:j], "-")
+	}
+	return ""
+}
+
+// withExtensions returns the locale with the week data, hour cycle, currency, and measurement system of the tag's region, overridden by the tag's Unicode locale extensions: the calendar (-u-ca-), numbering system (-u-nu-), hour cycle (-u-hc-), first day of the week (-u-fw-), currency (-u-cu-), measurement system (-u-ms-), and time zone (-u-tz-).
+func withExtensions(locale Locale, tag language.Tag) Locale {
+	if calendar := calendarType(tag); calendar != "" {
+		locale = withCalendar(locale, calendar)
+	}
+	if numberingSystem := tag.TypeForKey("nu"); numberingSystem != "" {
+		if _, ok := numberingSystems[numberingSystem]; ok {
+			locale.NumberingSystem = numberingSystem
+		} else {
+			log.Printf("INFO: locale: unsupported numbering system: %v\n", numberingSystem)
+		}
+	}
+
+	week := getWeekData(tag)
+	locale.FirstDay, locale.MinDays = week.FirstDay, week.MinDays
+	hours := getTimeData(tag)
+	locale.HourCycle, locale.AllowedHours = hours.Preferred, hours.Allowed
+	if symbol, ok := hourCycles[tag.TypeForKey("hc")]; ok {
+		locale = withHourCycle(locale, symbol)
+	}
+
+	locale.PreferredCurrency = ""
+	if code := tag.TypeForKey("cu"); code != "" {
+		if unit, err := currency.ParseISO(code); err == nil {
+			locale.PreferredCurrency = unit.String()
+		} else {
+			log.Printf("INFO: locale: unsupported currency: %v\n", code)
+		}
+	}
+	locale.MeasurementSystem = MeasurementSystem(tag)
+
+	locale.Timezone = ""
+	if timezone := tag.TypeForKey("tz"); timezone != "" {
+		if id, ok := bcp47Timezones[timezone]; ok {
+			locale.Timezone = id
+		} else {
+			log.Printf("INFO: locale: unsupported time zone: %v\n", timezone)
+		}
+	}
+	return locale
+}
+
+type registeredCurrency struct {
+	CurrencyInfo
+	names map[language.Tag]Currency
+}
+
+var registeredCurrenciesMu sync.RWMutex
+var registeredCurrencies = map[string]registeredCurrency{}
+
+// RegisterCurrency registers a custom currency or overrides the CLDR data of a currency, setting its digits, rounding, cash digits and cash rounding, and its names and symbols per language. Names and symbols are looked up for the language and its parents and fall back to CLDR for empty fields. Since currency.Unit can only represent ISO 4217 codes, custom currencies such as loyalty points must use an unused code such as XTS or one of the other X codes. Currencies with more than 15 digits are only supported by BigAmount.
+func RegisterCurrency(unit currency.Unit, info CurrencyInfo, names map[language.Tag]Currency) error {
+	if info.Digits < 0 || len(int64Scales) <= info.Digits {
+		return fmt.Errorf("unsupported currency digits: %v", info.Digits)
+	} else if info.Rounding != 0 && info.Rounding != 1 && info.Rounding != 10 && info.Rounding != 100 {
+		return fmt.Errorf("unsupported currency rounding: %v", info.Rounding)
+	} else if info.CashDigits < 0 || info.Digits < info.CashDigits {
+		return fmt.Errorf("unsupported currency cash digits: %v", info.CashDigits)
+	} else if info.CashRounding < 0 {
+		return fmt.Errorf("unsupported currency cash rounding: %v", info.CashRounding)
+	}
+
+	registeredCurrenciesMu.Lock()
+	defer registeredCurrenciesMu.Unlock()
+	registeredCurrencies[unit.String()] = registeredCurrency{info, names}
+	return nil
+}
+
+// UnregisterCurrency removes a currency registered by RegisterCurrency.
+func UnregisterCurrency(unit currency.Unit) {
+	registeredCurrenciesMu.Lock()
+	defer registeredCurrenciesMu.Unlock()
+	delete(registeredCurrencies, unit.String())
 }
 
 func GetCurrency(unit currency.Unit) CurrencyInfo {
+	registeredCurrenciesMu.RLock()
+	r, ok := registeredCurrencies[unit.String()]
+	registeredCurrenciesMu.RUnlock()
+	if ok {
+		return r.CurrencyInfo
+	}
+
 	d, ok := currencies[unit.String()]
 	if !ok {
 		d, _ = currencies["DEFAULT"]
 	}
 	return d
 }
+
+// getCurrencyNames returns the names and symbols of a currency for the given language, preferring registered currencies over CLDR data.
+func getCurrencyNames(tag language.Tag, locale Locale, iso string) Currency {
+	names := locale.Currency[iso]
+	registeredCurrenciesMu.RLock()
+	r, ok := registeredCurrencies[iso]
+	registeredCurrenciesMu.RUnlock()
+	if !ok {
+		return names
+	}
+	for {
+		if v, ok := r.names[tag]; ok {
+			if v.Name == "" {
+				v.Name = names.Name
+			}
+			if v.Standard == "" {
+				v.Standard = names.Standard
+			}
+			if v.Narrow == "" {
+				v.Narrow = names.Narrow
+			}
+			if v.PluralName.One == "" {
+				v.PluralName.One = names.PluralName.One
+			}
+			if v.PluralName.Other == "" {
+				v.PluralName.Other = names.PluralName.Other
+			}
+			return v
+		} else if tag == language.Und {
+			return names
+		}
+		tag = tag.Parent()
+	}
+}
+
+// getCurrencyISOs returns the ISO codes of all currencies with names or symbols for the locale, including registered currencies.
+func getCurrencyISOs(locale Locale) []string {
+	isos := make([]string, 0, len(locale.Currency))
+	for iso := range locale.Currency {
+		isos = append(isos, iso)
+	}
+	registeredCurrenciesMu.RLock()
+	for iso := range registeredCurrencies {
+		if _, ok := locale.Currency[iso]; !ok {
+			isos = append(isos, iso)
+		}
+	}
+	registeredCurrenciesMu.RUnlock()
+	return isos
+}
+
+// CurrenciesForRegion returns the currencies that are legal tender in the region at the given time, ordered by preference.
+func CurrenciesForRegion(region language.Region, at time.Time) []currency.Unit {
+	date := at.Format("2006-01-02")
+	units := []currency.Unit{}
+	for _, cur := range regionCurrencies[region.String()] {
+		if !cur.Tender || date < cur.From || cur.To != "" && cur.To < date {
+			continue
+		} else if unit, err := currency.ParseISO(cur.Currency); err == nil {
+			units = append(units, unit)
+		}
+	}
+	return units
+}
+
+// DefaultCurrency returns the currency set by the -u-cu- extension or otherwise the currency currently in use in the language's region, using likely subtags when the tag has no region.
+func DefaultCurrency(tag language.Tag) (currency.Unit, bool) {
+	if unit, err := currency.ParseISO(tag.TypeForKey("cu")); err == nil {
+		return unit, true
+	}
+	region, confidence := tag.Region()
+	if confidence == language.No {
+		return currency.Unit{}, false
+	} else if units := CurrenciesForRegion(region, time.Now()); 0 < len(units) {
+		return units[0], true
+	}
+	return currency.Unit{}, false
+}
+
+// MeasurementSystem returns the measurement system of the tag's region, which is "metric", "ussystem", or "uksystem", or the system set by the -u-ms- extension.
+func MeasurementSystem(tag language.Tag) string {
+	switch system := tag.TypeForKey("ms"); system {
+	case "metric", "ussystem", "uksystem":
+		return system
+	}
+	if region, confidence := tag.Region(); confidence != language.No {
+		if system, ok := measurementSystems[region.String()]; ok {
+			return system
+		}
+	}
+	return measurementSystems["001"]
+}
+
+// Select returns the pattern for the given plural form, falling back to Other.
+func (c Count) Select(form plural.Form) string {
+	if form == plural.One && c.One != "" {
+		return c.One
+	}
+	return c.Other
+}
+
+// pluralForm returns the cardinal plural form of num with dec decimals for the given language.
+func pluralForm(tag language.Tag, num int64, dec int) plural.Form {
+	if len(int64Scales) <= dec {
+		return plural.Other
+	} else if num < 0 {
+		num = -num
+	}
+	i, f := num/int64Scales[dec], num%int64Scales[dec]
+	w, t := dec, f
+	for 0 < w && t%10 == 0 {
+		t /= 10
+		w--
+	}
+	return plural.Cardinal.MatchPlural(tag, int(i), dec, w, int(f), int(t))
+}
+
+// Join joins the items using the list pattern, such as "a, b, and c".
+func (p ListPattern) Join(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return fillListPattern(p.Two, items[0], items[1])
+	}
+	s := fillListPattern(p.End, items[len(items)-2], items[len(items)-1])
+	for i := len(items) - 3; 0 < i; i-- {
+		s = fillListPattern(p.Middle, items[i], s)
+	}
+	return fillListPattern(p.Start, items[0], s)
+}
+
+func fillListPattern(pattern, a, b string) string {
+	return strings.NewReplacer("{0}", a, "{1}", b).Replace(pattern)
+}
+
+// matchUnicodeSet returns true if r is in the UnicodeSet, such as "[[:^S:]&[:^Z:]]". It supports properties such as [:digit:] and [:^S:] for general categories, nested sets, literals, ranges, intersections and differences.
+func matchUnicodeSet(set string, r rune) bool {
+	if set == "" || set[0] != '[' {
+		return false
+	}
+	ok, _ := matchUnicodeSetAt(set, 0, r)
+	return ok
+}
+
+func matchUnicodeSetAt(set string, i int, r rune) (bool, int) {
+	if strings.HasPrefix(set[i:], "[:") {
+		end := strings.Index(set[i:], ":]")
+		if end == -1 {
+			return false, len(set)
+		}
+		name := set[i+2 : i+end]
+		neg := false
+		if strings.HasPrefix(name, "^") {
+			neg = true
+			name = name[1:]
+		}
+		ok := false
+		if name == "digit" {
+			ok = unicode.IsDigit(r)
+		} else if table, has := unicode.Categories[name]; has {
+			ok = unicode.Is(table, r)
+		} else if table, has := unicode.Properties[name]; has {
+			ok = unicode.Is(table, r)
+		}
+		return ok != neg, i + end + 2
+	}
+
+	i++ // [
+	neg := false
+	if i < len(set) && set[i] == '^' {
+		neg = true
+		i++
+	}
+	result, first, op := false, true, byte(0)
+	for i < len(set) && set[i] != ']' {
+		if !first && (set[i] == '&' || set[i] == '-') {
+			op = set[i]
+			i++
+			continue
+		}
+
+		var ok bool
+		if set[i] == '[' {
+			ok, i = matchUnicodeSetAt(set, i, r)
+		} else {
+			if set[i] == '\\' && i+1 < len(set) {
+				i++
+			}
+			from, n := utf8.DecodeRuneInString(set[i:])
+			to := from
+			i += n
+			if i+1 < len(set) && set[i] == '-' && set[i+1] != '[' && set[i+1] != ']' {
+				to, n = utf8.DecodeRuneInString(set[i+1:])
+				i += 1 + n
+			}
+			ok = from <= r && r <= to
+		}
+
+		if first {
+			result, first = ok, false
+		} else if op == '&' {
+			result = result && ok
+		} else if op == '-' {
+			result = result && !ok
+		} else {
+			result = result || ok
+		}
+		op = 0
+	}
+	return result != neg, i + 1
+}